@@ -20,6 +20,7 @@ type HandlerOptions struct {
 	IBCKeeper         *keeper.Keeper
 	WasmConfig        *wasmTypes.WasmConfig
 	TXCounterStoreKey storetypes.StoreKey
+	WasmKeeper        *wasmkeeper.Keeper
 }
 
 // NewAnteHandler creates a new AnteHandler for the application using the provided HandlerOptions.
@@ -40,12 +41,16 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 	if options.TXCounterStoreKey == nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "tx counter key is required for AnteHandler")
 	}
+	if options.WasmKeeper == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "wasm keeper is required for AnteHandler")
+	}
 
 	// Create the sequence of AnteDecorators
 	anteDecorators := []sdk.AnteDecorator{
 		ante.NewSetUpContextDecorator(), // Must be called first
 		wasmkeeper.NewLimitSimulationGasDecorator(options.WasmConfig.SimulationGasLimit), // Enforce gas limits early
 		wasmkeeper.NewCountTXDecorator(options.TXCounterStoreKey), // Track transaction counts
+		wasmkeeper.NewContractInfoSourceDecorator(options.WasmKeeper), // Resolve wasm authz grants for code ids or a creator
 		ante.NewExtensionOptionsDecorator(options.ExtensionOptionChecker), // Process extension options
 		ante.NewValidateBasicDecorator(), // Basic validation
 		ante.NewTxTimeoutHeightDecorator(), // Timeout height check
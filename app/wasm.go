@@ -1,5 +1,9 @@
 package app
 
+import (
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
 // AllCapabilities returns a list of all capabilities available with the current WasmVM.
 // Reference: https://github.com/CosmWasm/cosmwasm/blob/main/docs/CAPABILITIES-BUILT-IN.md
 // Note: This functionality will be moved upstream: https://github.com/CosmWasm/wasmvm/issues/425
@@ -10,5 +14,6 @@ func AllCapabilities() []string {
 		"stargate",
 		"cosmwasm_1_1",
 		"cosmwasm_1_2",
+		wasmtypes.CapabilityStructuredSubMsgErrors,
 	}
 }
@@ -41,6 +41,7 @@ func SubmitProposalCmd() *cobra.Command {
 		ProposalInstantiateContract2Cmd(),
 		ProposalStoreAndInstantiateContractCmd(),
 		ProposalMigrateContractCmd(),
+		ProposalMigrateContractsByCodeCmd(),
 		ProposalExecuteContractCmd(),
 		ProposalSudoContractCmd(),
 		ProposalUpdateContractAdminCmd(),
@@ -48,6 +49,8 @@ func SubmitProposalCmd() *cobra.Command {
 		ProposalPinCodesCmd(),
 		ProposalUnpinCodesCmd(),
 		ProposalUpdateInstantiateConfigCmd(),
+		ProposalSetStargateQueryAllowlistCmd(),
+		ProposalRemoveStargateQueryAllowlistCmd(),
 	)
 	return cmd
 }
@@ -75,6 +78,10 @@ func ProposalStoreCodeCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			src.Source, src.Builder, src.CodeHash, err = parseVerificationFlags(src.WASMByteCode, cmd.Flags())
+			if err != nil {
+				return err
+			}
 
 			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{&src}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary)
 			if err != nil {
@@ -89,12 +96,19 @@ func ProposalStoreCodeCmd() *cobra.Command {
 		SilenceUsage: true,
 	}
 	addInstantiatePermissionFlags(cmd)
+	addVerificationFlags(cmd)
 
 	// proposal flags
 	addCommonProposalFlags(cmd)
 	return cmd
 }
 
+func addVerificationFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagSource, "", "Code Source URL is a valid absolute HTTPS URI to the contract's source code,")
+	cmd.Flags().String(flagBuilder, "", "Builder is a valid docker image name with tag, such as \"cosmwasm/workspace-optimizer:0.12.9\"")
+	cmd.Flags().BytesHex(flagCodeHash, nil, "CodeHash is the sha256 hash of the wasm code")
+}
+
 func parseVerificationFlags(gzippedWasm []byte, flags *flag.FlagSet) (string, string, []byte, error) {
 	source, err := flags.GetString(flagSource)
 	if err != nil {
@@ -349,9 +363,7 @@ func ProposalStoreAndInstantiateContractCmd() *cobra.Command {
 	}
 
 	cmd.Flags().Bool(flagUnpinCode, false, "Unpin code on upload, optional")
-	cmd.Flags().String(flagSource, "", "Code Source URL is a valid absolute HTTPS URI to the contract's source code,")
-	cmd.Flags().String(flagBuilder, "", "Builder is a valid docker image name with tag, such as \"cosmwasm/workspace-optimizer:0.12.9\"")
-	cmd.Flags().BytesHex(flagCodeHash, nil, "CodeHash is the sha256 hash of the wasm code")
+	addVerificationFlags(cmd)
 	cmd.Flags().String(flagAmount, "", "Coins to send to the contract during instantiation")
 	cmd.Flags().String(flagLabel, "", "A human-readable name for this contract in lists")
 	cmd.Flags().String(flagAdmin, "", "Address or key name of an admin")
@@ -404,6 +416,69 @@ func ProposalMigrateContractCmd() *cobra.Command {
 	return cmd
 }
 
+func ProposalMigrateContractsByCodeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-contracts-by-code [code_id_int64] [new_code_id_int64] [json_encoded_migration_args] --title [text] --summary [text] --authority [address]",
+		Short: "Submit a migrate all wasm contracts of a code to a new code version proposal",
+		Long:  "Submit a migrate all wasm contracts of a code to a new code version proposal. The contracts are migrated in batches at the end of the blocks after the proposal passed.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, proposalTitle, summary, deposit, err := getProposalInfo(cmd)
+			if err != nil {
+				return err
+			}
+
+			authority, err := cmd.Flags().GetString(flagAuthority)
+			if err != nil {
+				return fmt.Errorf("authority: %s", err)
+			}
+
+			if len(authority) == 0 {
+				return errors.New("authority address is required")
+			}
+
+			codeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("code id: %s", err)
+			}
+			newCodeID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("new code id: %s", err)
+			}
+			batchSize, err := cmd.Flags().GetUint32(flagBatchSize)
+			if err != nil {
+				return fmt.Errorf("batch size: %s", err)
+			}
+
+			msg := types.MsgMigrateContractsByCode{
+				Authority: authority,
+				CodeID:    codeID,
+				NewCodeID: newCodeID,
+				Msg:       []byte(args[2]),
+				BatchSize: batchSize,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{&msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary)
+			if err != nil {
+				return err
+			}
+			if err = proposalMsg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+		SilenceUsage: true,
+	}
+	cmd.Flags().Uint32(flagBatchSize, 0, fmt.Sprintf("Max number of contracts migrated per block. Default is %d", types.DefaultContractsMigrationBatchSize))
+	// proposal flags
+	addCommonProposalFlags(cmd)
+	return cmd
+}
+
 func ProposalExecuteContractCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "execute-contract [contract_addr_bech32] [json_encoded_migration_args] --title [text] --summary [text] --authority [address]",
@@ -690,6 +765,104 @@ func ProposalUnpinCodesCmd() *cobra.Command {
 	return cmd
 }
 
+func ProposalSetStargateQueryAllowlistCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-stargate-query-allowlist [code-id|contract_addr_bech32] [paths] --title [text] --summary [text] --authority [address]",
+		Short: "Submit a proposal to allow additional stargate query paths for a code or contract",
+		Long: `Submit a proposal to allow additional stargate query paths for all contracts of a code or for a single contract.
+An existing allowlist is replaced. Only paths that the chain supports as grantable can be queried.`,
+		Example: fmt.Sprintf("$ %s tx wasm submit-proposal set-stargate-query-allowlist 1 /cosmos.bank.v1beta1.Query/Balance --title ... --summary ... --authority ...", version.AppName),
+		Args:    cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, proposalTitle, summary, deposit, err := getProposalInfo(cmd)
+			if err != nil {
+				return err
+			}
+			authority, err := cmd.Flags().GetString(flagAuthority)
+			if err != nil {
+				return fmt.Errorf("authority: %s", err)
+			}
+
+			if len(authority) == 0 {
+				return errors.New("authority address is required")
+			}
+
+			codeID, contract := parseCodeIDOrContractArg(args[0])
+			msg := types.MsgSetStargateQueryAllowlist{
+				Authority: authority,
+				CodeID:    codeID,
+				Contract:  contract,
+				Paths:     args[1:],
+			}
+
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{&msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary)
+			if err != nil {
+				return err
+			}
+			if err = proposalMsg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+		SilenceUsage: true,
+	}
+	// proposal flags
+	addCommonProposalFlags(cmd)
+	return cmd
+}
+
+func ProposalRemoveStargateQueryAllowlistCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-stargate-query-allowlist [code-id|contract_addr_bech32] --title [text] --summary [text] --authority [address]",
+		Short: "Submit a proposal to remove the additional stargate query paths of a code or contract",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, proposalTitle, summary, deposit, err := getProposalInfo(cmd)
+			if err != nil {
+				return err
+			}
+			authority, err := cmd.Flags().GetString(flagAuthority)
+			if err != nil {
+				return fmt.Errorf("authority: %s", err)
+			}
+
+			if len(authority) == 0 {
+				return errors.New("authority address is required")
+			}
+
+			codeID, contract := parseCodeIDOrContractArg(args[0])
+			msg := types.MsgRemoveStargateQueryAllowlist{
+				Authority: authority,
+				CodeID:    codeID,
+				Contract:  contract,
+			}
+
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{&msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary)
+			if err != nil {
+				return err
+			}
+			if err = proposalMsg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+		SilenceUsage: true,
+	}
+	// proposal flags
+	addCommonProposalFlags(cmd)
+	return cmd
+}
+
+// parseCodeIDOrContractArg returns the code id when the argument is numeric, otherwise the argument as contract address
+func parseCodeIDOrContractArg(arg string) (uint64, string) {
+	if codeID, err := strconv.ParseUint(arg, 10, 64); err == nil {
+		return codeID, ""
+	}
+	return 0, arg
+}
+
 func parseAccessConfig(raw string) (c types.AccessConfig, err error) {
 	switch raw {
 	case "nobody":
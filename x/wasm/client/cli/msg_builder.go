@@ -0,0 +1,619 @@
+package cli
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+const (
+	flagSchemaFile  = "schema-file"
+	flagMsgArg      = "arg"
+	flagInteractive = "interactive"
+)
+
+const (
+	msgKindExecute = "execute"
+	msgKindQuery   = "query"
+)
+
+// ExecuteMsgCmd builds an execute message from the contract schema and executes it
+func ExecuteMsgCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "execute-msg [contract_addr_bech32] [variant] --arg [name=value] --amount [coins,optional]",
+		Short: "Build an execute message from the contract schema and execute it",
+		Long: fmt.Sprintf(`Build an execute message from the contract schema and execute it.
+The schema is loaded from the file given with --%s, either the combined schema generated by cosmwasm-schema
+or the execute message schema, or from the schema registered on chain for the code of the contract.
+The fields of the message variant are set with --%s name=value. Values of string fields are taken as they are,
+all other values must be JSON. Use name:=value to pass a JSON value to a string field. With --%s the variant
+and fields are prompted for. The message is validated against the schema before it is sent.
+Example:
+$ %s tx wasm execute-msg <contract_addr> transfer --arg recipient=<addr> --arg amount=100 --from mykey
+`, flagSchemaFile, flagMsgArg, flagInteractive, version.AppName),
+		Aliases: []string{"exec-msg"},
+		Args:    cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			execMsg, err := buildContractMsg(cmd, clientCtx, msgKindExecute, args)
+			if err != nil {
+				return err
+			}
+			msg, err := parseExecuteArgs(args[0], string(execMsg), clientCtx.GetFromAddress(), cmd.Flags())
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	cmd.Flags().String(flagAmount, "", "Coins to send to the contract along with command")
+	addMsgBuilderFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdGetContractStateSmartMsg builds a query message from the contract schema and prints the pretty printed result
+func GetCmdGetContractStateSmartMsg() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "smart-msg [bech32_address] [variant] --arg [name=value]",
+		Short: "Build a query message from the contract schema, call the contract and print the result",
+		Long: fmt.Sprintf(`Build a query message from the contract schema, call the contract and pretty print the result.
+The schema and fields are set as for "tx wasm execute-msg".
+Example:
+$ %s query wasm contract-state smart-msg <contract_addr> balance --arg address=<addr>
+`, version.AppName),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryData, err := buildContractMsg(cmd, clientCtx, msgKindQuery, args)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.SmartContractState(
+				context.Background(),
+				&types.QuerySmartContractStateRequest{
+					Address:   args[0],
+					QueryData: queryData,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			var out bytes.Buffer
+			if err := json.Indent(&out, res.Data, "", "  "); err != nil {
+				return err
+			}
+			return clientCtx.PrintString(out.String() + "\n")
+		},
+		SilenceUsage: true,
+	}
+	addMsgBuilderFlags(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdMsgVariants lists the execute or query messages of a contract with their fields
+func GetCmdMsgVariants() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "msg-variants [execute|query] [bech32_address]",
+		Short: "List the execute or query messages of a contract with their fields",
+		Long: fmt.Sprintf(`List the execute or query messages of a contract with their fields as defined in the contract schema.
+The schema is loaded from the file given with --%s or from the schema registered on chain for the code of the contract.`, flagSchemaFile),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			kind := args[0]
+			if kind != msgKindExecute && kind != msgKindQuery {
+				return fmt.Errorf("unsupported message kind %q: expected %s or %s", kind, msgKindExecute, msgKindQuery)
+			}
+			var contractAddr string
+			if len(args) > 1 {
+				contractAddr = args[1]
+			}
+			schema, err := loadMsgSchema(cmd, clientCtx, kind, contractAddr)
+			if err != nil {
+				return err
+			}
+			builder, err := newMsgBuilder(schema)
+			if err != nil {
+				return err
+			}
+			var out strings.Builder
+			for _, v := range builder.variants {
+				v.describe(&out)
+			}
+			return clientCtx.PrintString(out.String())
+		},
+		SilenceUsage: true,
+	}
+	cmd.Flags().String(flagSchemaFile, "", "Schema file generated by cosmwasm-schema. The schema registered on chain is used when not set")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func addMsgBuilderFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagSchemaFile, "", "Schema file generated by cosmwasm-schema. The schema registered on chain is used when not set")
+	cmd.Flags().StringArray(flagMsgArg, []string{}, "Message field as name=value or name:=json. Can be set multiple times")
+	cmd.Flags().Bool(flagInteractive, false, "Prompt for the message variant and fields")
+}
+
+// buildContractMsg builds the message of the given kind from the command args and flags
+func buildContractMsg(cmd *cobra.Command, clientCtx client.Context, kind string, args []string) ([]byte, error) {
+	if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+		return nil, fmt.Errorf("contract: %s", err)
+	}
+	schema, err := loadMsgSchema(cmd, clientCtx, kind, args[0])
+	if err != nil {
+		return nil, err
+	}
+	builder, err := newMsgBuilder(schema)
+	if err != nil {
+		return nil, err
+	}
+	fieldArgs, err := cmd.Flags().GetStringArray(flagMsgArg)
+	if err != nil {
+		return nil, fmt.Errorf("arg: %s", err)
+	}
+	interactive, err := cmd.Flags().GetBool(flagInteractive)
+	if err != nil {
+		return nil, fmt.Errorf("interactive: %s", err)
+	}
+	var variant string
+	if len(args) > 1 {
+		variant = args[1]
+	}
+	if interactive {
+		return builder.prompt(bufio.NewReader(cmd.InOrStdin()), cmd.ErrOrStderr(), variant)
+	}
+	if variant == "" {
+		return nil, fmt.Errorf("variant required, one of: %s", strings.Join(builder.variantNames(), ", "))
+	}
+	return builder.build(variant, fieldArgs)
+}
+
+// loadMsgSchema returns the schema of the message kind from the schema file flag or from the schema registered on
+// chain for the code of the contract
+func loadMsgSchema(cmd *cobra.Command, clientCtx client.Context, kind, contractAddr string) ([]byte, error) {
+	file, err := cmd.Flags().GetString(flagSchemaFile)
+	if err != nil {
+		return nil, fmt.Errorf("schema file: %s", err)
+	}
+	if file != "" {
+		bz, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("schema file: %s", err)
+		}
+		return msgSchemaFromFile(bz, kind)
+	}
+	if contractAddr == "" {
+		return nil, fmt.Errorf("contract address or --%s required", flagSchemaFile)
+	}
+	queryClient := types.NewQueryClient(clientCtx)
+	info, err := queryClient.ContractInfo(context.Background(), &types.QueryContractInfoRequest{Address: contractAddr})
+	if err != nil {
+		return nil, err
+	}
+	res, err := queryClient.ContractSchema(context.Background(), &types.QueryContractSchemaRequest{CodeId: info.CodeID})
+	if err != nil {
+		return nil, err
+	}
+	schema := res.Schema.Execute
+	if kind == msgKindQuery {
+		schema = res.Schema.Query
+	}
+	if len(schema) == 0 || string(schema) == "null" {
+		return nil, fmt.Errorf("no %s schema registered for code %d: use --%s", kind, info.CodeID, flagSchemaFile)
+	}
+	return schema, nil
+}
+
+// msgSchemaFromFile returns the schema of the message kind from the combined schema file generated by cosmwasm-schema.
+// Any other file is taken as the schema of the message kind.
+func msgSchemaFromFile(bz []byte, kind string) ([]byte, error) {
+	var idl map[string]json.RawMessage
+	if err := json.Unmarshal(bz, &idl); err != nil {
+		return nil, fmt.Errorf("schema file: %s", err)
+	}
+	if _, ok := idl["contract_name"]; !ok {
+		return bz, nil
+	}
+	schema, ok := idl[kind]
+	if !ok || string(schema) == "null" {
+		return nil, fmt.Errorf("schema file: no %s schema", kind)
+	}
+	return schema, nil
+}
+
+// msgBuilder builds the JSON messages of an enum type as generated by cosmwasm-schema. A variant is encoded as
+// `{"<name>": {<fields>}}` or `"<name>"` for a unit variant.
+type msgBuilder struct {
+	schema    *types.JSONSchema
+	variants  []msgVariant
+	variantOf map[string]int
+}
+
+type msgVariant struct {
+	name        string
+	unit        bool
+	description string
+	fields      []msgField
+}
+
+type msgField struct {
+	name        string
+	typeName    string
+	required    bool
+	stringValue bool
+	description string
+}
+
+func newMsgBuilder(schema []byte) (*msgBuilder, error) {
+	compiled, err := types.CompileJSONSchema(schema)
+	if err != nil {
+		return nil, fmt.Errorf("schema: %s", err)
+	}
+	var root map[string]interface{}
+	if err := json.Unmarshal(schema, &root); err != nil {
+		return nil, fmt.Errorf("schema: %s", err)
+	}
+	r := schemaResolver{root: root}
+	alternatives, ok := r.resolve(root)["oneOf"].([]interface{})
+	if !ok {
+		if alternatives, ok = r.resolve(root)["anyOf"].([]interface{}); !ok {
+			return nil, errors.New("schema: not an enum of messages")
+		}
+	}
+	b := &msgBuilder{schema: compiled, variantOf: make(map[string]int)}
+	for _, a := range alternatives {
+		alt := r.resolve(a)
+		if names, ok := alt["enum"].([]interface{}); ok {
+			for _, n := range names {
+				if name, ok := n.(string); ok {
+					b.add(msgVariant{name: name, unit: true, description: stringOf(alt["description"])})
+				}
+			}
+			continue
+		}
+		props, _ := alt["properties"].(map[string]interface{})
+		if len(props) != 1 {
+			continue
+		}
+		for name, p := range props {
+			b.add(msgVariant{name: name, description: stringOf(alt["description"]), fields: r.fields(p)})
+		}
+	}
+	if len(b.variants) == 0 {
+		return nil, errors.New("schema: no message variants")
+	}
+	return b, nil
+}
+
+func (b *msgBuilder) add(v msgVariant) {
+	b.variantOf[v.name] = len(b.variants)
+	b.variants = append(b.variants, v)
+}
+
+func (b msgBuilder) variantNames() []string {
+	names := make([]string, len(b.variants))
+	for i, v := range b.variants {
+		names[i] = v.name
+	}
+	return names
+}
+
+func (b msgBuilder) variant(name string) (msgVariant, error) {
+	i, ok := b.variantOf[name]
+	if !ok {
+		return msgVariant{}, fmt.Errorf("unknown variant %q, expected one of: %s", name, strings.Join(b.variantNames(), ", "))
+	}
+	return b.variants[i], nil
+}
+
+// build returns the JSON message for the variant with fields from name=value or name:=json args
+func (b msgBuilder) build(variantName string, args []string) ([]byte, error) {
+	v, err := b.variant(variantName)
+	if err != nil {
+		return nil, err
+	}
+	values := make(map[string]json.RawMessage, len(args))
+	for _, a := range args {
+		name, value, ok := strings.Cut(a, "=")
+		if !ok {
+			return nil, fmt.Errorf("arg %q: expected name=value", a)
+		}
+		rawJSON := strings.HasSuffix(name, ":")
+		name = strings.TrimSuffix(name, ":")
+		f, ok := v.field(name)
+		if !ok {
+			return nil, fmt.Errorf("unknown field %q of %s, expected one of: %s", name, v.name, strings.Join(v.fieldNames(), ", "))
+		}
+		if _, exists := values[name]; exists {
+			return nil, fmt.Errorf("duplicate field %q", name)
+		}
+		if values[name], err = f.encode(value, rawJSON); err != nil {
+			return nil, err
+		}
+	}
+	return b.encode(v, values)
+}
+
+// prompt asks for the variant, when not set, and all fields of the variant
+func (b msgBuilder) prompt(in *bufio.Reader, out io.Writer, variantName string) ([]byte, error) {
+	for variantName == "" {
+		for i, v := range b.variants {
+			fmt.Fprintf(out, "%d) %s\n", i+1, v.name)
+		}
+		fmt.Fprint(out, "variant: ")
+		line, err := readLine(in)
+		if err != nil {
+			return nil, err
+		}
+		if i, err := strconv.Atoi(line); err == nil && i > 0 && i <= len(b.variants) {
+			line = b.variants[i-1].name
+		}
+		if _, err := b.variant(line); err != nil {
+			fmt.Fprintln(out, err)
+			continue
+		}
+		variantName = line
+	}
+	v, err := b.variant(variantName)
+	if err != nil {
+		return nil, err
+	}
+	values := make(map[string]json.RawMessage, len(v.fields))
+	for _, f := range v.fields {
+		for {
+			optional := ", optional"
+			if f.required {
+				optional = ""
+			}
+			fmt.Fprintf(out, "%s (%s%s): ", f.name, f.typeName, optional)
+			line, err := readLine(in)
+			if err != nil {
+				return nil, err
+			}
+			if line == "" {
+				if f.required {
+					fmt.Fprintf(out, "%s is required\n", f.name)
+					continue
+				}
+				break
+			}
+			value, err := f.encode(line, false)
+			if err != nil {
+				fmt.Fprintln(out, err)
+				continue
+			}
+			values[f.name] = value
+			break
+		}
+	}
+	return b.encode(v, values)
+}
+
+// encode returns the JSON message of the variant and validates it against the schema
+func (b msgBuilder) encode(v msgVariant, values map[string]json.RawMessage) ([]byte, error) {
+	for _, f := range v.fields {
+		if _, ok := values[f.name]; f.required && !ok {
+			return nil, fmt.Errorf("missing required field %q of %s", f.name, v.name)
+		}
+	}
+	var msg []byte
+	var err error
+	if v.unit {
+		msg, err = json.Marshal(v.name)
+	} else {
+		msg, err = json.Marshal(map[string]map[string]json.RawMessage{v.name: values})
+	}
+	if err != nil {
+		return nil, err
+	}
+	if _, err := b.schema.Validate(msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+func (v msgVariant) field(name string) (msgField, bool) {
+	for _, f := range v.fields {
+		if f.name == name {
+			return f, true
+		}
+	}
+	return msgField{}, false
+}
+
+func (v msgVariant) fieldNames() []string {
+	names := make([]string, len(v.fields))
+	for i, f := range v.fields {
+		names[i] = f.name
+	}
+	return names
+}
+
+func (v msgVariant) describe(out io.Writer) {
+	fmt.Fprint(out, v.name)
+	if v.description != "" {
+		fmt.Fprintf(out, " - %s", firstLine(v.description))
+	}
+	fmt.Fprintln(out)
+	for _, f := range v.fields {
+		optional := ", optional"
+		if f.required {
+			optional = ""
+		}
+		fmt.Fprintf(out, "  %s (%s%s)", f.name, f.typeName, optional)
+		if f.description != "" {
+			fmt.Fprintf(out, " - %s", firstLine(f.description))
+		}
+		fmt.Fprintln(out)
+	}
+}
+
+// encode returns the JSON value of the field. String fields take the value as it is unless rawJSON is set.
+func (f msgField) encode(value string, rawJSON bool) (json.RawMessage, error) {
+	if f.stringValue && !rawJSON {
+		return json.Marshal(value)
+	}
+	if !json.Valid([]byte(value)) {
+		return nil, fmt.Errorf("field %q: invalid JSON value %q for %s", f.name, value, f.typeName)
+	}
+	return json.RawMessage(value), nil
+}
+
+// schemaResolver resolves local references in a JSON schema
+type schemaResolver struct {
+	root map[string]interface{}
+}
+
+// resolve returns the referenced schema object. Nested references are followed up to a fixed depth.
+func (r schemaResolver) resolve(s interface{}) map[string]interface{} {
+	m, _ := s.(map[string]interface{})
+	for i := 0; i < 8 && m != nil; i++ {
+		ref, ok := m["$ref"].(string)
+		if !ok {
+			return m
+		}
+		var cur interface{} = r.root
+		for _, token := range strings.Split(strings.TrimPrefix(strings.TrimPrefix(ref, "#"), "/"), "/") {
+			if token == "" {
+				continue
+			}
+			obj, _ := cur.(map[string]interface{})
+			cur = obj[strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")]
+		}
+		m, _ = cur.(map[string]interface{})
+	}
+	return m
+}
+
+// fields returns the fields of an object schema ordered by required first and name
+func (r schemaResolver) fields(s interface{}) []msgField {
+	obj := r.resolve(s)
+	props, _ := obj["properties"].(map[string]interface{})
+	required := make(map[string]bool)
+	if list, ok := obj["required"].([]interface{}); ok {
+		for _, n := range list {
+			if name, ok := n.(string); ok {
+				required[name] = true
+			}
+		}
+	}
+	fields := make([]msgField, 0, len(props))
+	for name, p := range props {
+		typeName, isString := r.typeOf(p)
+		fields = append(fields, msgField{
+			name:        name,
+			typeName:    typeName,
+			required:    required[name],
+			stringValue: isString,
+			description: stringOf(r.resolve(p)["description"]),
+		})
+	}
+	sort.Slice(fields, func(i, j int) bool {
+		if fields[i].required != fields[j].required {
+			return fields[i].required
+		}
+		return fields[i].name < fields[j].name
+	})
+	return fields
+}
+
+// typeOf returns a human readable type and if values are JSON strings
+func (r schemaResolver) typeOf(s interface{}) (string, bool) {
+	m, _ := s.(map[string]interface{})
+	if ref, ok := m["$ref"].(string); ok {
+		name := ref[strings.LastIndex(ref, "/")+1:]
+		_, isString := r.typeOf(r.resolve(m))
+		return name, isString
+	}
+	for _, keyword := range []string{"anyOf", "oneOf"} {
+		alternatives, ok := m[keyword].([]interface{})
+		if !ok {
+			continue
+		}
+		var names []string
+		var isString bool
+		for _, a := range alternatives {
+			name, str := r.typeOf(a)
+			if name == "null" {
+				continue
+			}
+			names = append(names, name)
+			isString = isString || str
+		}
+		return strings.Join(names, " | "), isString && len(names) == 1
+	}
+	switch t := m["type"].(type) {
+	case string:
+		if t == "array" {
+			itemType, _ := r.typeOf(m["items"])
+			return "[]" + itemType, false
+		}
+		if format, ok := m["format"].(string); ok && t != "string" {
+			return format, false
+		}
+		return t, t == "string"
+	case []interface{}:
+		var names []string
+		var isString bool
+		for _, e := range t {
+			if name, ok := e.(string); ok && name != "null" {
+				names = append(names, name)
+				isString = isString || name == "string"
+			}
+		}
+		return strings.Join(names, " | "), isString && len(names) == 1
+	}
+	return "json", false
+}
+
+func readLine(in *bufio.Reader) (string, error) {
+	line, err := in.ReadString('\n')
+	if err != nil && (err != io.EOF || line == "") {
+		return "", err
+	}
+	return strings.TrimSpace(line), nil
+}
+
+func stringOf(v interface{}) string {
+	s, _ := v.(string)
+	return s
+}
+
+func firstLine(s string) string {
+	line, _, _ := strings.Cut(s, "\n")
+	return line
+}
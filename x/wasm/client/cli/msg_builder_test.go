@@ -0,0 +1,280 @@
+package cli
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// cw20ExecuteSchema is a shortened version of the execute message schema generated by cosmwasm-schema for cw20-base
+const cw20ExecuteSchema = `{
+  "$schema": "http://json-schema.org/draft-07/schema#",
+  "title": "ExecuteMsg",
+  "oneOf": [
+    {
+      "description": "Transfer is a base message to move tokens to another account without triggering actions",
+      "type": "object",
+      "required": ["transfer"],
+      "properties": {
+        "transfer": {
+          "type": "object",
+          "required": ["amount", "recipient"],
+          "properties": {
+            "amount": {"$ref": "#/definitions/Uint128"},
+            "recipient": {"type": "string"}
+          },
+          "additionalProperties": false
+        }
+      },
+      "additionalProperties": false
+    },
+    {
+      "type": "object",
+      "required": ["increase_allowance"],
+      "properties": {
+        "increase_allowance": {
+          "type": "object",
+          "required": ["amount", "spender"],
+          "properties": {
+            "amount": {"$ref": "#/definitions/Uint128"},
+            "expires": {
+              "anyOf": [{"$ref": "#/definitions/Expiration"}, {"type": "null"}]
+            },
+            "spender": {"type": "string"}
+          },
+          "additionalProperties": false
+        }
+      },
+      "additionalProperties": false
+    },
+    {
+      "type": "string",
+      "enum": ["pause"]
+    }
+  ],
+  "definitions": {
+    "Expiration": {
+      "oneOf": [
+        {
+          "type": "object",
+          "required": ["at_height"],
+          "properties": {"at_height": {"type": "integer", "format": "uint64", "minimum": 0.0}},
+          "additionalProperties": false
+        },
+        {
+          "type": "object",
+          "required": ["never"],
+          "properties": {"never": {"type": "object"}},
+          "additionalProperties": false
+        }
+      ]
+    },
+    "Uint128": {
+      "description": "A string containing a 128-bit integer in decimal representation.",
+      "type": "string"
+    }
+  }
+}`
+
+func TestNewMsgBuilder(t *testing.T) {
+	b, err := newMsgBuilder([]byte(cw20ExecuteSchema))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"transfer", "increase_allowance", "pause"}, b.variantNames())
+
+	var out strings.Builder
+	for _, v := range b.variants {
+		v.describe(&out)
+	}
+	exp := `transfer - Transfer is a base message to move tokens to another account without triggering actions
+  amount (Uint128) - A string containing a 128-bit integer in decimal representation.
+  recipient (string)
+increase_allowance
+  amount (Uint128) - A string containing a 128-bit integer in decimal representation.
+  spender (string)
+  expires (Expiration, optional)
+pause
+`
+	assert.Equal(t, exp, out.String())
+
+	// and not an enum
+	_, err = newMsgBuilder([]byte(`{"type": "object"}`))
+	assert.Error(t, err)
+	// and invalid schema
+	_, err = newMsgBuilder([]byte(`{"type": "text"}`))
+	assert.Error(t, err)
+}
+
+func TestMsgBuilderBuild(t *testing.T) {
+	specs := map[string]struct {
+		variant string
+		args    []string
+		exp     string
+		expErr  bool
+	}{
+		"all required fields": {
+			variant: "transfer",
+			args:    []string{"amount=100", "recipient=cosmos1foo"},
+			exp:     `{"transfer":{"amount":"100","recipient":"cosmos1foo"}}`,
+		},
+		"optional json field": {
+			variant: "increase_allowance",
+			args:    []string{"amount=1", "spender=cosmos1foo", `expires={"at_height":10}`},
+			exp:     `{"increase_allowance":{"amount":"1","expires":{"at_height":10},"spender":"cosmos1foo"}}`,
+		},
+		"raw json for string field": {
+			variant: "transfer",
+			args:    []string{`amount:="100"`, "recipient=cosmos1foo"},
+			exp:     `{"transfer":{"amount":"100","recipient":"cosmos1foo"}}`,
+		},
+		"value with equal sign": {
+			variant: "transfer",
+			args:    []string{"amount=100", "recipient=a=b"},
+			exp:     `{"transfer":{"amount":"100","recipient":"a=b"}}`,
+		},
+		"unit variant": {
+			variant: "pause",
+			exp:     `"pause"`,
+		},
+		"unknown variant": {
+			variant: "burn",
+			expErr:  true,
+		},
+		"unknown field": {
+			variant: "transfer",
+			args:    []string{"amount=100", "recipient=cosmos1foo", "memo=bar"},
+			expErr:  true,
+		},
+		"missing required field": {
+			variant: "transfer",
+			args:    []string{"amount=100"},
+			expErr:  true,
+		},
+		"duplicate field": {
+			variant: "transfer",
+			args:    []string{"amount=100", "amount=200", "recipient=cosmos1foo"},
+			expErr:  true,
+		},
+		"invalid json value": {
+			variant: "increase_allowance",
+			args:    []string{"amount=1", "spender=cosmos1foo", "expires={"},
+			expErr:  true,
+		},
+		"not matching schema": {
+			variant: "increase_allowance",
+			args:    []string{"amount=1", "spender=cosmos1foo", `expires={"at_time":1}`},
+			expErr:  true,
+		},
+		"missing separator": {
+			variant: "transfer",
+			args:    []string{"amount"},
+			expErr:  true,
+		},
+	}
+	b, err := newMsgBuilder([]byte(cw20ExecuteSchema))
+	require.NoError(t, err)
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			got, gotErr := b.build(spec.variant, spec.args)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, string(got))
+		})
+	}
+}
+
+func TestMsgBuilderPrompt(t *testing.T) {
+	specs := map[string]struct {
+		variant string
+		input   string
+		exp     string
+		expErr  bool
+	}{
+		"variant by number": {
+			input: "1\n100\ncosmos1foo\n",
+			exp:   `{"transfer":{"amount":"100","recipient":"cosmos1foo"}}`,
+		},
+		"variant by name": {
+			input: "transfer\n100\ncosmos1foo\n",
+			exp:   `{"transfer":{"amount":"100","recipient":"cosmos1foo"}}`,
+		},
+		"variant given": {
+			variant: "increase_allowance",
+			input:   "1\ncosmos1foo\n\n",
+			exp:     `{"increase_allowance":{"amount":"1","spender":"cosmos1foo"}}`,
+		},
+		"retry on invalid input": {
+			input: "burn\n9\n2\n\n1\ncosmos1foo\n{\n{\"never\":{}}\n",
+			exp:   `{"increase_allowance":{"amount":"1","expires":{"never":{}},"spender":"cosmos1foo"}}`,
+		},
+		"without trailing newline": {
+			input: "3",
+			exp:   `"pause"`,
+		},
+		"end of input": {
+			input:  "1\n100\n",
+			expErr: true,
+		},
+	}
+	b, err := newMsgBuilder([]byte(cw20ExecuteSchema))
+	require.NoError(t, err)
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			var out bytes.Buffer
+			got, gotErr := b.prompt(bufio.NewReader(strings.NewReader(spec.input)), &out, spec.variant)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, string(got))
+		})
+	}
+}
+
+func TestMsgSchemaFromFile(t *testing.T) {
+	specs := map[string]struct {
+		src    string
+		kind   string
+		exp    string
+		expErr bool
+	}{
+		"combined schema": {
+			src:  `{"contract_name": "cw20-base", "execute": {"title": "ExecuteMsg"}, "query": {"title": "QueryMsg"}}`,
+			kind: msgKindQuery,
+			exp:  `{"title": "QueryMsg"}`,
+		},
+		"combined schema without kind": {
+			src:    `{"contract_name": "cw20-base", "execute": {"title": "ExecuteMsg"}, "query": null}`,
+			kind:   msgKindQuery,
+			expErr: true,
+		},
+		"single schema": {
+			src:  `{"title": "ExecuteMsg"}`,
+			kind: msgKindExecute,
+			exp:  `{"title": "ExecuteMsg"}`,
+		},
+		"invalid json": {
+			src:    `{`,
+			kind:   msgKindExecute,
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			got, gotErr := msgSchemaFromFile([]byte(spec.src), spec.kind)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.JSONEq(t, spec.exp, string(got))
+		})
+	}
+}
@@ -1,13 +1,18 @@
 package cli
 
 import (
+	"encoding/json"
+	"fmt"
+	"os"
 	"strconv"
+	"strings"
 
 	errorsmod "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
 
 	"github.com/CosmWasm/wasmd/x/wasm/types"
 )
@@ -92,6 +97,189 @@ func parseUpdateContractAdminArgs(args []string, sender string) types.MsgUpdateA
 	return msg
 }
 
+const (
+	flagMetadataDescription = "description"
+	flagMetadataWebsite     = "website"
+	flagMetadataSchemaURL   = "schema-url"
+	flagMetadataIcon        = "icon"
+	flagMetadataTag         = "tag"
+)
+
+// UpdateContractMetadataCmd replaces the descriptive metadata of a contract
+func UpdateContractMetadataCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-contract-metadata [contract_addr_bech32]",
+		Short: "Set the descriptive metadata of a contract",
+		Long: `Set the descriptive metadata of a contract. The stored metadata is replaced with the given values,
+unset flags clear the respective field. Calling without any flag removes the metadata. Only the contract admin can update the metadata.`,
+		Aliases: []string{"metadata"},
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg, err := parseUpdateContractMetadataArgs(args, clientCtx.GetFromAddress().String(), cmd.Flags())
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	cmd.Flags().String(flagMetadataDescription, "", "Human readable description of the contract")
+	cmd.Flags().String(flagMetadataWebsite, "", "URL of the project website")
+	cmd.Flags().String(flagMetadataSchemaURL, "", "URL of the JSON schema of the contract messages")
+	cmd.Flags().String(flagMetadataIcon, "", "URL of the contract icon")
+	cmd.Flags().StringArray(flagMetadataTag, []string{}, "Tag as key=value. Can be set multiple times")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func parseUpdateContractMetadataArgs(args []string, sender string, flags *flag.FlagSet) (types.MsgUpdateContractMetadata, error) {
+	var metadata types.ContractMetadata
+	var err error
+	if metadata.Description, err = flags.GetString(flagMetadataDescription); err != nil {
+		return types.MsgUpdateContractMetadata{}, fmt.Errorf("description: %s", err)
+	}
+	if metadata.Website, err = flags.GetString(flagMetadataWebsite); err != nil {
+		return types.MsgUpdateContractMetadata{}, fmt.Errorf("website: %s", err)
+	}
+	if metadata.SchemaURL, err = flags.GetString(flagMetadataSchemaURL); err != nil {
+		return types.MsgUpdateContractMetadata{}, fmt.Errorf("schema url: %s", err)
+	}
+	if metadata.Icon, err = flags.GetString(flagMetadataIcon); err != nil {
+		return types.MsgUpdateContractMetadata{}, fmt.Errorf("icon: %s", err)
+	}
+	tags, err := flags.GetStringArray(flagMetadataTag)
+	if err != nil {
+		return types.MsgUpdateContractMetadata{}, fmt.Errorf("tag: %s", err)
+	}
+	for _, t := range tags {
+		key, value, ok := strings.Cut(t, "=")
+		if !ok {
+			return types.MsgUpdateContractMetadata{}, fmt.Errorf("tag %q: expected key=value", t)
+		}
+		metadata.Tags = append(metadata.Tags, types.MetadataTag{Key: key, Value: value})
+	}
+	return types.MsgUpdateContractMetadata{
+		Sender:   sender,
+		Contract: args[0],
+		Metadata: metadata,
+	}, nil
+}
+
+const (
+	flagSchemaIDL         = "idl"
+	flagSchemaInstantiate = "instantiate-schema"
+	flagSchemaExecute     = "execute-schema"
+	flagSchemaQuery       = "query-schema"
+	flagSchemaMigrate     = "migrate-schema"
+)
+
+// SetContractSchemaCmd registers the JSON schema of the messages of a code
+func SetContractSchemaCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-contract-schema [code_id_int64]",
+		Short: "Set the JSON schema of the messages of a code",
+		Long: fmt.Sprintf(`Set the JSON schema of the instantiate, execute, query and migrate messages of a code.
+The schemas can be read from the combined schema file that is generated by cosmwasm-schema with --%s or from
+a JSON schema file per message type. Only the code creator can set the schema. It can be set once and only before
+a contract is instantiated from the code.`, flagSchemaIDL),
+		Aliases: []string{"schema"},
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg, err := parseSetContractSchemaArgs(args, clientCtx.GetFromAddress().String(), cmd.Flags())
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	cmd.Flags().String(flagSchemaIDL, "", "Combined schema file generated by cosmwasm-schema")
+	cmd.Flags().String(flagSchemaInstantiate, "", "JSON schema file of the instantiate message")
+	cmd.Flags().String(flagSchemaExecute, "", "JSON schema file of the execute message")
+	cmd.Flags().String(flagSchemaQuery, "", "JSON schema file of the query message")
+	cmd.Flags().String(flagSchemaMigrate, "", "JSON schema file of the migrate message")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func parseSetContractSchemaArgs(args []string, sender string, flags *flag.FlagSet) (types.MsgSetContractSchema, error) {
+	codeID, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return types.MsgSetContractSchema{}, errorsmod.Wrap(err, "code id")
+	}
+	var schema types.ContractSchema
+	idlFile, err := flags.GetString(flagSchemaIDL)
+	if err != nil {
+		return types.MsgSetContractSchema{}, fmt.Errorf("idl: %s", err)
+	}
+	if idlFile != "" {
+		bz, err := os.ReadFile(idlFile)
+		if err != nil {
+			return types.MsgSetContractSchema{}, fmt.Errorf("idl: %s", err)
+		}
+		// the schema of a message type is null in the idl when not supported by the contract
+		var idl struct {
+			Instantiate json.RawMessage `json:"instantiate"`
+			Execute     json.RawMessage `json:"execute"`
+			Query       json.RawMessage `json:"query"`
+			Migrate     json.RawMessage `json:"migrate"`
+		}
+		if err := json.Unmarshal(bz, &idl); err != nil {
+			return types.MsgSetContractSchema{}, fmt.Errorf("idl: %s", err)
+		}
+		for _, v := range []struct {
+			src json.RawMessage
+			dst *types.RawContractMessage
+		}{
+			{idl.Instantiate, &schema.Instantiate}, {idl.Execute, &schema.Execute}, {idl.Query, &schema.Query}, {idl.Migrate, &schema.Migrate},
+		} {
+			if len(v.src) != 0 && string(v.src) != "null" {
+				*v.dst = types.RawContractMessage(v.src)
+			}
+		}
+	}
+	for _, v := range []struct {
+		flag string
+		dst  *types.RawContractMessage
+	}{
+		{flagSchemaInstantiate, &schema.Instantiate}, {flagSchemaExecute, &schema.Execute}, {flagSchemaQuery, &schema.Query}, {flagSchemaMigrate, &schema.Migrate},
+	} {
+		file, err := flags.GetString(v.flag)
+		if err != nil {
+			return types.MsgSetContractSchema{}, fmt.Errorf("%s: %s", v.flag, err)
+		}
+		if file == "" {
+			continue
+		}
+		bz, err := os.ReadFile(file)
+		if err != nil {
+			return types.MsgSetContractSchema{}, fmt.Errorf("%s: %s", v.flag, err)
+		}
+		*v.dst = bz
+	}
+	return types.MsgSetContractSchema{
+		Sender: sender,
+		CodeID: codeID,
+		Schema: schema,
+	}, nil
+}
+
 // ClearContractAdminCmd clears an admin for a contract
 func ClearContractAdminCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -120,6 +308,97 @@ func ClearContractAdminCmd() *cobra.Command {
 	return cmd
 }
 
+// ProposeContractAdminCmd proposes a new admin for a contract that has to accept the transfer
+func ProposeContractAdminCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "propose-contract-admin [contract_addr_bech32] [new_admin_addr_bech32]",
+		Short:   "Propose a new admin for a contract that has to accept the transfer with accept-contract-admin",
+		Aliases: []string{"propose-admin"},
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			expiryBlocks, err := cmd.Flags().GetUint64(flagExpiryBlocks)
+			if err != nil {
+				return fmt.Errorf("expiry blocks: %s", err)
+			}
+
+			msg := types.MsgProposeAdmin{
+				Sender:       clientCtx.GetFromAddress().String(),
+				Contract:     args[0],
+				NewAdmin:     args[1],
+				ExpiryBlocks: expiryBlocks,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	cmd.Flags().Uint64(flagExpiryBlocks, 0, fmt.Sprintf("Number of blocks the new admin can accept the transfer in. Default is %d", types.DefaultAdminTransferExpiryBlocks))
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// AcceptContractAdminCmd accepts the admin transfer of a contract proposed to the sender
+func AcceptContractAdminCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "accept-contract-admin [contract_addr_bech32]",
+		Short:   "Accept the admin transfer of a contract proposed to the sender",
+		Aliases: []string{"accept-admin"},
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgAcceptAdmin{
+				Sender:   clientCtx.GetFromAddress().String(),
+				Contract: args[0],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CancelAdminTransferCmd cancels or declines the pending admin transfer of a contract
+func CancelAdminTransferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cancel-admin-transfer [contract_addr_bech32]",
+		Short:   "Cancel the pending admin transfer of a contract as admin or decline it as proposed admin",
+		Aliases: []string{"cancel-admin"},
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgCancelAdminTransfer{
+				Sender:   clientCtx.GetFromAddress().String(),
+				Contract: args[0],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // UpdateInstantiateConfigCmd updates instantiate config for a smart contract.
 func UpdateInstantiateConfigCmd() *cobra.Command {
 	cmd := &cobra.Command{
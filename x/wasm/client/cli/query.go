@@ -14,6 +14,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/spf13/cobra"
 	flag "github.com/spf13/pflag"
 
@@ -37,12 +38,27 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryCodeInfo(),
 		GetCmdGetContractInfo(),
 		GetCmdGetContractHistory(),
+		GetCmdSimulateMigrate(),
 		GetCmdGetContractState(),
 		GetCmdListPinnedCode(),
 		GetCmdLibVersion(),
 		GetCmdQueryParams(),
 		GetCmdBuildAddress(),
+		GetCmdNextClassicAddress(),
+		GetCmdContractsMigration(),
+		GetCmdContractsMigrationResults(),
 		GetCmdListContractsByCreator(),
+		GetCmdListContractsByAdmin(),
+		GetCmdPendingAdminTransfer(),
+		GetCmdListPendingAdminTransfers(),
+		GetCmdListContractsByLabel(),
+		GetCmdGetContractIBCState(),
+		GetCmdStargateQueryAllowlist(),
+		GetCmdContractAuthzGrants(),
+		GetCmdContractMetadata(),
+		GetCmdListContractsByTag(),
+		GetCmdContractSchema(),
+		GetCmdMsgVariants(),
 	)
 	return queryCmd
 }
@@ -72,41 +88,285 @@ func GetCmdLibVersion() *cobra.Command {
 func GetCmdBuildAddress() *cobra.Command {
 	decoder := newArgDecoder(hex.DecodeString)
 	cmd := &cobra.Command{
-		Use:     "build-address [code-hash] [creator-address] [salt-hex-encoded] [json_encoded_init_args (required when set as fixed)]",
-		Short:   "build contract address",
+		Use:   "build-address [code-hash] [creator-address] [salt-hex-encoded] [json_encoded_init_args (required when set as fixed)]",
+		Short: "build contract address",
+		Long: fmt.Sprintf(`Build the address of a contract with the given address generator strategy.
+Strategies:
+  %s: the default for contracts instantiated with a salt
+  %s: like %s but includes the domain set with --%s. Does not match cosmwasm_std::instantiate2_address
+      and does not apply to contracts that instantiate with a salt; they always get the %s address
+  %s: contracts instantiated without salt. Args: [code-id] [instance-id]
+Use "next-address" to query the next %s address of a code.
+`, types.AddressGeneratorPredictable, types.AddressGeneratorDomainSeparated, types.AddressGeneratorPredictable, flagDomain,
+			types.AddressGeneratorPredictable, addressGeneratorClassic, addressGeneratorClassic),
 		Aliases: []string{"address"},
-		Args:    cobra.RangeArgs(3, 4),
+		Args:    cobra.RangeArgs(2, 4),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			codeHash, err := hex.DecodeString(args[0])
+			strategy, err := cmd.Flags().GetString(flagStrategy)
 			if err != nil {
-				return fmt.Errorf("code-hash: %s", err)
+				return fmt.Errorf("strategy: %s", err)
 			}
-			creator, err := sdk.AccAddressFromBech32(args[1])
+			domain, err := cmd.Flags().GetString(flagDomain)
 			if err != nil {
-				return fmt.Errorf("creator: %s", err)
+				return fmt.Errorf("domain: %s", err)
 			}
-			salt, err := hex.DecodeString(args[2])
-			switch {
-			case err != nil:
-				return fmt.Errorf("salt: %s", err)
-			case len(salt) == 0:
-				return errors.New("empty salt")
+			addr, err := buildAddress(strategy, domain, args)
+			if err != nil {
+				return err
+			}
+			cmd.Println(addr.String())
+			return nil
+		},
+		SilenceUsage: true,
+	}
+	decoder.RegisterFlags(cmd.PersistentFlags(), "salt")
+	cmd.Flags().String(flagStrategy, types.AddressGeneratorPredictable, fmt.Sprintf("Address generator strategy: %s, %s or %s",
+		types.AddressGeneratorPredictable, types.AddressGeneratorDomainSeparated, addressGeneratorClassic))
+	cmd.Flags().String(flagDomain, "", fmt.Sprintf("Domain separator for the %s strategy", types.AddressGeneratorDomainSeparated))
+	return cmd
+}
+
+// addressGeneratorClassic is the name of the sequence based address generator in the CLI
+const addressGeneratorClassic = "classic"
+
+func buildAddress(strategy, domain string, args []string) (sdk.AccAddress, error) {
+	if strategy == addressGeneratorClassic {
+		if len(args) != 2 {
+			return nil, fmt.Errorf("expected [code-id] [instance-id] but got %d args", len(args))
+		}
+		codeID, err := strconv.ParseUint(args[0], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("code-id: %s", err)
+		}
+		instanceID, err := strconv.ParseUint(args[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("instance-id: %s", err)
+		}
+		return keeper.BuildContractAddressClassic(codeID, instanceID), nil
+	}
+	if len(args) < 3 {
+		return nil, fmt.Errorf("expected at least 3 args but got %d", len(args))
+	}
+	codeHash, err := hex.DecodeString(args[0])
+	switch {
+	case err != nil:
+		return nil, fmt.Errorf("code-hash: %s", err)
+	case len(codeHash) != 32:
+		return nil, errors.New("code-hash: invalid length")
+	}
+	creator, err := sdk.AccAddressFromBech32(args[1])
+	if err != nil {
+		return nil, fmt.Errorf("creator: %s", err)
+	}
+	salt, err := hex.DecodeString(args[2])
+	switch {
+	case err != nil:
+		return nil, fmt.Errorf("salt: %s", err)
+	case len(salt) == 0:
+		return nil, errors.New("empty salt")
+	}
+	msg := types.RawContractMessage{}
+	if len(args) == 4 {
+		msg = types.RawContractMessage(args[3])
+		if err := msg.ValidateBasic(); err != nil {
+			return nil, fmt.Errorf("init message: %s", err)
+		}
+	}
+	switch strategy {
+	case types.AddressGeneratorPredictable:
+		if domain != "" {
+			return nil, fmt.Errorf("domain not supported by %s strategy", strategy)
+		}
+		return keeper.BuildContractAddressPredictable(codeHash, creator, salt, msg), nil
+	case types.AddressGeneratorDomainSeparated:
+		if domain == "" {
+			return nil, errors.New("domain required")
+		}
+		return keeper.BuildContractAddressDomainSeparated(domain, codeHash, creator, salt, msg), nil
+	default:
+		return nil, fmt.Errorf("unsupported strategy: %q", strategy)
+	}
+}
+
+// GetCmdNextClassicAddress gets the address of the next contract instantiated from a code without salt
+func GetCmdNextClassicAddress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "next-address [code_id]",
+		Short: "Prints out the address of the next contract instantiated from a code without salt",
+		Long:  "Prints out the address of the next contract instantiated from a code without salt. The address is taken by any contract instantiated without salt before.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
 			}
 
-			if len(args) == 3 {
-				cmd.Println(keeper.BuildContractAddressPredictable(codeHash, creator, salt, []byte{}).String())
-				return nil
+			codeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
 			}
-			msg := types.RawContractMessage(args[3])
-			if err := msg.ValidateBasic(); err != nil {
-				return fmt.Errorf("init message: %s", err)
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.NextClassicAddress(
+				context.Background(),
+				&types.QueryNextClassicAddressRequest{
+					CodeId: codeID,
+				},
+			)
+			if err != nil {
+				return err
 			}
-			cmd.Println(keeper.BuildContractAddressPredictable(codeHash, creator, salt, msg).String())
-			return nil
+			return clientCtx.PrintProto(res)
 		},
 		SilenceUsage: true,
 	}
-	decoder.RegisterFlags(cmd.PersistentFlags(), "salt")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdContractsMigration shows the progress of a migration of all contracts of a code
+func GetCmdContractsMigration() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contracts-migration [migration_id]",
+		Short: "Prints out the progress of a migration of all contracts of a code",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			migrationID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ContractsMigration(
+				context.Background(),
+				&types.QueryContractsMigrationRequest{
+					MigrationId: migrationID,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdContractsMigrationResults lists the results of the contracts processed by a migration
+func GetCmdContractsMigrationResults() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contracts-migration-results [migration_id]",
+		Short: "List the success or failure of every contract processed by a migration of all contracts of a code",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			migrationID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ContractsMigrationResults(
+				context.Background(),
+				&types.QueryContractsMigrationResultsRequest{
+					MigrationId: migrationID,
+					Pagination:  pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "contracts migration results")
+	return cmd
+}
+
+// GetCmdPendingAdminTransfer shows the pending admin transfer of a contract
+func GetCmdPendingAdminTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-admin [bech32_address]",
+		Short: "Prints out the pending admin transfer of a contract",
+		Long:  "Prints out the pending admin transfer of a contract. Expired transfers are shown until replaced or cancelled.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err = sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PendingAdminTransfer(
+				context.Background(),
+				&types.QueryPendingAdminTransferRequest{
+					Address: args[0],
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdListPendingAdminTransfers lists the pending admin transfers to an address
+func GetCmdListPendingAdminTransfers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-admin-transfers [new_admin_bech32_address]",
+		Short: "List the pending admin transfers of contracts to an address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err = sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PendingAdminTransfersByNewAdmin(
+				context.Background(),
+				&types.QueryPendingAdminTransfersByNewAdminRequest{
+					NewAdmin:   args[0],
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pending admin transfers")
 	return cmd
 }
 
@@ -326,6 +586,7 @@ func GetCmdGetContractState() *cobra.Command {
 		GetCmdGetContractStateAll(),
 		GetCmdGetContractStateRaw(),
 		GetCmdGetContractStateSmart(),
+		GetCmdGetContractStateSmartMsg(),
 	)
 	return cmd
 }
@@ -462,6 +723,58 @@ func GetCmdGetContractStateSmart() *cobra.Command {
 	return cmd
 }
 
+// GetCmdSimulateMigrate runs the migration of a contract without persisting the state
+func GetCmdSimulateMigrate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-migrate [contract_addr_bech32] [new_code_id_int64] [json_encoded_migration_args]",
+		Short: "Dry-run of a contract migration that prints the response, events, gas used and changed storage keys",
+		Long: `Dry-run of a contract migration that prints the response, events, gas used and changed storage keys.
+Capabilities required by the new code that the chain does not support are listed, too. Nothing is persisted.
+The migration is run with the governance permissions unless a sender is given.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err = sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+			codeID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("code id: %s", err)
+			}
+			if !json.Valid([]byte(args[2])) {
+				return errors.New("migration args must be json")
+			}
+			sender, err := cmd.Flags().GetString(flagSender)
+			if err != nil {
+				return fmt.Errorf("sender: %s", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.SimulateMigrate(
+				context.Background(),
+				&types.QuerySimulateMigrateRequest{
+					Address: args[0],
+					CodeId:  codeID,
+					Msg:     []byte(args[2]),
+					Sender:  sender,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	cmd.Flags().String(flagSender, "", "Address of the migration sender, must be the contract admin. Default is the governance authority")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdGetContractHistory prints the code history for a given contract
 func GetCmdGetContractHistory() *cobra.Command {
 	cmd := &cobra.Command{
@@ -584,6 +897,84 @@ func GetCmdListContractsByCreator() *cobra.Command {
 	return cmd
 }
 
+// GetCmdListContractsByAdmin lists all contracts administered by an address
+func GetCmdListContractsByAdmin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-contracts-by-admin [admin]",
+		Short: "List all contracts by admin",
+		Long:  "List all contracts administered by an address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ContractsByAdmin(
+				context.Background(),
+				&types.QueryContractsByAdminRequest{
+					AdminAddress: args[0],
+					Pagination:   pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "list contracts by admin")
+	return cmd
+}
+
+// GetCmdListContractsByLabel lists all contracts with a label that starts with a prefix
+func GetCmdListContractsByLabel() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-contracts-by-label [label_prefix]",
+		Short: "List all contracts by label prefix",
+		Long:  "List all contracts with a label that starts with the given case sensitive prefix, ordered by label",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ContractsByLabelPrefix(
+				context.Background(),
+				&types.QueryContractsByLabelPrefixRequest{
+					LabelPrefix: args[0],
+					Pagination:  pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "list contracts by label")
+	return cmd
+}
+
 type argumentDecoder struct {
 	// dec is the default decoder
 	dec                func(string) ([]byte, error)
@@ -673,3 +1064,254 @@ func GetCmdQueryParams() *cobra.Command {
 
 	return cmd
 }
+
+const (
+	flagIBCChannel  = "channel"
+	flagAcksPageKey = "acks-page-key"
+	flagAcksLimit   = "acks-limit"
+)
+
+// GetCmdGetContractIBCState gets the IBC channels and packet state of a given contract
+func GetCmdGetContractIBCState() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "contract-ibc-state [bech32_address]",
+		Short:   "Prints out the IBC channels of a contract with their sequences and pending packets",
+		Long:    "Prints out the IBC channels bound to the contract's port with their counterparty, next sequences, outstanding packet commitments and written acknowledgements. The pagination applies to the packet commitments and the acks pagination to the acknowledgements of each channel. Page keys require the channel that they were returned for",
+		Aliases: []string{"ibc-state"},
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			channelID, err := cmd.Flags().GetString(flagIBCChannel)
+			if err != nil {
+				return err
+			}
+			acksPageKey, err := cmd.Flags().GetBytesBase64(flagAcksPageKey)
+			if err != nil {
+				return err
+			}
+			acksLimit, err := cmd.Flags().GetUint64(flagAcksLimit)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ContractIBCState(
+				context.Background(),
+				&types.QueryContractIBCStateRequest{
+					Address:    args[0],
+					Pagination: pageReq,
+					ChannelID:  channelID,
+					AcknowledgementsPagination: &query.PageRequest{
+						Key:   acksPageKey,
+						Limit: acksLimit,
+					},
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "contract ibc packet commitments")
+	cmd.Flags().String(flagIBCChannel, "", "Restrict the result to this channel of the contract port")
+	cmd.Flags().BytesBase64(flagAcksPageKey, nil, "pagination page-key of packet acknowledgements")
+	cmd.Flags().Uint64(flagAcksLimit, 100, "pagination limit of packet acknowledgements to query for")
+	return cmd
+}
+
+// GetCmdStargateQueryAllowlist lists the additional stargate query paths of a code or contract
+func GetCmdStargateQueryAllowlist() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "stargate-query-allowlist [code_id|bech32_address]",
+		Short:   "Prints out the additional stargate query paths allowed for a code or contract",
+		Long:    "Prints out the stargate query paths that governance allowed for all contracts of a code or for a single contract in addition to the chain wide accept list",
+		Aliases: []string{"stargate-allowlist"},
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := types.QueryStargateQueryAllowlistRequest{}
+			if codeID, err := strconv.ParseUint(args[0], 10, 64); err == nil {
+				req.CodeID = codeID
+			} else {
+				if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+					return err
+				}
+				req.Contract = args[0]
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.StargateQueryAllowlist(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdContractAuthzGrants lists the authz grants for wasm operations on a contract
+func GetCmdContractAuthzGrants() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "contract-authz-grants [bech32_address]",
+		Short:   "Prints out the authz grants for wasm operations on a contract",
+		Long:    "Prints out the execution, migration, admin and instantiate authz grants that reference a contract by address, code id or creator, with the current limits, filters and remaining usage. Expired grants are not listed. A page scans a bounded number of authz grants so that it can have less grants than the limit and still a next key. Offset and count total are not supported",
+		Aliases: []string{"authz-grants"},
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ContractAuthzGrants(
+				context.Background(),
+				&types.QueryContractAuthzGrantsRequest{
+					Address:    args[0],
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "contract authz grants")
+	return cmd
+}
+
+// GetCmdContractMetadata gets the descriptive metadata of a contract
+func GetCmdContractMetadata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract-metadata [bech32_address]",
+		Short: "Prints out the descriptive metadata of a contract",
+		Long:  "Prints out the description, website, schema url, icon and tags of a contract as set by the contract admin",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ContractMetadata(
+				context.Background(),
+				&types.QueryContractMetadataRequest{
+					Address: args[0],
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdListContractsByTag lists all contracts with a metadata tag
+func GetCmdListContractsByTag() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-contracts-by-tag [key] [value]",
+		Short: "List all contracts with a metadata tag",
+		Long:  "List all contracts with a metadata tag. All values of the tag key match when no value is given",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			req := types.QueryContractsByTagRequest{Key: args[0]}
+			if len(args) == 2 {
+				req.Value = args[1]
+			}
+			req.Pagination, err = client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ContractsByTag(context.Background(), &req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "list contracts by tag")
+	return cmd
+}
+
+// GetCmdContractSchema gets the JSON schema of the messages of a code
+func GetCmdContractSchema() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract-schema [code_id]",
+		Short: "Prints out the JSON schema of the messages of a code",
+		Long:  "Prints out the JSON schema of the instantiate, execute, query and migrate messages of a code as set by the code creator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			codeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ContractSchema(
+				context.Background(),
+				&types.QueryContractSchemaRequest{
+					CodeId: codeID,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
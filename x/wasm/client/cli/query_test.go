@@ -0,0 +1,103 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestBuildAddress(t *testing.T) {
+	checksum := bytes.Repeat([]byte{1}, 32)
+	creator := sdk.AccAddress(bytes.Repeat([]byte{2}, 20))
+	salt := []byte("my salt")
+	predictableArgs := []string{hex.EncodeToString(checksum), creator.String(), hex.EncodeToString(salt)}
+
+	specs := map[string]struct {
+		strategy string
+		domain   string
+		args     []string
+		exp      sdk.AccAddress
+		expErr   bool
+	}{
+		"predictable": {
+			strategy: types.AddressGeneratorPredictable,
+			args:     predictableArgs,
+			exp:      keeper.BuildContractAddressPredictable(checksum, creator, salt, []byte{}),
+		},
+		"predictable with init msg": {
+			strategy: types.AddressGeneratorPredictable,
+			args:     append(predictableArgs, `{"foo":"bar"}`),
+			exp:      keeper.BuildContractAddressPredictable(checksum, creator, salt, []byte(`{"foo":"bar"}`)),
+		},
+		"predictable with domain": {
+			strategy: types.AddressGeneratorPredictable,
+			domain:   "my-domain",
+			args:     predictableArgs,
+			expErr:   true,
+		},
+		"domain separated": {
+			strategy: types.AddressGeneratorDomainSeparated,
+			domain:   "my-domain",
+			args:     predictableArgs,
+			exp:      keeper.BuildContractAddressDomainSeparated("my-domain", checksum, creator, salt, []byte{}),
+		},
+		"domain separated without domain": {
+			strategy: types.AddressGeneratorDomainSeparated,
+			args:     predictableArgs,
+			expErr:   true,
+		},
+		"classic": {
+			strategy: addressGeneratorClassic,
+			args:     []string{"1", "2"},
+			exp:      keeper.BuildContractAddressClassic(1, 2),
+		},
+		"classic with invalid instance id": {
+			strategy: addressGeneratorClassic,
+			args:     []string{"1", "x"},
+			expErr:   true,
+		},
+		"classic with too many args": {
+			strategy: addressGeneratorClassic,
+			args:     predictableArgs,
+			expErr:   true,
+		},
+		"invalid checksum": {
+			strategy: types.AddressGeneratorPredictable,
+			args:     []string{"0102", creator.String(), hex.EncodeToString(salt)},
+			expErr:   true,
+		},
+		"invalid init msg": {
+			strategy: types.AddressGeneratorPredictable,
+			args:     append(predictableArgs, `{`),
+			expErr:   true,
+		},
+		"missing salt": {
+			strategy: types.AddressGeneratorPredictable,
+			args:     predictableArgs[:2],
+			expErr:   true,
+		},
+		"unknown strategy": {
+			strategy: "unknown",
+			args:     predictableArgs,
+			expErr:   true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			got, gotErr := buildAddress(spec.strategy, spec.domain, spec.args)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, got)
+		})
+	}
+}
@@ -2,10 +2,12 @@ package cli
 
 import (
 	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -44,7 +46,22 @@ const (
 	flagMaxFunds                  = "max-funds"
 	flagAllowAllMsgs              = "allow-all-messages"
 	flagNoTokenTransfer           = "no-token-transfer" //nolint:gosec
+	flagLimitWindow               = "limit-window"
+	flagCooldown                  = "cooldown"
+	flagJSONPathEquals            = "json-path-eq"
+	flagJSONPathRange             = "json-path-range"
+	flagJSONPathAnyOf             = "json-path-any-of"
+	flagJSONPathRegex             = "json-path-regex"
+	flagAllowedLabels             = "allow-labels"
+	flagAllowedAdmins             = "allow-admins"
+	flagMatchCodeIDs              = "match-code-ids"
+	flagMatchCreator              = "match-creator"
 	flagAuthority                 = "authority"
+	flagStrategy                  = "strategy"
+	flagDomain                    = "domain"
+	flagBatchSize                 = "batch-size"
+	flagSender                    = "sender"
+	flagExpiryBlocks              = "expiry-blocks"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -62,12 +79,20 @@ func GetTxCmd() *cobra.Command {
 		InstantiateContractCmd(),
 		InstantiateContract2Cmd(),
 		ExecuteContractCmd(),
+		ExecuteContractsCmd(),
+		ExecuteMsgCmd(),
 		MigrateContractCmd(),
 		UpdateContractAdminCmd(),
 		ClearContractAdminCmd(),
+		ProposeContractAdminCmd(),
+		AcceptContractAdminCmd(),
+		CancelAdminTransferCmd(),
+		UpdateContractMetadataCmd(),
+		SetContractSchemaCmd(),
 		GrantAuthorizationCmd(),
 		UpdateInstantiateConfigCmd(),
 		SubmitProposalCmd(),
+		VerifyCodeCmd(),
 	)
 	return txCmd
 }
@@ -88,6 +113,10 @@ func StoreCodeCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			msg.Source, msg.Builder, msg.CodeHash, err = parseVerificationFlags(msg.WASMByteCode, cmd.Flags())
+			if err != nil {
+				return err
+			}
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -97,6 +126,7 @@ func StoreCodeCmd() *cobra.Command {
 	}
 
 	addInstantiatePermissionFlags(cmd)
+	addVerificationFlags(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -412,9 +442,78 @@ func parseExecuteArgs(contractAddr string, execMsg string, sender sdk.AccAddress
 	}, nil
 }
 
+// ExecuteContractsCmd will execute multiple contracts in order within a single message
+func ExecuteContractsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "execute-contracts [json_encoded_executions|file]",
+		Short: "Execute multiple contracts in order. All executions succeed or fail together",
+		Long: fmt.Sprintf(`Execute multiple contracts in order. All executions succeed or fail together.
+The executions are a JSON array, either inline or in a file. Funds are optional.
+A string value "{{responses.N}}" in a msg is replaced with the base64 encoded response data of execution N
+and "{{responses.N.path}}" with the JSON value at the dot separated path in the response data.
+Example:
+$ %s tx wasm execute-contracts '[{"contract":"<contract_addr>","msg":{"mint":{}},"funds":"100stake"},{"contract":"<contract_addr>","msg":{"transfer":{"id":"{{responses.0.token_id}}"}}}]'
+`, version.AppName),
+		Aliases: []string{"exec-many"},
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg, err := parseExecuteContractsArgs(args[0], clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func parseExecuteContractsArgs(input string, sender sdk.AccAddress) (types.MsgExecuteContracts, error) {
+	bz := []byte(input)
+	if !json.Valid(bz) {
+		var err error
+		if bz, err = os.ReadFile(input); err != nil {
+			return types.MsgExecuteContracts{}, fmt.Errorf("executions: neither valid json nor a readable file: %s", err)
+		}
+	}
+	var entries []struct {
+		Contract string          `json:"contract"`
+		Msg      json.RawMessage `json:"msg"`
+		Funds    string          `json:"funds"`
+	}
+	if err := json.Unmarshal(bz, &entries); err != nil {
+		return types.MsgExecuteContracts{}, fmt.Errorf("executions: %s", err)
+	}
+	executions := make([]types.ContractExecution, len(entries))
+	for i, e := range entries {
+		funds, err := sdk.ParseCoinsNormalized(e.Funds)
+		if err != nil {
+			return types.MsgExecuteContracts{}, fmt.Errorf("execution %d: funds: %s", i, err)
+		}
+		executions[i] = types.ContractExecution{
+			Contract: e.Contract,
+			Msg:      types.RawContractMessage(e.Msg),
+			Funds:    funds,
+		}
+	}
+	return types.MsgExecuteContracts{
+		Sender:     sender.String(),
+		Executions: executions,
+	}, nil
+}
+
 func GrantAuthorizationCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "grant [grantee] [message_type=\"execution\"|\"migration\"] [contract_addr_bech32] --allow-raw-msgs [msg1,msg2,...] --allow-msg-keys [key1,key2,...] --allow-all-messages",
+		Use:   "grant [grantee] [message_type=\"execution\"|\"execute-contracts\"|\"migration\"|\"update-admin\"|\"clear-admin\"|\"instantiate\"|\"instantiate2\"|\"store-code\"] [contract_addr_bech32|code_id|code_hash] --allow-raw-msgs [msg1,msg2,...] --allow-msg-keys [key1,key2,...] --allow-all-messages",
 		Short: "Grant authorization to an address",
 		Long: fmt.Sprintf(`Grant authorization to an address.
 Examples:
@@ -423,7 +522,23 @@ $ %s tx grant <grantee_addr> execution <contract_addr> --allow-all-messages --ma
 $ %s tx grant <grantee_addr> execution <contract_addr> --allow-all-messages --max-funds 100000uwasm --expiration 1667979596
 
 $ %s tx grant <grantee_addr> execution <contract_addr> --allow-all-messages --max-calls 5 --max-funds 100000uwasm --expiration 1667979596
-`, version.AppName, version.AppName, version.AppName),
+
+$ %s tx grant <grantee_addr> execution <contract_addr> --allow-all-messages --max-funds 100000uwasm --limit-window 24h --expiration 1667979596
+
+$ %s tx grant <grantee_addr> execution <contract_addr> --allow-all-messages --cooldown 10m --no-token-transfer --expiration 1667979596
+
+$ %s tx grant <grantee_addr> execution <contract_addr> --json-path-eq 'swap.pool_id="1"' --json-path-range swap.amount=1:1000000 --max-calls 10 --no-token-transfer --expiration 1667979596
+
+$ %s tx grant <grantee_addr> execution '*' --match-code-ids 1,2 --allow-all-messages --max-calls 10 --no-token-transfer --expiration 1667979596
+
+$ %s tx grant <grantee_addr> execute-contracts <contract_addr> --allow-all-messages --max-calls 10 --no-token-transfer --expiration 1667979596
+
+$ %s tx grant <grantee_addr> update-admin <contract_addr> --json-path-any-of new_admin=<admin_addr> --max-calls 1 --no-token-transfer --expiration 1667979596
+
+$ %s tx grant <grantee_addr> instantiate <code_id> --allow-labels foo,bar --allow-admins <admin_addr> --allow-all-messages --max-calls 1 --no-token-transfer --expiration 1667979596
+
+$ %s tx grant <grantee_addr> store-code <code_hash_hex> --max-calls 1 --no-token-transfer --expiration 1667979596
+`, version.AppName, version.AppName, version.AppName, version.AppName, version.AppName, version.AppName, version.AppName, version.AppName, version.AppName, version.AppName, version.AppName),
 		Args: cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -436,11 +551,6 @@ $ %s tx grant <grantee_addr> execution <contract_addr> --allow-all-messages --ma
 				return err
 			}
 
-			contract, err := sdk.AccAddressFromBech32(args[2])
-			if err != nil {
-				return err
-			}
-
 			msgKeys, err := cmd.Flags().GetStringSlice(flagAllowedMsgKeys)
 			if err != nil {
 				return err
@@ -479,8 +589,30 @@ $ %s tx grant <grantee_addr> execution <contract_addr> --allow-all-messages --ma
 				return err
 			}
 
+			window, err := cmd.Flags().GetDuration(flagLimitWindow)
+			if err != nil {
+				return err
+			}
+
+			cooldown, err := cmd.Flags().GetDuration(flagCooldown)
+			if err != nil {
+				return err
+			}
+
 			var limit types.ContractAuthzLimitX
 			switch {
+			case window != 0 && cooldown != 0, cooldown != 0 && (maxCalls != 0 || maxFundsStr != ""):
+				return errors.New("cannot set more than one limit within one grant")
+			case window != 0 && maxFundsStr != "" && !noTokenTransfer:
+				maxFunds, err := sdk.ParseCoinsNormalized(maxFundsStr)
+				if err != nil {
+					return fmt.Errorf("max funds: %s", err)
+				}
+				limit = types.NewRollingWindowLimit(window, maxCalls, maxFunds...)
+			case window != 0 && maxCalls != 0 && noTokenTransfer && maxFundsStr == "":
+				limit = types.NewRollingWindowLimit(window, maxCalls)
+			case cooldown != 0 && noTokenTransfer && maxFundsStr == "" && maxCalls == 0:
+				limit = types.NewCooldownLimit(cooldown)
 			case maxFundsStr != "" && maxCalls != 0 && !noTokenTransfer:
 				maxFunds, err := sdk.ParseCoinsNormalized(maxFundsStr)
 				if err != nil {
@@ -499,10 +631,18 @@ $ %s tx grant <grantee_addr> execution <contract_addr> --allow-all-messages --ma
 				return errors.New("invalid limit setup")
 			}
 
+			jsonPathConditions, err := parseJSONPathConditionFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
 			var filter types.ContractAuthzFilterX
 			switch {
-			case allowAllMsgs && len(msgKeys) != 0 || allowAllMsgs && len(rawMsgs) != 0 || len(msgKeys) != 0 && len(rawMsgs) != 0:
+			case allowAllMsgs && len(msgKeys) != 0 || allowAllMsgs && len(rawMsgs) != 0 || len(msgKeys) != 0 && len(rawMsgs) != 0,
+				len(jsonPathConditions) != 0 && (allowAllMsgs || len(msgKeys) != 0 || len(rawMsgs) != 0):
 				return errors.New("cannot set more than one filter within one grant")
+			case len(jsonPathConditions) != 0:
+				filter = types.NewJSONPathFilter(jsonPathConditions...)
 			case allowAllMsgs:
 				filter = types.NewAllowAllMessagesFilter()
 			case len(msgKeys) != 0:
@@ -513,21 +653,76 @@ $ %s tx grant <grantee_addr> execution <contract_addr> --allow-all-messages --ma
 					msgs[i] = types.RawContractMessage(msg)
 				}
 				filter = types.NewAcceptedMessagesFilter(msgs...)
-			default:
-				return errors.New("invalid filter setup")
 			}
 
-			grant, err := types.NewContractGrant(contract, limit, filter)
+			labels, err := cmd.Flags().GetStringSlice(flagAllowedLabels)
+			if err != nil {
+				return err
+			}
+			if len(labels) != 0 && args[1] != "instantiate" && args[1] != "instantiate2" {
+				return errors.New("labels are supported for instantiate only")
+			}
+
+			admins, err := cmd.Flags().GetStringSlice(flagAllowedAdmins)
 			if err != nil {
 				return err
 			}
+			if len(admins) != 0 && args[1] != "instantiate" && args[1] != "instantiate2" {
+				return errors.New("admins are supported for instantiate only")
+			}
 
 			var authorization authz.Authorization
 			switch args[1] {
-			case "execution":
-				authorization = types.NewContractExecutionAuthorization(*grant)
-			case "migration":
-				authorization = types.NewContractMigrationAuthorization(*grant)
+			case "execution", "execute-contracts", "migration", "update-admin", "clear-admin":
+				if filter == nil {
+					return errors.New("invalid filter setup")
+				}
+				grant, err := parseContractGrant(cmd.Flags(), args[2], limit, filter)
+				if err != nil {
+					return err
+				}
+				switch args[1] {
+				case "execution":
+					authorization = types.NewContractExecutionAuthorization(*grant)
+				case "execute-contracts":
+					authorization = types.NewExecuteContractsAuthorization(*grant)
+				case "migration":
+					authorization = types.NewContractMigrationAuthorization(*grant)
+				case "update-admin":
+					authorization = types.NewContractUpdateAdminAuthorization(*grant)
+				case "clear-admin":
+					authorization = types.NewContractClearAdminAuthorization(*grant)
+				}
+			case "instantiate", "instantiate2":
+				if filter == nil {
+					return errors.New("invalid filter setup")
+				}
+				codeID, err := strconv.ParseUint(args[2], 10, 64)
+				if err != nil {
+					return fmt.Errorf("code id: %s", err)
+				}
+				grant, err := types.NewInstantiateGrant(codeID, labels, admins, limit, filter)
+				if err != nil {
+					return err
+				}
+				if args[1] == "instantiate" {
+					authorization = types.NewContractInstantiationAuthorization(*grant)
+				} else {
+					authorization = types.NewContractInstantiation2Authorization(*grant)
+				}
+			case "store-code":
+				if filter != nil {
+					return errors.New("filter not supported for store code")
+				}
+				codeHash, err := hex.DecodeString(args[2])
+				if err != nil {
+					return fmt.Errorf("code hash: %s", err)
+				}
+				grant, err := types.NewCodeGrant(codeHash, nil, limit)
+				if err != nil {
+					return err
+				}
+				authorization = types.NewStoreCodeAuthorization(*grant)
 			default:
 				return fmt.Errorf("%s authorization type not supported", args[1])
 			}
@@ -552,9 +747,97 @@ $ %s tx grant <grantee_addr> execution <contract_addr> --allow-all-messages --ma
 	cmd.Flags().Int64(flagExpiration, 0, "The Unix timestamp.")
 	cmd.Flags().Bool(flagAllowAllMsgs, false, "Allow all messages")
 	cmd.Flags().Bool(flagNoTokenTransfer, false, "Don't allow token transfer")
+	cmd.Flags().Duration(flagLimitWindow, 0, "Apply max calls and max funds per window of time instead of in total, for example 24h")
+	cmd.Flags().Duration(flagCooldown, 0, "Minimum time between two calls. Requires no token transfer")
+	cmd.Flags().StringArray(flagJSONPathEquals, []string{}, "Allow only messages where the value at the JSON path equals the JSON value: path=json_value")
+	cmd.Flags().StringArray(flagJSONPathRange, []string{}, "Allow only messages where the numeric value at the JSON path is within the inclusive range: path=min:max, a bound can be empty")
+	cmd.Flags().StringArray(flagJSONPathAnyOf, []string{}, "Allow only messages where the string value at the JSON path is one of the values: path=value1,value2,...")
+	cmd.Flags().StringArray(flagJSONPathRegex, []string{}, "Allow only messages where the string value at the JSON path fully matches the regular expression: path=regex")
+	cmd.Flags().StringSlice(flagAllowedLabels, []string{}, "Allowed contract labels on instantiate. Any label when not set")
+	cmd.Flags().StringSlice(flagAllowedAdmins, []string{}, "Allowed contract admins on instantiate. Only the granter or no admin when not set")
+	cmd.Flags().UintSlice(flagMatchCodeIDs, []uint{}, "Grant for any contract instantiated from one of the code ids. The limit applies to all matched contracts together. Requires '*' as contract address")
+	cmd.Flags().String(flagMatchCreator, "", "Grant for any contract created by the address. The limit applies to all matched contracts together. Requires '*' as contract address")
 	return cmd
 }
 
+// parseContractGrant returns a grant for the contract address or, with '*' as address, for the contracts
+// matching the code ids or creator flags. The limit of these grants applies to each matched contract and to all
+// matched contracts together.
+func parseContractGrant(flags *flag.FlagSet, contractArg string, limit types.ContractAuthzLimitX, filter types.ContractAuthzFilterX) (*types.ContractGrant, error) {
+	codeIDArgs, err := flags.GetUintSlice(flagMatchCodeIDs)
+	if err != nil {
+		return nil, err
+	}
+	codeIDs := make([]uint64, len(codeIDArgs))
+	for i, v := range codeIDArgs {
+		codeIDs[i] = uint64(v)
+	}
+	creatorArg, err := flags.GetString(flagMatchCreator)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case contractArg != "*":
+		if len(codeIDs) != 0 || creatorArg != "" {
+			return nil, errors.New("code ids or creator require '*' as contract address")
+		}
+		contract, err := sdk.AccAddressFromBech32(contractArg)
+		if err != nil {
+			return nil, err
+		}
+		return types.NewContractGrant(contract, limit, filter)
+	case len(codeIDs) != 0 && creatorArg != "":
+		return nil, errors.New("cannot set code ids and creator within one grant")
+	case len(codeIDs) != 0:
+		return types.NewContractGrantForCodeIDs(codeIDs, limit, limit, filter)
+	case creatorArg != "":
+		creator, err := sdk.AccAddressFromBech32(creatorArg)
+		if err != nil {
+			return nil, fmt.Errorf("creator: %s", err)
+		}
+		return types.NewContractGrantForCreator(creator, limit, limit, filter)
+	default:
+		return nil, errors.New("code ids or creator required for '*' as contract address")
+	}
+}
+
+// parseJSONPathConditionFlags returns the json path filter conditions from the flags. All conditions must be met.
+func parseJSONPathConditionFlags(flags *flag.FlagSet) ([]types.JSONPathCondition, error) {
+	var conditions []types.JSONPathCondition
+	for _, flagName := range []string{flagJSONPathEquals, flagJSONPathRange, flagJSONPathAnyOf, flagJSONPathRegex} {
+		values, err := flags.GetStringArray(flagName)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range values {
+			path, value, ok := strings.Cut(v, "=")
+			if !ok || path == "" {
+				return nil, fmt.Errorf("%s: expected format path=value, got %q", flagName, v)
+			}
+			var c types.JSONPathCondition
+			switch flagName {
+			case flagJSONPathEquals:
+				c = types.NewJSONPathEqualsCondition(path, value)
+			case flagJSONPathRange:
+				min, max, ok := strings.Cut(value, ":")
+				if !ok {
+					return nil, fmt.Errorf("%s: expected format path=min:max, got %q", flagName, v)
+				}
+				c = types.NewJSONPathRangeCondition(path, min, max)
+			case flagJSONPathAnyOf:
+				c = types.NewJSONPathAnyOfCondition(path, strings.Split(value, ",")...)
+			case flagJSONPathRegex:
+				c = types.NewJSONPathRegexCondition(path, value)
+			}
+			if err := c.ValidateBasic(); err != nil {
+				return nil, fmt.Errorf("%s: %s", flagName, err)
+			}
+			conditions = append(conditions, c)
+		}
+	}
+	return conditions, nil
+}
+
 func getExpireTime(cmd *cobra.Command) (*time.Time, error) {
 	exp, err := cmd.Flags().GetInt64(flagExpiration)
 	if err != nil {
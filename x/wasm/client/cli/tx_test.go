@@ -2,6 +2,8 @@ package cli
 
 import (
 	"encoding/hex"
+	"os"
+	"path/filepath"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -122,3 +124,297 @@ func TestParseAccessConfigFlags(t *testing.T) {
 		})
 	}
 }
+
+func TestParseJSONPathConditionFlags(t *testing.T) {
+	specs := map[string]struct {
+		args   []string
+		exp    []types.JSONPathCondition
+		expErr bool
+	}{
+		"all matchers": {
+			args: []string{
+				`--json-path-eq=swap.pool_id="1"`,
+				"--json-path-range=swap.amount=1:1000",
+				"--json-path-range=swap.min_out=:5",
+				"--json-path-any-of=swap.recipient=foo,bar",
+				"--json-path-regex=swap.denom=u[a-z]+",
+			},
+			exp: []types.JSONPathCondition{
+				types.NewJSONPathEqualsCondition("swap.pool_id", `"1"`),
+				types.NewJSONPathRangeCondition("swap.amount", "1", "1000"),
+				types.NewJSONPathRangeCondition("swap.min_out", "", "5"),
+				types.NewJSONPathAnyOfCondition("swap.recipient", "foo", "bar"),
+				types.NewJSONPathRegexCondition("swap.denom", "u[a-z]+"),
+			},
+		},
+		"json value with equal sign": {
+			args: []string{`--json-path-eq=swap={"memo":"a=b"}`},
+			exp:  []types.JSONPathCondition{types.NewJSONPathEqualsCondition("swap", `{"memo":"a=b"}`)},
+		},
+		"not set": {
+			args: []string{},
+		},
+		"missing value": {
+			args:   []string{"--json-path-eq=swap.pool_id"},
+			expErr: true,
+		},
+		"missing path": {
+			args:   []string{"--json-path-eq==1"},
+			expErr: true,
+		},
+		"invalid json value": {
+			args:   []string{"--json-path-eq=swap.pool_id=foo"},
+			expErr: true,
+		},
+		"range without separator": {
+			args:   []string{"--json-path-range=swap.amount=1"},
+			expErr: true,
+		},
+		"invalid regex": {
+			args:   []string{"--json-path-regex=swap.denom=u[a-z"},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			flags := GrantAuthorizationCmd().Flags()
+			require.NoError(t, flags.Parse(spec.args))
+			got, gotErr := parseJSONPathConditionFlags(flags)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, got)
+		})
+	}
+}
+
+func TestParseContractGrant(t *testing.T) {
+	myContract := sdk.AccAddress(make([]byte, types.ContractAddrLen))
+	myCreator := sdk.AccAddress(make([]byte, types.SDKAddrLen))
+	limit, filter := types.NewMaxCallsLimit(1), types.NewAllowAllMessagesFilter()
+
+	specs := map[string]struct {
+		contractArg string
+		args        []string
+		exp         func() (*types.ContractGrant, error)
+		expErr      bool
+	}{
+		"contract": {
+			contractArg: myContract.String(),
+			exp:         func() (*types.ContractGrant, error) { return types.NewContractGrant(myContract, limit, filter) },
+		},
+		"code ids": {
+			contractArg: "*",
+			args:        []string{"--match-code-ids=1,2"},
+			exp: func() (*types.ContractGrant, error) {
+				return types.NewContractGrantForCodeIDs([]uint64{1, 2}, limit, limit, filter)
+			},
+		},
+		"creator": {
+			contractArg: "*",
+			args:        []string{"--match-creator=" + myCreator.String()},
+			exp: func() (*types.ContractGrant, error) {
+				return types.NewContractGrantForCreator(myCreator, limit, limit, filter)
+			},
+		},
+		"code ids and creator": {
+			contractArg: "*",
+			args:        []string{"--match-code-ids=1", "--match-creator=" + myCreator.String()},
+			expErr:      true,
+		},
+		"code ids with contract": {
+			contractArg: myContract.String(),
+			args:        []string{"--match-code-ids=1"},
+			expErr:      true,
+		},
+		"wildcard without match": {
+			contractArg: "*",
+			expErr:      true,
+		},
+		"invalid creator": {
+			contractArg: "*",
+			args:        []string{"--match-creator=foo"},
+			expErr:      true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			flags := GrantAuthorizationCmd().Flags()
+			require.NoError(t, flags.Parse(spec.args))
+			got, gotErr := parseContractGrant(flags, spec.contractArg, limit, filter)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			exp, err := spec.exp()
+			require.NoError(t, err)
+			assert.Equal(t, exp, got)
+		})
+	}
+}
+
+func TestParseExecuteContractsArgs(t *testing.T) {
+	mySender := sdk.AccAddress(make([]byte, types.SDKAddrLen))
+	myContract := sdk.AccAddress(make([]byte, types.ContractAddrLen)).String()
+	myExecutions := `[{"contract":"` + myContract + `","msg":{"foo":{}},"funds":"100stake"},{"contract":"` + myContract + `","msg":{"bar":"{{responses.0}}"}}]`
+	myFile := filepath.Join(t.TempDir(), "executions.json")
+	require.NoError(t, os.WriteFile(myFile, []byte(myExecutions), 0o600))
+	expMsg := types.MsgExecuteContracts{
+		Sender: mySender.String(),
+		Executions: []types.ContractExecution{
+			{Contract: myContract, Msg: []byte(`{"foo":{}}`), Funds: sdk.NewCoins(sdk.NewInt64Coin("stake", 100))},
+			{Contract: myContract, Msg: []byte(`{"bar":"{{responses.0}}"}`)},
+		},
+	}
+
+	specs := map[string]struct {
+		input  string
+		exp    types.MsgExecuteContracts
+		expErr bool
+	}{
+		"inline json": {
+			input: myExecutions,
+			exp:   expMsg,
+		},
+		"file": {
+			input: myFile,
+			exp:   expMsg,
+		},
+		"invalid funds": {
+			input:  `[{"contract":"` + myContract + `","msg":{},"funds":"-1stake"}]`,
+			expErr: true,
+		},
+		"not a list": {
+			input:  `{"contract":"` + myContract + `","msg":{}}`,
+			expErr: true,
+		},
+		"unknown file": {
+			input:  filepath.Join(t.TempDir(), "unknown.json"),
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			got, gotErr := parseExecuteContractsArgs(spec.input, mySender)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, got)
+		})
+	}
+}
+
+func TestParseUpdateContractMetadataArgs(t *testing.T) {
+	mySender := sdk.AccAddress(make([]byte, types.SDKAddrLen)).String()
+	myContract := sdk.AccAddress(make([]byte, types.ContractAddrLen)).String()
+
+	specs := map[string]struct {
+		args   []string
+		exp    types.ContractMetadata
+		expErr bool
+	}{
+		"all set": {
+			args: []string{
+				"--description=my contract", "--website=https://example.com", "--schema-url=https://example.com/schema.json",
+				"--icon=https://example.com/icon.png", "--tag=type=token", "--tag=audited=",
+			},
+			exp: types.ContractMetadata{
+				Description: "my contract",
+				Website:     "https://example.com",
+				SchemaURL:   "https://example.com/schema.json",
+				Icon:        "https://example.com/icon.png",
+				Tags:        []types.MetadataTag{{Key: "type", Value: "token"}, {Key: "audited"}},
+			},
+		},
+		"none set": {},
+		"tag value with separator": {
+			args: []string{"--tag=formula=a=b"},
+			exp:  types.ContractMetadata{Tags: []types.MetadataTag{{Key: "formula", Value: "a=b"}}},
+		},
+		"tag without separator": {
+			args:   []string{"--tag=type"},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			flagSet := UpdateContractMetadataCmd().Flags()
+			require.NoError(t, flagSet.Parse(spec.args))
+			got, gotErr := parseUpdateContractMetadataArgs([]string{myContract}, mySender, flagSet)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, types.MsgUpdateContractMetadata{Sender: mySender, Contract: myContract, Metadata: spec.exp}, got)
+		})
+	}
+}
+
+func TestParseSetContractSchemaArgs(t *testing.T) {
+	mySender := sdk.AccAddress(make([]byte, types.SDKAddrLen)).String()
+	dir := t.TempDir()
+	writeFile := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+		return path
+	}
+	myIDL := writeFile("contract.json", `{"contract_name": "my-contract", "instantiate": {"type": "object"}, "execute": {"oneOf": []}, "query": {"type": "string"}, "migrate": null}`)
+	myMigrate := writeFile("migrate_msg.json", `{"type": "null"}`)
+	myExecute := writeFile("execute_msg.json", `{"type": "object"}`)
+
+	specs := map[string]struct {
+		args   []string
+		exp    types.ContractSchema
+		expErr bool
+	}{
+		"idl": {
+			args: []string{"--idl=" + myIDL},
+			exp: types.ContractSchema{
+				Instantiate: []byte(`{"type": "object"}`),
+				Execute:     []byte(`{"oneOf": []}`),
+				Query:       []byte(`{"type": "string"}`),
+			},
+		},
+		"idl with message files": {
+			args: []string{"--idl=" + myIDL, "--migrate-schema=" + myMigrate, "--execute-schema=" + myExecute},
+			exp: types.ContractSchema{
+				Instantiate: []byte(`{"type": "object"}`),
+				Execute:     []byte(`{"type": "object"}`),
+				Query:       []byte(`{"type": "string"}`),
+				Migrate:     []byte(`{"type": "null"}`),
+			},
+		},
+		"message file only": {
+			args: []string{"--migrate-schema=" + myMigrate},
+			exp:  types.ContractSchema{Migrate: []byte(`{"type": "null"}`)},
+		},
+		"none set": {},
+		"unknown file": {
+			args:   []string{"--idl=" + filepath.Join(dir, "unknown.json")},
+			expErr: true,
+		},
+		"invalid idl": {
+			args:   []string{"--idl=" + writeFile("invalid.json", `[]`)},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			flagSet := SetContractSchemaCmd().Flags()
+			require.NoError(t, flagSet.Parse(spec.args))
+			got, gotErr := parseSetContractSchemaArgs([]string{"1"}, mySender, flagSet)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, types.MsgSetContractSchema{Sender: mySender, CodeID: 1, Schema: spec.exp}, got)
+		})
+	}
+}
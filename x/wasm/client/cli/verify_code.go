@@ -0,0 +1,143 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+const (
+	flagSourceDir = "source-dir"
+	flagDocker    = "docker"
+	flagSkipBuild = "skip-build"
+)
+
+// VerifyCodeCmd rebuilds a stored code from a local source checkout with the builder stored on chain
+// and compares the checksum of the build artifacts with the stored code
+func VerifyCodeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-code [code_id]",
+		Short: "Rebuild a stored code with its on-chain builder and compare the checksum",
+		Long: `Rebuild a stored code with the builder docker image that is stored on chain and compare the checksum.
+The source must be checked out locally at the revision referenced by the on-chain source url. The builder runs with
+the source directory mounted to /code and writes the wasm files to the "artifacts" directory. The code is verified
+when the checksum of any artifact matches the checksum of the stored code.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			codeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			sourceDir, err := cmd.Flags().GetString(flagSourceDir)
+			if err != nil {
+				return fmt.Errorf("source dir: %s", err)
+			}
+			if sourceDir, err = filepath.Abs(sourceDir); err != nil {
+				return fmt.Errorf("source dir: %s", err)
+			}
+			docker, err := cmd.Flags().GetString(flagDocker)
+			if err != nil {
+				return fmt.Errorf("docker: %s", err)
+			}
+			skipBuild, err := cmd.Flags().GetBool(flagSkipBuild)
+			if err != nil {
+				return fmt.Errorf("skip build: %s", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Code(
+				context.Background(),
+				&types.QueryCodeRequest{
+					CodeId: codeID,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			if res.CodeInfoResponse == nil {
+				return fmt.Errorf("code not found")
+			}
+			info := res.CodeInfoResponse
+			if info.Builder == "" {
+				return fmt.Errorf("no builder stored for code id: %d", codeID)
+			}
+
+			if !skipBuild {
+				build := exec.Command(docker, builderArgs(sourceDir, info.Builder)...) //nolint:gosec
+				build.Stdout = cmd.ErrOrStderr()
+				build.Stderr = cmd.ErrOrStderr()
+				if err := build.Run(); err != nil {
+					return fmt.Errorf("build with %s: %w", info.Builder, err)
+				}
+			}
+			artifact, err := findArtifact(filepath.Join(sourceDir, "artifacts"), info.DataHash)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintString(fmt.Sprintf("code %d verified: %s built with %s from %s matches checksum %s\n",
+				codeID, artifact, info.Builder, info.Source, info.DataHash))
+		},
+		SilenceUsage: true,
+	}
+	cmd.Flags().String(flagSourceDir, ".", "Local checkout of the code source")
+	cmd.Flags().String(flagDocker, "docker", "Docker executable to run the builder")
+	cmd.Flags().Bool(flagSkipBuild, false, "Skip the build and compare the existing artifacts only")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// builderArgs returns the docker arguments to run the builder image as documented for the cosmwasm optimizers
+func builderArgs(sourceDir, builder string) []string {
+	return []string{
+		"run", "--rm",
+		"-v", sourceDir + ":/code",
+		"--mount", fmt.Sprintf("type=volume,source=%s_cache,target=/target", filepath.Base(sourceDir)),
+		"--mount", "type=volume,source=registry_cache,target=/usr/local/cargo/registry",
+		builder,
+	}
+}
+
+// findArtifact returns the path of the wasm file in the given directory that matches the checksum
+func findArtifact(dir string, checksum []byte) (string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return "", fmt.Errorf("artifacts: %w", err)
+	}
+	var checked []string
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), ".wasm") {
+			continue
+		}
+		path := filepath.Join(dir, e.Name())
+		wasm, err := os.ReadFile(path)
+		if err != nil {
+			return "", err
+		}
+		got := sha256.Sum256(wasm)
+		if bytes.Equal(got[:], checksum) {
+			return path, nil
+		}
+		checked = append(checked, fmt.Sprintf("%s: %X", e.Name(), got))
+	}
+	if len(checked) == 0 {
+		return "", errors.New("no wasm artifacts found")
+	}
+	return "", fmt.Errorf("no artifact matches checksum %X: %s", checksum, strings.Join(checked, ", "))
+}
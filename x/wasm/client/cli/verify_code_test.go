@@ -0,0 +1,70 @@
+package cli
+
+import (
+	"crypto/sha256"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindArtifact(t *testing.T) {
+	myWasm := []byte("my wasm")
+	myChecksum := sha256.Sum256(myWasm)
+
+	specs := map[string]struct {
+		files    map[string][]byte
+		checksum []byte
+		expPath  string
+		expErr   bool
+	}{
+		"matching artifact": {
+			files:    map[string][]byte{"other.wasm": []byte("other"), "my.wasm": myWasm},
+			checksum: myChecksum[:],
+			expPath:  "my.wasm",
+		},
+		"no matching artifact": {
+			files:    map[string][]byte{"other.wasm": []byte("other")},
+			checksum: myChecksum[:],
+			expErr:   true,
+		},
+		"non wasm files ignored": {
+			files:    map[string][]byte{"checksums.txt": myWasm},
+			checksum: myChecksum[:],
+			expErr:   true,
+		},
+		"empty dir": {
+			checksum: myChecksum[:],
+			expErr:   true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			for n, c := range spec.files {
+				require.NoError(t, os.WriteFile(filepath.Join(dir, n), c, 0o600))
+			}
+			gotPath, gotErr := findArtifact(dir, spec.checksum)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, filepath.Join(dir, spec.expPath), gotPath)
+		})
+	}
+}
+
+func TestBuilderArgs(t *testing.T) {
+	got := builderArgs("/tmp/my-contract", "cosmwasm/rust-optimizer:0.12.13")
+	exp := []string{
+		"run", "--rm",
+		"-v", "/tmp/my-contract:/code",
+		"--mount", "type=volume,source=my-contract_cache,target=/target",
+		"--mount", "type=volume,source=registry_cache,target=/usr/local/cargo/registry",
+		"cosmwasm/rust-optimizer:0.12.13",
+	}
+	assert.Equal(t, exp, got)
+}
@@ -0,0 +1,121 @@
+package wasm
+
+import (
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+var _ porttypes.IBCModule = IBCQueryHandler{}
+
+// IBCQueryHandler is the host side of the cross-chain contract query protocol. It is bound to the
+// `types.IBCQueryPortID` and answers queries that contracts on a counterparty chain send over unordered
+// channels opened from their contract port. The query result is returned in the acknowledgement so that
+// it is delivered to the contract's `ibc_packet_ack` entry point on the counterparty chain.
+type IBCQueryHandler struct {
+	keeper types.IBCQueryHostKeeper
+}
+
+// NewIBCQueryHandler constructor
+func NewIBCQueryHandler(k types.IBCQueryHostKeeper) IBCQueryHandler {
+	return IBCQueryHandler{keeper: k}
+}
+
+// OnChanOpenInit implements the IBCModule interface. Query channels must be opened by the counterparty chain.
+func (i IBCQueryHandler) OnChanOpenInit(
+	_ sdk.Context,
+	_ channeltypes.Order,
+	_ []string,
+	_ string,
+	_ string,
+	_ *capabilitytypes.Capability,
+	_ channeltypes.Counterparty,
+	_ string,
+) (string, error) {
+	return "", errorsmod.Wrap(types.ErrInvalid, "query channels must be opened by the counterparty chain")
+}
+
+// OnChanOpenTry implements the IBCModule interface
+func (i IBCQueryHandler) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	_ []string,
+	portID, channelID string,
+	chanCap *capabilitytypes.Capability,
+	_ channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	if err := ValidateChannelParams(channelID); err != nil {
+		return "", err
+	}
+	if order != channeltypes.UNORDERED {
+		return "", errorsmod.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s channel, got %s", channeltypes.UNORDERED, order)
+	}
+	if counterpartyVersion != types.IBCQueryVersion {
+		return "", errorsmod.Wrapf(channeltypes.ErrInvalidChannelVersion, "expected %s, got %s", types.IBCQueryVersion, counterpartyVersion)
+	}
+	// Claim channel capability passed back by IBC module
+	if err := i.keeper.ClaimCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)); err != nil {
+		return "", errorsmod.Wrap(err, "claim capability")
+	}
+	return types.IBCQueryVersion, nil
+}
+
+// OnChanOpenAck implements the IBCModule interface. Not supported as the host never opens a channel.
+func (i IBCQueryHandler) OnChanOpenAck(_ sdk.Context, _, _ string, _ string, _ string) error {
+	return errorsmod.Wrap(types.ErrInvalid, "query channels must be opened by the counterparty chain")
+}
+
+// OnChanOpenConfirm implements the IBCModule interface
+func (i IBCQueryHandler) OnChanOpenConfirm(_ sdk.Context, _, _ string) error {
+	return nil
+}
+
+// OnChanCloseInit implements the IBCModule interface. Query channels can only be closed by the counterparty chain.
+func (i IBCQueryHandler) OnChanCloseInit(_ sdk.Context, _, _ string) error {
+	return errorsmod.Wrap(types.ErrInvalid, "user cannot close channel")
+}
+
+// OnChanCloseConfirm implements the IBCModule interface
+func (i IBCQueryHandler) OnChanCloseConfirm(_ sdk.Context, _, _ string) error {
+	return nil
+}
+
+// OnRecvPacket implements the IBCModule interface. The query is executed and the result returned as
+// acknowledgement. Any error is returned as error acknowledgement.
+func (i IBCQueryHandler) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	_ sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	var data types.IBCQueryPacketData
+	if err := json.Unmarshal(packet.GetData(), &data); err != nil {
+		return channeltypes.NewErrorAcknowledgement(errorsmod.Wrap(types.ErrInvalid, "packet data"))
+	}
+	if err := data.ValidateBasic(); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	res, err := i.keeper.OnRecvIBCQuery(ctx, packet.DestinationChannel, data.Request)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	return channeltypes.NewResultAcknowledgement(res)
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface. Not supported as the host never sends packets.
+func (i IBCQueryHandler) OnAcknowledgementPacket(_ sdk.Context, _ channeltypes.Packet, _ []byte, _ sdk.AccAddress) error {
+	return errorsmod.Wrap(types.ErrInvalid, "query host does not send packets")
+}
+
+// OnTimeoutPacket implements the IBCModule interface. Not supported as the host never sends packets.
+func (i IBCQueryHandler) OnTimeoutPacket(_ sdk.Context, _ channeltypes.Packet, _ sdk.AccAddress) error {
+	return errorsmod.Wrap(types.ErrInvalid, "query host does not send packets")
+}
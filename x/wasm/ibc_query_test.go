@@ -0,0 +1,143 @@
+package wasm
+
+import (
+	"testing"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestIBCQueryHandlerOnRecvPacket(t *testing.T) {
+	myQuery := wasmvmtypes.QueryRequest{Wasm: &wasmvmtypes.WasmQuery{Smart: &wasmvmtypes.SmartQuery{
+		ContractAddr: "cosmos1w09vr7rpe2agu0kg2zlpkdckce865l3zps8mxjurxthfh3m7035qe5hh7f",
+		Msg:          []byte(`{"votes":{}}`),
+	}}}
+	specs := map[string]struct {
+		data        []byte
+		keeperRsp   []byte
+		keeperErr   error
+		expRequest  *wasmvmtypes.QueryRequest
+		expAck      ibcexported.Acknowledgement
+		expRejected bool
+	}{
+		"query executed": {
+			data:       types.IBCQueryPacketData{Request: myQuery}.GetBytes(),
+			keeperRsp:  []byte(`{"yes":1}`),
+			expRequest: &myQuery,
+			expAck:     channeltypes.NewResultAcknowledgement([]byte(`{"yes":1}`)),
+		},
+		"query failed": {
+			data:       types.IBCQueryPacketData{Request: myQuery}.GetBytes(),
+			keeperErr:  types.ErrQueryFailed,
+			expRequest: &myQuery,
+			expAck:     channeltypes.NewErrorAcknowledgement(types.ErrQueryFailed),
+		},
+		"invalid packet data": {
+			data:        []byte("not json"),
+			expRejected: true,
+		},
+		"empty request": {
+			data:        []byte(`{"request":{}}`),
+			expRejected: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			var gotRequest *wasmvmtypes.QueryRequest
+			mock := IBCQueryHostKeeperMock{
+				OnRecvIBCQueryFn: func(ctx sdk.Context, channelID string, request wasmvmtypes.QueryRequest) ([]byte, error) {
+					assert.Equal(t, "channel-1", channelID)
+					gotRequest = &request
+					return spec.keeperRsp, spec.keeperErr
+				},
+			}
+			pkg := IBCPacketFixture(func(p *channeltypes.Packet) {
+				p.DestinationPort = types.IBCQueryPortID
+				p.DestinationChannel = "channel-1"
+				p.Data = spec.data
+			})
+			h := NewIBCQueryHandler(mock)
+			// when
+			gotAck := h.OnRecvPacket(sdk.Context{}, pkg, nil)
+			// then
+			assert.Equal(t, spec.expRequest, gotRequest)
+			if spec.expRejected {
+				require.False(t, gotAck.Success())
+				return
+			}
+			assert.Equal(t, spec.expAck, gotAck)
+		})
+	}
+}
+
+func TestIBCQueryHandlerOnChanOpenTry(t *testing.T) {
+	specs := map[string]struct {
+		order   channeltypes.Order
+		version string
+		expErr  bool
+	}{
+		"all good": {
+			order:   channeltypes.UNORDERED,
+			version: types.IBCQueryVersion,
+		},
+		"ordered channel": {
+			order:   channeltypes.ORDERED,
+			version: types.IBCQueryVersion,
+			expErr:  true,
+		},
+		"other version": {
+			order:   channeltypes.UNORDERED,
+			version: "ics20-1",
+			expErr:  true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			var capClaimed bool
+			mock := IBCQueryHostKeeperMock{
+				ClaimCapabilityFn: func(ctx sdk.Context, cap *capabilitytypes.Capability, name string) error {
+					capClaimed = true
+					return nil
+				},
+			}
+			h := NewIBCQueryHandler(mock)
+			gotVersion, gotErr := h.OnChanOpenTry(sdk.Context{}, spec.order, []string{"connection-0"}, types.IBCQueryPortID, "channel-1", &capabilitytypes.Capability{}, channeltypes.NewCounterparty("wasm.foo", "channel-2"), spec.version)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				assert.False(t, capClaimed)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, types.IBCQueryVersion, gotVersion)
+			assert.True(t, capClaimed)
+		})
+	}
+}
+
+var _ types.IBCQueryHostKeeper = IBCQueryHostKeeperMock{}
+
+type IBCQueryHostKeeperMock struct {
+	OnRecvIBCQueryFn  func(ctx sdk.Context, channelID string, request wasmvmtypes.QueryRequest) ([]byte, error)
+	ClaimCapabilityFn func(ctx sdk.Context, cap *capabilitytypes.Capability, name string) error
+}
+
+func (m IBCQueryHostKeeperMock) OnRecvIBCQuery(ctx sdk.Context, channelID string, request wasmvmtypes.QueryRequest) ([]byte, error) {
+	if m.OnRecvIBCQueryFn == nil {
+		panic("not expected to be called")
+	}
+	return m.OnRecvIBCQueryFn(ctx, channelID, request)
+}
+
+func (m IBCQueryHostKeeperMock) ClaimCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) error {
+	if m.ClaimCapabilityFn == nil {
+		panic("not expected to be called")
+	}
+	return m.ClaimCapabilityFn(ctx, cap, name)
+}
@@ -0,0 +1,83 @@
+package ioutils
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+const (
+	// wasmExportSectionID is the id of the export section
+	// See https://webassembly.github.io/spec/core/binary/modules.html#export-section
+	wasmExportSectionID = 7
+	// wasmExportKindFunc is the export descriptor of a function
+	wasmExportKindFunc = 0
+)
+
+// ExportedFunctions returns the names of all functions exported by the given wasm binary in the order they are
+// declared. The code is not validated beyond what is required to read the export section.
+func ExportedFunctions(wasmCode []byte) ([]string, error) {
+	if len(wasmCode) < 8 || !IsWasm(wasmCode) {
+		return nil, errors.New("not a wasm binary")
+	}
+	r := bytes.NewReader(wasmCode[8:]) // skip magic and version
+	for r.Len() != 0 {
+		id, err := r.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		size, err := binary.ReadUvarint(r)
+		if err != nil {
+			return nil, fmt.Errorf("section size: %w", err)
+		}
+		if size > uint64(r.Len()) {
+			return nil, errors.New("section exceeds code size")
+		}
+		if id != wasmExportSectionID {
+			if _, err := r.Seek(int64(size), io.SeekCurrent); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		section := make([]byte, size)
+		if _, err := io.ReadFull(r, section); err != nil {
+			return nil, err
+		}
+		return readExportSection(bytes.NewReader(section))
+	}
+	return nil, nil
+}
+
+func readExportSection(r *bytes.Reader) ([]string, error) {
+	count, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, fmt.Errorf("export count: %w", err)
+	}
+	var result []string
+	for i := uint64(0); i < count; i++ {
+		nameLen, err := binary.ReadUvarint(r)
+		if err != nil {
+			return nil, fmt.Errorf("export name length: %w", err)
+		}
+		if nameLen > uint64(r.Len()) {
+			return nil, errors.New("export name exceeds section size")
+		}
+		name := make([]byte, nameLen)
+		if _, err := io.ReadFull(r, name); err != nil {
+			return nil, err
+		}
+		kind, err := r.ReadByte()
+		if err != nil {
+			return nil, fmt.Errorf("export kind: %w", err)
+		}
+		if _, err := binary.ReadUvarint(r); err != nil {
+			return nil, fmt.Errorf("export index: %w", err)
+		}
+		if kind == wasmExportKindFunc {
+			result = append(result, string(name))
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,72 @@
+package ioutils
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportedFunctions(t *testing.T) {
+	hackatom, err := os.ReadFile("../keeper/testdata/hackatom.wasm")
+	require.NoError(t, err)
+	wasmHeader := []byte("\x00asm\x01\x00\x00\x00")
+
+	specs := map[string]struct {
+		src    []byte
+		expErr bool
+		exp    []string
+	}{
+		"hackatom": {
+			src: hackatom,
+			exp: []string{"instantiate", "migrate", "sudo", "execute", "query", "allocate", "deallocate", "interface_version_8"},
+		},
+		"no sections": {
+			src: wasmHeader,
+		},
+		"function, memory and empty name exports": {
+			src: append(wasmHeader,
+				0x07, 0x10, // export section with size
+				0x03,                            // 3 exports
+				0x03, 'f', 'o', 'o', 0x00, 0x01, // func foo
+				0x03, 'm', 'e', 'm', 0x02, 0x00, // memory mem
+				0x00, 0x00, 0x02, // func with empty name
+			),
+			exp: []string{"foo", ""},
+		},
+		"other sections skipped": {
+			src: append(wasmHeader,
+				0x00, 0x02, 0x01, 'x', // custom section
+				0x07, 0x05, 0x01, 0x01, 'a', 0x00, 0x00,
+			),
+			exp: []string{"a"},
+		},
+		"section exceeds code": {
+			src:    append(wasmHeader, 0x07, 0x05, 0x01),
+			expErr: true,
+		},
+		"name exceeds section": {
+			src:    append(wasmHeader, 0x07, 0x03, 0x01, 0x05, 'a'),
+			expErr: true,
+		},
+		"not wasm": {
+			src:    []byte("hello world"),
+			expErr: true,
+		},
+		"empty": {
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			got, gotErr := ExportedFunctions(spec.src)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, got)
+		})
+	}
+}
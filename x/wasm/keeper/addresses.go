@@ -4,6 +4,7 @@ import (
 	"encoding/binary"
 	"fmt"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 
@@ -31,6 +32,95 @@ func PredicableAddressGenerator(creator sdk.AccAddress, salt []byte, msg []byte,
 	}
 }
 
+// AddressGeneratorStrategy derives the addresses of contracts that are instantiated with a salt. Strategies are
+// registered with the keeper by name and selected via the module params.
+type AddressGeneratorStrategy interface {
+	// ValidateConfig returns an error when the strategy can not be used with the given params
+	ValidateConfig(cfg types.AddressGeneratorConfig) error
+	// Generator returns the address generator for a contract instantiated with a salt
+	Generator(cfg types.AddressGeneratorConfig, creator sdk.AccAddress, salt, initMsg []byte, fixMsg bool) AddressGenerator
+}
+
+var (
+	_ AddressGeneratorStrategy = PredictableAddressStrategy{}
+	_ AddressGeneratorStrategy = DomainSeparatedAddressStrategy{}
+)
+
+// defaultAddressGeneratorStrategies returns a new registry with the build-in strategies
+func defaultAddressGeneratorStrategies() map[string]AddressGeneratorStrategy {
+	return map[string]AddressGeneratorStrategy{
+		types.AddressGeneratorPredictable:     PredictableAddressStrategy{},
+		types.AddressGeneratorDomainSeparated: DomainSeparatedAddressStrategy{},
+	}
+}
+
+// PredictableAddressStrategy is the default strategy. Addresses are the same on all chains for the same
+// code checksum, creator, salt and optional init message.
+type PredictableAddressStrategy struct{}
+
+// ValidateConfig ensures that no domain is set
+func (PredictableAddressStrategy) ValidateConfig(cfg types.AddressGeneratorConfig) error {
+	if cfg.Domain != "" {
+		return errorsmod.Wrap(types.ErrInvalid, "domain not supported")
+	}
+	return nil
+}
+
+// Generator returns a PredicableAddressGenerator
+func (PredictableAddressStrategy) Generator(_ types.AddressGeneratorConfig, creator sdk.AccAddress, salt, initMsg []byte, fixMsg bool) AddressGenerator {
+	return PredicableAddressGenerator(creator, salt, initMsg, fixMsg)
+}
+
+// DomainSeparatedAddressStrategy includes a domain separator in the predictable address. Addresses are the same
+// on all chains that use the same domain. They do not match `cosmwasm_std::instantiate2_address` so that the
+// strategy does not apply to contracts that instantiate.
+type DomainSeparatedAddressStrategy struct{}
+
+// ValidateConfig ensures that a domain is set
+func (DomainSeparatedAddressStrategy) ValidateConfig(cfg types.AddressGeneratorConfig) error {
+	if cfg.Domain == "" {
+		return errorsmod.Wrap(types.ErrEmpty, "domain")
+	}
+	return nil
+}
+
+// Generator returns a generator for domain separated addresses
+func (DomainSeparatedAddressStrategy) Generator(cfg types.AddressGeneratorConfig, creator sdk.AccAddress, salt, initMsg []byte, fixMsg bool) AddressGenerator {
+	return func(ctx sdk.Context, _ uint64, checksum []byte) sdk.AccAddress {
+		if !fixMsg { // clear msg to not be included in the address generation
+			initMsg = []byte{}
+		}
+		return BuildContractAddressDomainSeparated(cfg.Domain, checksum, creator, salt, initMsg)
+	}
+}
+
+// predictableAddressGenerator returns the generator of the strategy that is selected in the params.
+// No gas is charged for the params lookup so that the instantiation costs do not depend on the strategy.
+// Contracts always get the predictable address as they compute it with `cosmwasm_std::instantiate2_address`.
+func (k Keeper) predictableAddressGenerator(ctx sdk.Context, creator sdk.AccAddress, salt, initMsg []byte, fixMsg bool) (AddressGenerator, error) {
+	if k.HasContractInfo(ctx, creator) {
+		return PredicableAddressGenerator(creator, salt, initMsg, fixMsg), nil
+	}
+	cfg := k.GetParams(ctx.WithGasMeter(sdk.NewInfiniteGasMeter())).AddressGenerator
+	s, err := k.addressGeneratorStrategy(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return s.Generator(cfg, creator, salt, initMsg, fixMsg), nil
+}
+
+// addressGeneratorStrategy returns the registered strategy when it accepts the given config
+func (k Keeper) addressGeneratorStrategy(cfg types.AddressGeneratorConfig) (AddressGeneratorStrategy, error) {
+	s, ok := k.addressGeneratorStrategies[cfg.StrategyName()]
+	if !ok {
+		return nil, errorsmod.Wrapf(types.ErrNotFound, "address generator strategy %q", cfg.StrategyName())
+	}
+	if err := s.ValidateConfig(cfg); err != nil {
+		return nil, errorsmod.Wrapf(err, "address generator strategy %q", cfg.StrategyName())
+	}
+	return s, nil
+}
+
 // BuildContractAddressClassic builds an sdk account address for a contract.
 func BuildContractAddressClassic(codeID, instanceID uint64) sdk.AccAddress {
 	contractID := make([]byte, 16)
@@ -46,6 +136,23 @@ func BuildContractAddressClassic(codeID, instanceID uint64) sdk.AccAddress {
 //
 // All method parameter values must be valid and not nil.
 func BuildContractAddressPredictable(checksum []byte, creator sdk.AccAddress, salt, initMsg types.RawContractMessage) sdk.AccAddress {
+	return address.Module(types.ModuleName, predictableAddressKey(checksum, creator, salt, initMsg))[:types.ContractAddrLen]
+}
+
+// BuildContractAddressDomainSeparated generates a contract address like BuildContractAddressPredictable but the
+// internal key is prefixed with the length prefixed domain:
+// (len(domain) | domain | len(checksum) | checksum | len(sender_address) | sender_address | len(salt) | salt| len(initMsg) | initMsg).
+//
+// All method parameter values must be valid and not nil.
+func BuildContractAddressDomainSeparated(domain string, checksum []byte, creator sdk.AccAddress, salt, initMsg types.RawContractMessage) sdk.AccAddress {
+	if domain == "" {
+		panic("empty domain")
+	}
+	key := append(UInt64LengthPrefix([]byte(domain)), predictableAddressKey(checksum, creator, salt, initMsg)...)
+	return address.Module(types.ModuleName, key)[:types.ContractAddrLen]
+}
+
+func predictableAddressKey(checksum []byte, creator sdk.AccAddress, salt, initMsg types.RawContractMessage) []byte {
 	if len(checksum) != 32 {
 		panic("invalid checksum")
 	}
@@ -67,7 +174,7 @@ func BuildContractAddressPredictable(checksum []byte, creator sdk.AccAddress, sa
 	copy(key[len(checksum):], creator)
 	copy(key[len(checksum)+len(creator):], salt)
 	copy(key[len(checksum)+len(creator)+len(salt):], initMsg)
-	return address.Module(types.ModuleName, key)[:types.ContractAddrLen]
+	return key
 }
 
 // UInt64LengthPrefix prepend big endian encoded byte length
@@ -1,13 +1,17 @@
 package keeper
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"testing"
 
 	tmbytes "github.com/cometbft/cometbft/libs/bytes"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
 )
 
 func TestBuildContractAddress(t *testing.T) {
@@ -43,6 +47,126 @@ func TestBuildContractAddress(t *testing.T) {
 	}
 }
 
+func TestBuildContractAddressDomainSeparated(t *testing.T) {
+	checksum := bytes.Repeat([]byte{1}, 32)
+	creator := RandomAccountAddress(t)
+	salt := []byte("my salt")
+	myAddr := BuildContractAddressDomainSeparated("my-domain", checksum, creator, salt, []byte{})
+	require.NoError(t, sdk.VerifyAddressFormat(myAddr))
+
+	// same domain generates the same address
+	assert.Equal(t, myAddr, BuildContractAddressDomainSeparated("my-domain", checksum, creator, salt, []byte{}))
+	// other domain generates a different address
+	assert.NotEqual(t, myAddr, BuildContractAddressDomainSeparated("other-domain", checksum, creator, salt, []byte{}))
+	// predictable address is different
+	assert.NotEqual(t, myAddr, BuildContractAddressPredictable(checksum, creator, salt, []byte{}))
+	// init message is included
+	assert.NotEqual(t, myAddr, BuildContractAddressDomainSeparated("my-domain", checksum, creator, salt, []byte(`{}`)))
+	// empty domain not supported
+	assert.Panics(t, func() {
+		BuildContractAddressDomainSeparated("", checksum, creator, salt, []byte{})
+	})
+}
+
+func TestAddressGeneratorStrategy(t *testing.T) {
+	myCustomAddr := RandomAccountAddress(t)
+	myCustomStrategy := customAddressStrategy(func(types.AddressGeneratorConfig, sdk.AccAddress, []byte, []byte, bool) AddressGenerator {
+		return func(sdk.Context, uint64, []byte) sdk.AccAddress { return myCustomAddr }
+	})
+	specs := map[string]struct {
+		cfg        types.AddressGeneratorConfig
+		expAddr    func(checksum []byte, creator sdk.AccAddress, salt []byte) sdk.AccAddress
+		expInvalid bool
+	}{
+		"default": {
+			expAddr: func(checksum []byte, creator sdk.AccAddress, salt []byte) sdk.AccAddress {
+				return BuildContractAddressPredictable(checksum, creator, salt, []byte{})
+			},
+		},
+		"predictable": {
+			cfg: types.AddressGeneratorConfig{Strategy: types.AddressGeneratorPredictable},
+			expAddr: func(checksum []byte, creator sdk.AccAddress, salt []byte) sdk.AccAddress {
+				return BuildContractAddressPredictable(checksum, creator, salt, []byte{})
+			},
+		},
+		"domain separated": {
+			cfg: types.AddressGeneratorConfig{Strategy: types.AddressGeneratorDomainSeparated, Domain: "my-domain"},
+			expAddr: func(checksum []byte, creator sdk.AccAddress, salt []byte) sdk.AccAddress {
+				return BuildContractAddressDomainSeparated("my-domain", checksum, creator, salt, []byte{})
+			},
+		},
+		"custom": {
+			cfg: types.AddressGeneratorConfig{Strategy: "custom"},
+			expAddr: func([]byte, sdk.AccAddress, []byte) sdk.AccAddress {
+				return myCustomAddr
+			},
+		},
+		"unknown strategy": {
+			cfg:        types.AddressGeneratorConfig{Strategy: "unknown"},
+			expInvalid: true,
+		},
+		"predictable with domain": {
+			cfg:        types.AddressGeneratorConfig{Strategy: types.AddressGeneratorPredictable, Domain: "my-domain"},
+			expInvalid: true,
+		},
+		"domain separated without domain": {
+			cfg:        types.AddressGeneratorConfig{Strategy: types.AddressGeneratorDomainSeparated},
+			expInvalid: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, keepers := CreateTestInput(t, false, AvailableCapabilities, WithAddressGeneratorStrategy("custom", myCustomStrategy))
+			example := StoreHackatomExampleContract(t, ctx, keepers)
+			params := keepers.WasmKeeper.GetParams(ctx)
+			params.AddressGenerator = spec.cfg
+
+			// when
+			gotErr := keepers.WasmKeeper.SetParams(ctx, params)
+			// then
+			if spec.expInvalid {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+
+			// and when
+			salt := []byte("my salt")
+			initMsg := HackatomExampleInitMsg{Verifier: RandomAccountAddress(t), Beneficiary: RandomAccountAddress(t)}.GetBytes(t)
+			gotAddr, _, err := keepers.ContractKeeper.Instantiate2(ctx, example.CodeID, example.CreatorAddr, nil, initMsg, "label", nil, salt, false)
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, spec.expAddr(example.Checksum, example.CreatorAddr, salt), gotAddr)
+
+			// and when a contract instantiates
+			gotAddr, _, err = keepers.ContractKeeper.Instantiate2(ctx, example.CodeID, gotAddr, nil, initMsg, "label", nil, salt, false)
+			// then the address matches cosmwasm_std::instantiate2_address
+			require.NoError(t, err)
+			creatorContract := spec.expAddr(example.Checksum, example.CreatorAddr, salt)
+			assert.Equal(t, BuildContractAddressPredictable(example.Checksum, creatorContract, salt, []byte{}), gotAddr)
+		})
+	}
+}
+
+func TestWithAddressGeneratorStrategy(t *testing.T) {
+	myStrategy := customAddressStrategy(nil)
+	assert.Panics(t, func() { WithAddressGeneratorStrategy("", myStrategy) })
+	assert.Panics(t, func() { WithAddressGeneratorStrategy("Invalid Name", myStrategy) })
+	assert.Panics(t, func() { WithAddressGeneratorStrategy("custom", nil) })
+	assert.Panics(t, func() {
+		WithAddressGeneratorStrategy(types.AddressGeneratorPredictable, myStrategy).apply(&Keeper{addressGeneratorStrategies: defaultAddressGeneratorStrategies()})
+	})
+}
+
+// customAddressStrategy accepts any config
+type customAddressStrategy func(cfg types.AddressGeneratorConfig, creator sdk.AccAddress, salt, initMsg []byte, fixMsg bool) AddressGenerator
+
+func (customAddressStrategy) ValidateConfig(types.AddressGeneratorConfig) error { return nil }
+
+func (c customAddressStrategy) Generator(cfg types.AddressGeneratorConfig, creator sdk.AccAddress, salt, initMsg []byte, fixMsg bool) AddressGenerator {
+	return c(cfg, creator, salt, initMsg, fixMsg)
+}
+
 const goldenMasterPredictableContractAddr = `[
   {
     "in": {
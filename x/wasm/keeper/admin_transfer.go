@@ -0,0 +1,158 @@
+package keeper
+
+import (
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// proposeContractAdmin records the new admin of a contract that has to accept the transfer before the expiry. An
+// existing pending transfer is replaced.
+func (k Keeper) proposeContractAdmin(ctx sdk.Context, contractAddress, caller, newAdmin sdk.AccAddress, expiryBlocks uint64, authZ AuthorizationPolicy) error {
+	contractInfo := k.GetContractInfo(ctx, contractAddress)
+	if contractInfo == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unknown contract")
+	}
+	if !authZ.CanModifyContract(contractInfo.AdminAddr(), caller) {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "can not modify contract")
+	}
+	if newAdmin.Equals(contractInfo.AdminAddr()) {
+		return errorsmod.Wrap(types.ErrInvalid, "new admin is the same as the old")
+	}
+	if expiryBlocks == 0 {
+		expiryBlocks = types.DefaultAdminTransferExpiryBlocks
+	}
+	k.deletePendingAdminTransfer(ctx, contractAddress)
+	transfer := types.PendingAdminTransfer{
+		Contract:     contractAddress.String(),
+		NewAdmin:     newAdmin.String(),
+		Proposer:     caller.String(),
+		ExpiryHeight: ctx.BlockHeight() + int64(expiryBlocks),
+	}
+	k.storePendingAdminTransfer(ctx, contractAddress, newAdmin, transfer)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeProposeContractAdmin,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, transfer.Contract),
+		sdk.NewAttribute(types.AttributeKeyNewAdmin, transfer.NewAdmin),
+		sdk.NewAttribute(types.AttributeKeyExpiryHeight, strconv.FormatInt(transfer.ExpiryHeight, 10)),
+	))
+	return nil
+}
+
+// acceptContractAdmin sets the caller as admin when proposed before and not expired. An expired transfer is removed
+// without an error so that the removal is persisted, the admin is not changed then.
+func (k Keeper) acceptContractAdmin(ctx sdk.Context, contractAddress, caller sdk.AccAddress) error {
+	transfer := k.GetPendingAdminTransfer(ctx, contractAddress)
+	if transfer == nil {
+		return errorsmod.Wrap(types.ErrNotFound, "pending admin transfer")
+	}
+	if transfer.NewAdmin != caller.String() {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "not the proposed admin")
+	}
+	if transfer.IsExpired(ctx.BlockHeight()) {
+		k.deletePendingAdminTransfer(ctx, contractAddress)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeExpireAdminTransfer,
+			sdk.NewAttribute(types.AttributeKeyContractAddr, transfer.Contract),
+			sdk.NewAttribute(types.AttributeKeyNewAdmin, transfer.NewAdmin),
+			sdk.NewAttribute(types.AttributeKeyExpiryHeight, strconv.FormatInt(transfer.ExpiryHeight, 10)),
+		))
+		return nil
+	}
+	contractInfo := k.GetContractInfo(ctx, contractAddress)
+	if contractInfo == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unknown contract")
+	}
+	k.updateContractAdmin(ctx, contractAddress, contractInfo, caller)
+	return nil
+}
+
+// cancelAdminTransfer removes the pending admin transfer. The current admin can cancel the transfer and the proposed
+// admin can decline it.
+func (k Keeper) cancelAdminTransfer(ctx sdk.Context, contractAddress, caller sdk.AccAddress, authZ AuthorizationPolicy) error {
+	transfer := k.GetPendingAdminTransfer(ctx, contractAddress)
+	if transfer == nil {
+		return errorsmod.Wrap(types.ErrNotFound, "pending admin transfer")
+	}
+	contractInfo := k.GetContractInfo(ctx, contractAddress)
+	if contractInfo == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unknown contract")
+	}
+	if transfer.NewAdmin != caller.String() && !authZ.CanModifyContract(contractInfo.AdminAddr(), caller) {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "can not modify contract")
+	}
+	k.deletePendingAdminTransfer(ctx, contractAddress)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCancelAdminTransfer,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, transfer.Contract),
+		sdk.NewAttribute(types.AttributeKeyNewAdmin, transfer.NewAdmin),
+	))
+	return nil
+}
+
+// GetPendingAdminTransfer returns the pending admin transfer of the contract or nil when not found. Expired transfers
+// are returned until removed by a new proposal, an accept or a cancel. Callers have to check the expiry.
+func (k Keeper) GetPendingAdminTransfer(ctx sdk.Context, contractAddress sdk.AccAddress) *types.PendingAdminTransfer {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetPendingAdminTransferKey(contractAddress))
+	if bz == nil {
+		return nil
+	}
+	var transfer types.PendingAdminTransfer
+	k.cdc.MustUnmarshal(bz, &transfer)
+	return &transfer
+}
+
+// IteratePendingAdminTransfers iterates over all pending admin transfers ordered by contract address
+func (k Keeper) IteratePendingAdminTransfers(ctx sdk.Context, cb func(types.PendingAdminTransfer) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingAdminTransferPrefix).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var transfer types.PendingAdminTransfer
+		k.cdc.MustUnmarshal(iter.Value(), &transfer)
+		if cb(transfer) {
+			return
+		}
+	}
+}
+
+func (k Keeper) storePendingAdminTransfer(ctx sdk.Context, contractAddress, newAdmin sdk.AccAddress, transfer types.PendingAdminTransfer) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPendingAdminTransferKey(contractAddress), k.cdc.MustMarshal(&transfer))
+	store.Set(types.GetPendingAdminTransferByNewAdminKey(newAdmin, contractAddress), []byte{})
+}
+
+// deletePendingAdminTransfer removes the pending admin transfer with the new admin index entry, if any
+func (k Keeper) deletePendingAdminTransfer(ctx sdk.Context, contractAddress sdk.AccAddress) {
+	transfer := k.GetPendingAdminTransfer(ctx, contractAddress)
+	if transfer == nil {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPendingAdminTransferKey(contractAddress))
+	store.Delete(types.GetPendingAdminTransferByNewAdminKey(sdk.MustAccAddressFromBech32(transfer.NewAdmin), contractAddress))
+}
+
+// importPendingAdminTransfer stores a pending admin transfer from genesis
+func (k Keeper) importPendingAdminTransfer(ctx sdk.Context, transfer types.PendingAdminTransfer) error {
+	contractAddress, err := sdk.AccAddressFromBech32(transfer.Contract)
+	if err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	newAdmin, err := sdk.AccAddressFromBech32(transfer.NewAdmin)
+	if err != nil {
+		return errorsmod.Wrap(err, "new admin")
+	}
+	if !k.HasContractInfo(ctx, contractAddress) {
+		return types.ErrNoSuchContractFn(transfer.Contract).Wrapf("address %s", transfer.Contract)
+	}
+	if k.GetPendingAdminTransfer(ctx, contractAddress) != nil {
+		return errorsmod.Wrapf(types.ErrDuplicate, "pending admin transfer for %s", transfer.Contract)
+	}
+	k.storePendingAdminTransfer(ctx, contractAddress, newAdmin, transfer)
+	return nil
+}
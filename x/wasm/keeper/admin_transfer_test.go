@@ -0,0 +1,262 @@
+package keeper
+
+import (
+	"strconv"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestProposeAndAcceptAdmin(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	oldAdmin, newAdmin := example.CreatorAddr, RandomAccountAddress(t)
+	msgServer := NewMsgServerImpl(k)
+
+	// when proposed by the admin
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err := msgServer.ProposeAdmin(sdk.WrapSDKContext(ctx), &types.MsgProposeAdmin{
+		Sender:       oldAdmin.String(),
+		Contract:     example.Contract.String(),
+		NewAdmin:     newAdmin.String(),
+		ExpiryBlocks: 10,
+	})
+	// then the transfer is pending
+	require.NoError(t, err)
+	expTransfer := types.PendingAdminTransfer{
+		Contract:     example.Contract.String(),
+		NewAdmin:     newAdmin.String(),
+		Proposer:     oldAdmin.String(),
+		ExpiryHeight: ctx.BlockHeight() + 10,
+	}
+	assert.Equal(t, &expTransfer, k.GetPendingAdminTransfer(ctx, example.Contract))
+	assert.Equal(t, oldAdmin.String(), k.GetContractInfo(ctx, example.Contract).Admin)
+	assert.Equal(t, sdk.Events{sdk.NewEvent(types.EventTypeProposeContractAdmin,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, example.Contract.String()),
+		sdk.NewAttribute(types.AttributeKeyNewAdmin, newAdmin.String()),
+		sdk.NewAttribute(types.AttributeKeyExpiryHeight, strconv.FormatInt(expTransfer.ExpiryHeight, 10)),
+	)}, ctx.EventManager().Events())
+
+	// and can be queried
+	querier := NewGrpcQuerier(k.cdc, k.storeKey, k, k.queryGasLimit)
+	gotTransfer, err := querier.PendingAdminTransfer(sdk.WrapSDKContext(ctx), &types.QueryPendingAdminTransferRequest{Address: example.Contract.String()})
+	require.NoError(t, err)
+	assert.Equal(t, expTransfer, gotTransfer.Transfer)
+	gotTransfers, err := querier.PendingAdminTransfersByNewAdmin(sdk.WrapSDKContext(ctx), &types.QueryPendingAdminTransfersByNewAdminRequest{
+		NewAdmin:   newAdmin.String(),
+		Pagination: &query.PageRequest{Limit: 10},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []types.PendingAdminTransfer{expTransfer}, gotTransfers.Transfers)
+
+	// when accepted by any other address
+	_, err = msgServer.AcceptAdmin(sdk.WrapSDKContext(ctx), &types.MsgAcceptAdmin{Sender: RandomBech32AccountAddress(t), Contract: example.Contract.String()})
+	// then
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// when accepted by the new admin
+	_, err = msgServer.AcceptAdmin(sdk.WrapSDKContext(ctx), &types.MsgAcceptAdmin{Sender: newAdmin.String(), Contract: example.Contract.String()})
+	// then the admin and the admin index are updated
+	require.NoError(t, err)
+	assert.Equal(t, newAdmin.String(), k.GetContractInfo(ctx, example.Contract).Admin)
+	assert.Equal(t, []sdk.AccAddress{example.Contract}, contractsByAdmin(ctx, k, newAdmin))
+	assert.Empty(t, contractsByAdmin(ctx, k, oldAdmin))
+	// and the transfer is removed
+	assert.Nil(t, k.GetPendingAdminTransfer(ctx, example.Contract))
+	gotTransfers, err = querier.PendingAdminTransfersByNewAdmin(sdk.WrapSDKContext(ctx), &types.QueryPendingAdminTransfersByNewAdminRequest{NewAdmin: newAdmin.String()})
+	require.NoError(t, err)
+	assert.Empty(t, gotTransfers.Transfers)
+	_, err = querier.PendingAdminTransfer(sdk.WrapSDKContext(ctx), &types.QueryPendingAdminTransferRequest{Address: example.Contract.String()})
+	require.ErrorIs(t, err, types.ErrNotFound)
+
+	// and can not be accepted twice
+	_, err = msgServer.AcceptAdmin(sdk.WrapSDKContext(ctx), &types.MsgAcceptAdmin{Sender: newAdmin.String(), Contract: example.Contract.String()})
+	require.ErrorIs(t, err, types.ErrNotFound)
+}
+
+func TestProposeAdmin(t *testing.T) {
+	specs := map[string]struct {
+		sender       func(example HackatomExampleInstance, authority string) string
+		newAdmin     func(example HackatomExampleInstance) string
+		expiryBlocks uint64
+		expErr       error
+		expExpiry    int64
+	}{
+		"admin": {
+			sender:    func(example HackatomExampleInstance, _ string) string { return example.CreatorAddr.String() },
+			newAdmin:  func(HackatomExampleInstance) string { return RandomBech32AccountAddress(t) },
+			expExpiry: int64(types.DefaultAdminTransferExpiryBlocks),
+		},
+		"gov authority": {
+			sender:       func(_ HackatomExampleInstance, authority string) string { return authority },
+			newAdmin:     func(HackatomExampleInstance) string { return RandomBech32AccountAddress(t) },
+			expiryBlocks: 1,
+			expExpiry:    1,
+		},
+		"not admin": {
+			sender:   func(HackatomExampleInstance, string) string { return RandomBech32AccountAddress(t) },
+			newAdmin: func(HackatomExampleInstance) string { return RandomBech32AccountAddress(t) },
+			expErr:   sdkerrors.ErrUnauthorized,
+		},
+		"current admin": {
+			sender:   func(_ HackatomExampleInstance, authority string) string { return authority },
+			newAdmin: func(example HackatomExampleInstance) string { return example.CreatorAddr.String() },
+			expErr:   types.ErrInvalid,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+			k := keepers.WasmKeeper
+			example := InstantiateHackatomExampleContract(t, ctx, keepers)
+			newAdmin := spec.newAdmin(example)
+
+			// when
+			_, gotErr := NewMsgServerImpl(k).ProposeAdmin(sdk.WrapSDKContext(ctx), &types.MsgProposeAdmin{
+				Sender:       spec.sender(example, k.GetAuthority()),
+				Contract:     example.Contract.String(),
+				NewAdmin:     newAdmin,
+				ExpiryBlocks: spec.expiryBlocks,
+			})
+			// then
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				assert.Nil(t, k.GetPendingAdminTransfer(ctx, example.Contract))
+				return
+			}
+			require.NoError(t, gotErr)
+			gotTransfer := k.GetPendingAdminTransfer(ctx, example.Contract)
+			require.NotNil(t, gotTransfer)
+			assert.Equal(t, newAdmin, gotTransfer.NewAdmin)
+			assert.Equal(t, ctx.BlockHeight()+spec.expExpiry, gotTransfer.ExpiryHeight)
+		})
+	}
+}
+
+func TestAcceptAdminExpired(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	newAdmin := RandomAccountAddress(t)
+	require.NoError(t, k.proposeContractAdmin(ctx, example.Contract, example.CreatorAddr, newAdmin, 2, DefaultAuthorizationPolicy{}))
+
+	// when accepted in the last block
+	lastCtx, _ := ctx.WithBlockHeight(ctx.BlockHeight() + 2).CacheContext()
+	// then
+	require.NoError(t, k.acceptContractAdmin(lastCtx, example.Contract, newAdmin))
+
+	// when queried after the expiry
+	expiredCtx, _ := ctx.WithBlockHeight(ctx.BlockHeight() + 3).CacheContext()
+	querier := NewGrpcQuerier(k.cdc, k.storeKey, k, k.queryGasLimit)
+	_, err := querier.PendingAdminTransfer(sdk.WrapSDKContext(expiredCtx), &types.QueryPendingAdminTransferRequest{Address: example.Contract.String()})
+	// then
+	require.ErrorIs(t, err, types.ErrNotFound)
+	gotTransfers, err := querier.PendingAdminTransfersByNewAdmin(sdk.WrapSDKContext(expiredCtx), &types.QueryPendingAdminTransfersByNewAdminRequest{NewAdmin: newAdmin.String()})
+	require.NoError(t, err)
+	assert.Empty(t, gotTransfers.Transfers)
+
+	// when accepted after the expiry
+	em := sdk.NewEventManager()
+	require.NoError(t, k.acceptContractAdmin(expiredCtx.WithEventManager(em), example.Contract, newAdmin))
+	// then the admin is not changed
+	assert.Equal(t, example.CreatorAddr.String(), k.GetContractInfo(expiredCtx, example.Contract).Admin)
+	// and the expired transfer is removed with the index entry
+	assert.Nil(t, k.GetPendingAdminTransfer(expiredCtx, example.Contract))
+	assert.False(t, expiredCtx.KVStore(k.storeKey).Has(types.GetPendingAdminTransferByNewAdminKey(newAdmin, example.Contract)))
+	require.Len(t, em.Events(), 1)
+	assert.Equal(t, types.EventTypeExpireAdminTransfer, em.Events()[0].Type)
+}
+
+func TestCancelAdminTransfer(t *testing.T) {
+	newAdmin := RandomAccountAddress(t)
+	specs := map[string]struct {
+		sender func(example HackatomExampleInstance, authority string) string
+		expErr error
+	}{
+		"admin": {
+			sender: func(example HackatomExampleInstance, _ string) string { return example.CreatorAddr.String() },
+		},
+		"proposed admin": {
+			sender: func(HackatomExampleInstance, string) string { return newAdmin.String() },
+		},
+		"gov authority": {
+			sender: func(_ HackatomExampleInstance, authority string) string { return authority },
+		},
+		"any other address": {
+			sender: func(HackatomExampleInstance, string) string { return RandomBech32AccountAddress(t) },
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+			k := keepers.WasmKeeper
+			example := InstantiateHackatomExampleContract(t, ctx, keepers)
+			require.NoError(t, k.proposeContractAdmin(ctx, example.Contract, example.CreatorAddr, newAdmin, 0, DefaultAuthorizationPolicy{}))
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+			// when
+			_, gotErr := NewMsgServerImpl(k).CancelAdminTransfer(sdk.WrapSDKContext(ctx), &types.MsgCancelAdminTransfer{
+				Sender:   spec.sender(example, k.GetAuthority()),
+				Contract: example.Contract.String(),
+			})
+			// then
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				assert.NotNil(t, k.GetPendingAdminTransfer(ctx, example.Contract))
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Nil(t, k.GetPendingAdminTransfer(ctx, example.Contract))
+			assert.Empty(t, pendingAdminTransfersTo(ctx, k, newAdmin))
+			assert.Equal(t, sdk.Events{sdk.NewEvent(types.EventTypeCancelAdminTransfer,
+				sdk.NewAttribute(types.AttributeKeyContractAddr, example.Contract.String()),
+				sdk.NewAttribute(types.AttributeKeyNewAdmin, newAdmin.String()),
+			)}, ctx.EventManager().Events())
+		})
+	}
+}
+
+func TestUpdateAdminDropsPendingAdminTransfer(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	proposedAdmin, govAdmin := RandomAccountAddress(t), RandomAccountAddress(t)
+	require.NoError(t, k.proposeContractAdmin(ctx, example.Contract, example.CreatorAddr, proposedAdmin, 0, DefaultAuthorizationPolicy{}))
+
+	// when the admin is set by governance
+	authority, err := sdk.AccAddressFromBech32(k.GetAuthority())
+	require.NoError(t, err)
+	require.NoError(t, k.setContractAdmin(ctx, example.Contract, authority, govAdmin, GovAuthorizationPolicy{}))
+
+	// then
+	assert.Equal(t, govAdmin.String(), k.GetContractInfo(ctx, example.Contract).Admin)
+	assert.Nil(t, k.GetPendingAdminTransfer(ctx, example.Contract))
+	assert.Empty(t, pendingAdminTransfersTo(ctx, k, proposedAdmin))
+	require.ErrorIs(t, k.acceptContractAdmin(ctx, example.Contract, proposedAdmin), types.ErrNotFound)
+}
+
+func contractsByAdmin(ctx sdk.Context, k *Keeper, admin sdk.AccAddress) []sdk.AccAddress {
+	var contracts []sdk.AccAddress
+	k.IterateContractsByAdmin(ctx, admin, func(addr sdk.AccAddress) bool {
+		contracts = append(contracts, addr)
+		return false
+	})
+	return contracts
+}
+
+func pendingAdminTransfersTo(ctx sdk.Context, k *Keeper, newAdmin sdk.AccAddress) []types.PendingAdminTransfer {
+	querier := NewGrpcQuerier(k.cdc, k.storeKey, k, k.queryGasLimit)
+	rsp, err := querier.PendingAdminTransfersByNewAdmin(sdk.WrapSDKContext(ctx), &types.QueryPendingAdminTransfersByNewAdminRequest{NewAdmin: newAdmin.String()})
+	if err != nil {
+		panic(err)
+	}
+	return rsp.Transfers
+}
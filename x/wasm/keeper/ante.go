@@ -95,3 +95,22 @@ func (d LimitSimulationGasDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simu
 	}
 	return next(ctx, tx, simulate)
 }
+
+// ContractInfoSourceDecorator ante decorator to provide the contract info to the wasm authz grants for
+// code ids or a creator. See `types.ContractInfoSourceFromContext(ctx)` to read the value.
+type ContractInfoSourceDecorator struct {
+	source types.ContractInfoSource
+}
+
+// NewContractInfoSourceDecorator constructor
+func NewContractInfoSourceDecorator(source types.ContractInfoSource) *ContractInfoSourceDecorator {
+	if source == nil {
+		panic("contract info source must not be nil")
+	}
+	return &ContractInfoSourceDecorator{source: source}
+}
+
+// AnteHandle passes the contract info source via sdk.Context upstream
+func (d ContractInfoSourceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	return next(types.WithContractInfoSource(ctx, d.source), tx, simulate)
+}
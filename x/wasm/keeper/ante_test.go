@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -181,6 +182,42 @@ func TestLimitSimulationGasDecorator(t *testing.T) {
 	}
 }
 
+func TestContractInfoSourceDecorator(t *testing.T) {
+	myContractAddr := keeper.RandomAccountAddress(t)
+	source := contractInfoSourceFn(func(_ sdk.Context, contractAddress sdk.AccAddress) *types.ContractInfo {
+		if contractAddress.Equals(myContractAddr) {
+			return &types.ContractInfo{CodeID: 1}
+		}
+		return nil
+	})
+	var anyTx sdk.Tx
+	var nextCalled bool
+	next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		gotSource, ok := types.ContractInfoSourceFromContext(ctx)
+		require.True(t, ok)
+		assert.Equal(t, &types.ContractInfo{CodeID: 1}, gotSource.GetContractInfo(ctx, myContractAddr))
+		nextCalled = true
+		return ctx, nil
+	}
+	ctx := sdk.Context{}.WithContext(context.Background())
+	_, gotSourceFound := types.ContractInfoSourceFromContext(ctx)
+	require.False(t, gotSourceFound)
+
+	// when
+	ante := keeper.NewContractInfoSourceDecorator(source)
+	_, gotErr := ante.AnteHandle(ctx, anyTx, false, next)
+
+	// then
+	require.NoError(t, gotErr)
+	assert.True(t, nextCalled)
+}
+
+type contractInfoSourceFn func(ctx sdk.Context, contractAddress sdk.AccAddress) *types.ContractInfo
+
+func (f contractInfoSourceFn) GetContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) *types.ContractInfo {
+	return f(ctx, contractAddress)
+}
+
 func consumeGasAnteHandler(gasToConsume sdk.Gas) sdk.AnteHandler {
 	return func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
 		ctx.GasMeter().ConsumeGas(gasToConsume, "testing")
@@ -0,0 +1,159 @@
+package keeper
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// GetContractAuthzGrants returns the grants of all wasm contract authorizations that reference the contract,
+// either by address or by code id and creator. Instantiate grants are matched by the code id of the contract.
+// The limits are the current state for the contract with the remaining usage at the block time. Expired grants
+// and grants that can not be decoded are skipped. Requires the authz keeper to be set via the `WithAuthzKeeper` option.
+//
+// The authz store has no index by contract so that the grants are scanned. A page ends with the page limit of
+// matching grants or after types.MaxContractAuthzGrantsScan scanned authz grants, whichever comes first. It can
+// therefore have less grants than the limit and still a next key. All grants of a single authorization are returned
+// on the same page. The page key is an opaque cursor to the next authz grant to scan. Offsets and total counts are
+// not supported as they require a scan over all authz grants.
+func (k Keeper) GetContractAuthzGrants(ctx sdk.Context, contractAddr sdk.AccAddress, pagination *query.PageRequest) ([]types.ContractAuthzGrant, *query.PageResponse, error) {
+	if k.authzKeeper == nil {
+		return nil, nil, sdkerrors.ErrNotSupported.Wrap("authz keeper not set")
+	}
+	start, limit, err := authzGrantsPage(pagination)
+	if err != nil {
+		return nil, nil, err
+	}
+	contract := contractAddr.String()
+	contractInfo := k.GetContractInfo(ctx, contractAddr)
+	result := make([]types.ContractAuthzGrant, 0)
+	var pos, scanned uint64
+	var nextKey []byte
+	k.authzKeeper.IterateGrants(ctx, func(granterAddr, granteeAddr sdk.AccAddress, grant authz.Grant) bool {
+		if pos < start {
+			pos++
+			return false
+		}
+		if uint64(len(result)) >= limit || scanned >= types.MaxContractAuthzGrantsScan {
+			nextKey = sdk.Uint64ToBigEndian(pos)
+			return true
+		}
+		pos++
+		scanned++
+		if grant.Expiration != nil && grant.Expiration.Before(ctx.BlockTime()) {
+			return false
+		}
+		authorization, err := grant.GetAuthorization()
+		if err != nil {
+			return false
+		}
+		for _, g := range contractAuthzGrantsOf(authorization, contract, contractInfo) {
+			g.Granter, g.Grantee = granterAddr.String(), granteeAddr.String()
+			g.MsgTypeURL = authorization.MsgTypeURL()
+			g.Expiration = grant.Expiration
+			g.Remaining = remainingUsage(ctx, g.Limit)
+			result = append(result, g)
+		}
+		return false
+	})
+	return result, &query.PageResponse{NextKey: nextKey}, nil
+}
+
+// contractAuthzGrantsOf returns the grants of a wasm authorization that reference the contract. Granter, grantee,
+// type, expiration and remaining usage are not set.
+func contractAuthzGrantsOf(authorization authz.Authorization, contract string, contractInfo *types.ContractInfo) []types.ContractAuthzGrant {
+	var grants []types.ContractGrant
+	switch a := authorization.(type) {
+	case *types.ContractExecutionAuthorization:
+		grants = a.Grants
+	case *types.ExecuteContractsAuthorization:
+		grants = a.Grants
+	case *types.ContractMigrationAuthorization:
+		grants = a.Grants
+	case *types.ContractUpdateAdminAuthorization:
+		grants = a.Grants
+	case *types.ContractClearAdminAuthorization:
+		grants = a.Grants
+	case *types.ContractInstantiationAuthorization:
+		return instantiateAuthzGrantsOf(a.Grants, contractInfo)
+	case *types.ContractInstantiation2Authorization:
+		return instantiateAuthzGrantsOf(a.Grants, contractInfo)
+	default:
+		return nil
+	}
+	var result []types.ContractAuthzGrant
+	for _, g := range grants {
+		matchedBy := g.MatchedBy(contract, contractInfo)
+		if matchedBy == "" {
+			continue
+		}
+		result = append(result, types.ContractAuthzGrant{
+			MatchedBy:  matchedBy,
+			Limit:      g.LimitStateFor(contract),
+			Filter:     g.Filter,
+			TotalLimit: g.TotalLimit,
+		})
+	}
+	return result
+}
+
+// instantiateAuthzGrantsOf returns the instantiate grants for the code id of the contract
+func instantiateAuthzGrantsOf(grants []types.InstantiateGrant, contractInfo *types.ContractInfo) []types.ContractAuthzGrant {
+	if contractInfo == nil {
+		return nil
+	}
+	var result []types.ContractAuthzGrant
+	for _, g := range grants {
+		if g.CodeID != contractInfo.CodeID {
+			continue
+		}
+		result = append(result, types.ContractAuthzGrant{
+			MatchedBy: types.ContractGrantMatchedByCodeID,
+			Limit:     g.Limit,
+			Filter:    g.Filter,
+		})
+	}
+	return result
+}
+
+// authzGrantsPage returns the scan position to start at and the limit of the page request. The key is the big endian
+// encoded position of the next authz grant to scan as returned in the page response.
+func authzGrantsPage(pagination *query.PageRequest) (start, limit uint64, err error) {
+	if pagination == nil {
+		return 0, query.DefaultLimit, nil
+	}
+	if pagination.Offset != 0 {
+		return 0, 0, sdkerrors.ErrInvalidRequest.Wrap("offset not supported, use the page key")
+	}
+	if pagination.CountTotal {
+		return 0, 0, sdkerrors.ErrInvalidRequest.Wrap("count total not supported")
+	}
+	if len(pagination.Key) != 0 {
+		if len(pagination.Key) != 8 {
+			return 0, 0, sdkerrors.ErrInvalidRequest.Wrap("invalid pagination key")
+		}
+		start = sdk.BigEndianToUint64(pagination.Key)
+	}
+	limit = pagination.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+	return start, limit, nil
+}
+
+// remainingUsage returns the remaining usage of the limit at the block time. A lapsed limit has no usage left.
+// The result is empty for limits that do not report their usage.
+func remainingUsage(ctx sdk.Context, limit *codectypes.Any) types.ContractAuthzRemaining {
+	if limit == nil {
+		return types.ContractAuthzRemaining{CallsLimited: true, FundsLimited: true, Funds: sdk.NewCoins()}
+	}
+	l, ok := limit.GetCachedValue().(types.ContractAuthzLimitRemaining)
+	if !ok {
+		return types.ContractAuthzRemaining{}
+	}
+	return l.RemainingUsage(ctx.BlockTime())
+}
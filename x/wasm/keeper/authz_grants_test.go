@@ -0,0 +1,215 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestGetContractAuthzGrants(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	ctx = ctx.WithBlockTime(time.Now().UTC())
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	otherContract := RandomAccountAddress(t)
+	granter, grantee := RandomAccountAddress(t), RandomAccountAddress(t)
+	expiration := ctx.BlockTime().Add(time.Hour)
+	oneToken := sdk.NewCoins(sdk.NewInt64Coin("denom", 1))
+
+	mustGrant := func(g *types.ContractGrant, err error) types.ContractGrant {
+		require.NoError(t, err)
+		return *g
+	}
+	myExecGrant := mustGrant(types.NewContractGrant(example.Contract, types.NewCombinedLimit(2, oneToken...), types.NewAllowAllMessagesFilter()))
+	myCodeIDGrant := mustGrant(types.NewContractGrantForCodeIDs([]uint64{example.CodeID}, types.NewMaxCallsLimit(3), types.NewMaxCallsLimit(10), types.NewAcceptedMessageKeysFilter("release")))
+	myCreatorGrant := mustGrant(types.NewContractGrantForCreator(example.CreatorAddr, types.NewMaxFundsLimit(oneToken...), types.NewMaxFundsLimit(oneToken...), types.NewAllowAllMessagesFilter()))
+	otherGrant := mustGrant(types.NewContractGrant(otherContract, types.NewMaxCallsLimit(1), types.NewAllowAllMessagesFilter()))
+	myInstantiateGrant, err := types.NewInstantiateGrant(example.CodeID, nil, nil, types.NewMaxCallsLimit(4), types.NewAllowAllMessagesFilter())
+	require.NoError(t, err)
+	otherInstantiateGrant, err := types.NewInstantiateGrant(example.CodeID+1, nil, nil, types.NewMaxCallsLimit(4), types.NewAllowAllMessagesFilter())
+	require.NoError(t, err)
+
+	authzKeeper := authzKeeperMock{
+		grants: []authzKeeperMockGrant{
+			{granter: granter, grantee: grantee, authorization: types.NewContractExecutionAuthorization(otherGrant, myExecGrant), expiration: &expiration},
+			{granter: granter, grantee: grantee, authorization: types.NewContractMigrationAuthorization(myCodeIDGrant)},
+			{granter: grantee, grantee: granter, authorization: types.NewContractClearAdminAuthorization(myCreatorGrant)},
+			{granter: granter, grantee: grantee, authorization: authz.NewGenericAuthorization(sdk.MsgTypeURL(&types.MsgExecuteContract{}))},
+			{granter: granter, grantee: grantee, authorization: banktypes.NewSendAuthorization(oneToken, nil)},
+			{granter: granter, grantee: grantee, authorization: types.NewExecuteContractsAuthorization(myExecGrant)},
+			{granter: granter, grantee: grantee, authorization: types.NewContractInstantiationAuthorization(*myInstantiateGrant, *otherInstantiateGrant)},
+		},
+	}
+	expiredGrant, err := authz.NewGrant(ctx.BlockTime().Add(-2*time.Hour), types.NewContractMigrationAuthorization(myExecGrant), ptr(ctx.BlockTime().Add(-time.Hour)))
+	require.NoError(t, err)
+
+	// when authz keeper not set
+	_, _, gotErr := k.GetContractAuthzGrants(ctx, example.Contract, nil)
+	// then
+	require.ErrorIs(t, gotErr, sdkerrors.ErrNotSupported)
+
+	// when
+	authzKeeper = authzKeeper.withBlockTime(t, ctx.BlockTime())
+	authzKeeper.grants = append(authzKeeper.grants, authzKeeperMockGrant{granter: granter, grantee: grantee, grant: expiredGrant})
+	// grants that can not be decoded are skipped
+	undecodableGrant := authz.Grant{Authorization: &codectypes.Any{TypeUrl: "/unknown.Authorization", Value: []byte{0x1}}}
+	authzKeeper.grants = append([]authzKeeperMockGrant{{granter: granter, grantee: grantee, grant: undecodableGrant}}, authzKeeper.grants...)
+	k.authzKeeper = authzKeeper
+	gotGrants, gotPage, gotErr := k.GetContractAuthzGrants(ctx, example.Contract, nil)
+
+	// then
+	require.NoError(t, gotErr)
+	assert.Nil(t, gotPage.NextKey)
+	require.Len(t, gotGrants, 5)
+	assert.Equal(t, types.ContractAuthzGrant{
+		Granter:    granter.String(),
+		Grantee:    grantee.String(),
+		MsgTypeURL: sdk.MsgTypeURL(&types.MsgExecuteContract{}),
+		Expiration: &expiration,
+		MatchedBy:  types.ContractGrantMatchedByContract,
+		Limit:      myExecGrant.Limit,
+		Filter:     myExecGrant.Filter,
+		Remaining:  types.ContractAuthzRemaining{CallsLimited: true, Calls: 2, FundsLimited: true, Funds: oneToken},
+	}, gotGrants[0])
+	assert.Equal(t, types.ContractAuthzGrant{
+		Granter:    granter.String(),
+		Grantee:    grantee.String(),
+		MsgTypeURL: sdk.MsgTypeURL(&types.MsgMigrateContract{}),
+		MatchedBy:  types.ContractGrantMatchedByCodeID,
+		Limit:      myCodeIDGrant.Limit,
+		Filter:     myCodeIDGrant.Filter,
+		TotalLimit: myCodeIDGrant.TotalLimit,
+		Remaining:  types.ContractAuthzRemaining{CallsLimited: true, Calls: 3, FundsLimited: true, Funds: sdk.NewCoins()},
+	}, gotGrants[1])
+	assert.Equal(t, types.ContractAuthzGrant{
+		Granter:    grantee.String(),
+		Grantee:    granter.String(),
+		MsgTypeURL: sdk.MsgTypeURL(&types.MsgClearAdmin{}),
+		MatchedBy:  types.ContractGrantMatchedByCreator,
+		Limit:      myCreatorGrant.Limit,
+		Filter:     myCreatorGrant.Filter,
+		TotalLimit: myCreatorGrant.TotalLimit,
+		Remaining:  types.ContractAuthzRemaining{FundsLimited: true, Funds: oneToken},
+	}, gotGrants[2])
+	assert.Equal(t, sdk.MsgTypeURL(&types.MsgExecuteContracts{}), gotGrants[3].MsgTypeURL)
+	assert.Equal(t, types.ContractGrantMatchedByContract, gotGrants[3].MatchedBy)
+	assert.Equal(t, types.ContractAuthzGrant{
+		Granter:    granter.String(),
+		Grantee:    grantee.String(),
+		MsgTypeURL: sdk.MsgTypeURL(&types.MsgInstantiateContract{}),
+		MatchedBy:  types.ContractGrantMatchedByCodeID,
+		Limit:      myInstantiateGrant.Limit,
+		Filter:     myInstantiateGrant.Filter,
+		Remaining:  types.ContractAuthzRemaining{CallsLimited: true, Calls: 4, FundsLimited: true, Funds: sdk.NewCoins()},
+	}, gotGrants[4])
+
+	// and the querier returns the same
+	q := Querier(k)
+	res, gotErr := q.ContractAuthzGrants(sdk.WrapSDKContext(ctx), &types.QueryContractAuthzGrantsRequest{Address: example.Contract.String()})
+	require.NoError(t, gotErr)
+	assert.Equal(t, gotGrants, res.Grants)
+
+	// and pages are returned
+	res, gotErr = q.ContractAuthzGrants(sdk.WrapSDKContext(ctx), &types.QueryContractAuthzGrantsRequest{
+		Address:    example.Contract.String(),
+		Pagination: &query.PageRequest{Limit: 2},
+	})
+	require.NoError(t, gotErr)
+	assert.Equal(t, gotGrants[:2], res.Grants)
+	require.NotNil(t, res.Pagination.NextKey)
+	res, gotErr = q.ContractAuthzGrants(sdk.WrapSDKContext(ctx), &types.QueryContractAuthzGrantsRequest{
+		Address:    example.Contract.String(),
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2},
+	})
+	require.NoError(t, gotErr)
+	assert.Equal(t, gotGrants[2:4], res.Grants)
+	require.NotNil(t, res.Pagination.NextKey)
+	res, gotErr = q.ContractAuthzGrants(sdk.WrapSDKContext(ctx), &types.QueryContractAuthzGrantsRequest{
+		Address:    example.Contract.String(),
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2},
+	})
+	require.NoError(t, gotErr)
+	assert.Equal(t, gotGrants[4:], res.Grants)
+	assert.Nil(t, res.Pagination.NextKey)
+
+	// and the scanned authz grants per page are limited
+	defer func(old uint64) { types.MaxContractAuthzGrantsScan = old }(types.MaxContractAuthzGrantsScan)
+	types.MaxContractAuthzGrantsScan = 2
+	var pagedGrants []types.ContractAuthzGrant
+	var pages int
+	for pageReq := (&query.PageRequest{}); pageReq != nil; pages++ {
+		res, gotErr = q.ContractAuthzGrants(sdk.WrapSDKContext(ctx), &types.QueryContractAuthzGrantsRequest{
+			Address:    example.Contract.String(),
+			Pagination: pageReq,
+		})
+		require.NoError(t, gotErr)
+		assert.LessOrEqual(t, len(res.Grants), 2)
+		pagedGrants = append(pagedGrants, res.Grants...)
+		pageReq = nil
+		if res.Pagination.NextKey != nil {
+			pageReq = &query.PageRequest{Key: res.Pagination.NextKey}
+		}
+	}
+	assert.Equal(t, gotGrants, pagedGrants)
+	assert.Equal(t, 5, pages)
+
+	// and offset and count total are rejected
+	for _, pageReq := range []*query.PageRequest{{Offset: 1}, {CountTotal: true}} {
+		_, gotErr = q.ContractAuthzGrants(sdk.WrapSDKContext(ctx), &types.QueryContractAuthzGrantsRequest{
+			Address:    example.Contract.String(),
+			Pagination: pageReq,
+		})
+		assert.ErrorIs(t, gotErr, sdkerrors.ErrInvalidRequest)
+	}
+
+	// and unknown contracts are rejected
+	_, gotErr = q.ContractAuthzGrants(sdk.WrapSDKContext(ctx), &types.QueryContractAuthzGrantsRequest{Address: otherContract.String()})
+	assert.ErrorIs(t, gotErr, types.ErrNoSuchContractFn(""))
+}
+
+type authzKeeperMockGrant struct {
+	granter, grantee sdk.AccAddress
+	authorization    authz.Authorization
+	expiration       *time.Time
+	grant            authz.Grant
+}
+
+type authzKeeperMock struct {
+	grants []authzKeeperMockGrant
+}
+
+func (m authzKeeperMock) withBlockTime(t *testing.T, blockTime time.Time) authzKeeperMock {
+	for i, g := range m.grants {
+		grant, err := authz.NewGrant(blockTime, g.authorization, g.expiration)
+		require.NoError(t, err)
+		m.grants[i].grant = grant
+	}
+	return m
+}
+
+func (m authzKeeperMock) IterateGrants(_ sdk.Context, handler func(granterAddr sdk.AccAddress, granteeAddr sdk.AccAddress, grant authz.Grant) bool) {
+	for _, g := range m.grants {
+		if handler(g.granter, g.grantee, g.grant) {
+			return
+		}
+	}
+}
+
+func (m authzKeeperMock) GetAuthorization(_ sdk.Context, grantee, granter sdk.AccAddress, msgType string) (authz.Authorization, *time.Time) {
+	for _, g := range m.grants {
+		if g.granter.Equals(granter) && g.grantee.Equals(grantee) && g.authorization.MsgTypeURL() == msgType {
+			return g.authorization, g.expiration
+		}
+	}
+	return nil, nil
+}
@@ -25,13 +25,17 @@ type decoratedKeeper interface {
 
 	migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, newCodeID uint64, msg []byte, authZ AuthorizationPolicy) ([]byte, error)
 	setContractAdmin(ctx sdk.Context, contractAddress, caller, newAdmin sdk.AccAddress, authZ AuthorizationPolicy) error
+	setContractMetadata(ctx sdk.Context, contractAddress, caller sdk.AccAddress, metadata types.ContractMetadata, authZ AuthorizationPolicy) error
+	setContractSchema(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, schema types.ContractSchema, authZ AuthorizationPolicy) error
 	pinCode(ctx sdk.Context, codeID uint64) error
 	unpinCode(ctx sdk.Context, codeID uint64) error
 	execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) ([]byte, error)
 	Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error)
 	setContractInfoExtension(ctx sdk.Context, contract sdk.AccAddress, extra types.ContractInfoExtension) error
 	setAccessConfig(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, newConfig types.AccessConfig, autz AuthorizationPolicy) error
+	setCodeVerificationInfo(ctx sdk.Context, codeID uint64, source, builder string, codeHash []byte) error
 	ClassicAddressGenerator() AddressGenerator
+	predictableAddressGenerator(ctx sdk.Context, creator sdk.AccAddress, salt, initMsg []byte, fixMsg bool) (AddressGenerator, error)
 }
 
 type PermissionedKeeper struct {
@@ -67,7 +71,7 @@ func (p PermissionedKeeper) Instantiate(
 	return p.nested.instantiate(ctx, codeID, creator, admin, initMsg, label, deposit, p.nested.ClassicAddressGenerator(), p.authZPolicy)
 }
 
-// Instantiate2 creates an instance of a WASM contract using the predictable address generator
+// Instantiate2 creates an instance of a WASM contract using the predictable address generator strategy selected in the params
 func (p PermissionedKeeper) Instantiate2(
 	ctx sdk.Context,
 	codeID uint64,
@@ -78,6 +82,10 @@ func (p PermissionedKeeper) Instantiate2(
 	salt []byte,
 	fixMsg bool,
 ) (sdk.AccAddress, []byte, error) {
+	addrGenerator, err := p.nested.predictableAddressGenerator(ctx, creator, salt, initMsg, fixMsg)
+	if err != nil {
+		return nil, nil, err
+	}
 	return p.nested.instantiate(
 		ctx,
 		codeID,
@@ -86,7 +94,7 @@ func (p PermissionedKeeper) Instantiate2(
 		initMsg,
 		label,
 		deposit,
-		PredicableAddressGenerator(creator, salt, initMsg, fixMsg),
+		addrGenerator,
 		p.authZPolicy,
 	)
 }
@@ -111,10 +119,25 @@ func (p PermissionedKeeper) ClearContractAdmin(ctx sdk.Context, contractAddress
 	return p.nested.setContractAdmin(ctx, contractAddress, caller, nil, p.authZPolicy)
 }
 
+// UpdateContractMetadata replaces the descriptive metadata of a contract
+func (p PermissionedKeeper) UpdateContractMetadata(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, metadata types.ContractMetadata) error {
+	return p.nested.setContractMetadata(ctx, contractAddress, caller, metadata, p.authZPolicy)
+}
+
+// SetContractSchema sets the JSON schema of the messages of a code once
+func (p PermissionedKeeper) SetContractSchema(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, schema types.ContractSchema) error {
+	return p.nested.setContractSchema(ctx, codeID, caller, schema, p.authZPolicy)
+}
+
 func (p PermissionedKeeper) PinCode(ctx sdk.Context, codeID uint64) error {
 	return p.nested.pinCode(ctx, codeID)
 }
 
+// SetCodeVerificationInfo stores the source and builder of a code id when the code hash matches the checksum
+func (p PermissionedKeeper) SetCodeVerificationInfo(ctx sdk.Context, codeID uint64, source, builder string, codeHash []byte) error {
+	return p.nested.setCodeVerificationInfo(ctx, codeID, source, builder, codeHash)
+}
+
 func (p PermissionedKeeper) UnpinCode(ctx sdk.Context, codeID uint64) error {
 	return p.nested.unpinCode(ctx, codeID)
 }
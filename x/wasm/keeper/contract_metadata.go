@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// setContractMetadata replaces the descriptive metadata of a contract. Only the contract admin is allowed to
+// modify the metadata.
+func (k Keeper) setContractMetadata(ctx sdk.Context, contractAddress, caller sdk.AccAddress, metadata types.ContractMetadata, authZ AuthorizationPolicy) error {
+	contractInfo := k.GetContractInfo(ctx, contractAddress)
+	if contractInfo == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unknown contract")
+	}
+	if !authZ.CanModifyContract(contractInfo.AdminAddr(), caller) {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "can not modify contract")
+	}
+	if err := k.storeContractMetadata(ctx, contractAddress, metadata); err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUpdateContractMetadata,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddress.String()),
+	))
+	return nil
+}
+
+// storeContractMetadata persists the metadata and updates the tag index. An empty metadata deletes the record.
+func (k Keeper) storeContractMetadata(ctx sdk.Context, contractAddress sdk.AccAddress, metadata types.ContractMetadata) error {
+	if err := metadata.ValidateBasic(); err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	if old := k.GetContractMetadata(ctx, contractAddress); old != nil {
+		for _, t := range old.Tags {
+			store.Delete(types.GetContractByMetadataTagSecondaryIndexKey(t, contractAddress))
+		}
+	}
+	key := types.GetContractMetadataKey(contractAddress)
+	if metadata.IsEmpty() {
+		store.Delete(key)
+		return nil
+	}
+	store.Set(key, k.cdc.MustMarshal(&metadata))
+	for _, t := range metadata.Tags {
+		store.Set(types.GetContractByMetadataTagSecondaryIndexKey(t, contractAddress), []byte{})
+	}
+	return nil
+}
+
+// GetContractMetadata returns the descriptive metadata of a contract or nil when not set
+func (k Keeper) GetContractMetadata(ctx sdk.Context, contractAddress sdk.AccAddress) *types.ContractMetadata {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetContractMetadataKey(contractAddress))
+	if bz == nil {
+		return nil
+	}
+	var metadata types.ContractMetadata
+	k.cdc.MustUnmarshal(bz, &metadata)
+	return &metadata
+}
+
+// IterateContractsByMetadataTag iterates over all contracts with the given metadata tag key. When a value is given,
+// only contracts with the exact key/value pair are returned. Contracts are ordered by tag value and address.
+func (k Keeper) IterateContractsByMetadataTag(ctx sdk.Context, key, value string, cb func(address sdk.AccAddress) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), contractsByMetadataTagPrefix(key, value))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if cb(parseContractsByMetadataTagKey(iter.Key(), value)) {
+			return
+		}
+	}
+}
+
+// contractsByMetadataTagPrefix returns the store prefix of the metadata tag index. All values of the tag key are
+// matched when value is empty.
+func contractsByMetadataTagPrefix(key, value string) []byte {
+	if value == "" {
+		return types.GetContractsByMetadataTagKeyPrefix(key)
+	}
+	return types.GetContractsByMetadataTagPrefix(key, value)
+}
+
+// parseContractsByMetadataTagKey returns the contract address of a key in the store prefixed by
+// contractsByMetadataTagPrefix with the same value.
+func parseContractsByMetadataTagKey(key []byte, value string) sdk.AccAddress {
+	if value == "" {
+		// skip length prefixed value
+		return key[1+int(key[0]):]
+	}
+	return key
+}
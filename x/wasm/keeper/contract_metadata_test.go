@@ -0,0 +1,167 @@
+package keeper
+
+import (
+	"testing"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestUpdateContractMetadata(t *testing.T) {
+	myMetadata := types.ContractMetadata{
+		Description: "my contract",
+		Website:     "https://example.com",
+		SchemaURL:   "https://example.com/schema.json",
+		Icon:        "https://example.com/icon.png",
+		Tags:        []types.MetadataTag{{Key: "type", Value: "token"}},
+	}
+	specs := map[string]struct {
+		sender      func(example HackatomExampleInstance) string
+		contract    func(example HackatomExampleInstance) string
+		expErr      *errorsmod.Error
+		expMetadata *types.ContractMetadata
+	}{
+		"admin": {
+			sender:      func(example HackatomExampleInstance) string { return example.CreatorAddr.String() },
+			contract:    func(example HackatomExampleInstance) string { return example.Contract.String() },
+			expMetadata: &myMetadata,
+		},
+		"not admin": {
+			sender:   func(example HackatomExampleInstance) string { return example.VerifierAddr.String() },
+			contract: func(example HackatomExampleInstance) string { return example.Contract.String() },
+			expErr:   sdkerrors.ErrUnauthorized,
+		},
+		"unknown contract": {
+			sender:   func(example HackatomExampleInstance) string { return example.CreatorAddr.String() },
+			contract: func(example HackatomExampleInstance) string { return RandomBech32AccountAddress(t) },
+			expErr:   sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+			example := InstantiateHackatomExampleContract(t, ctx, keepers)
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			msgServer := NewMsgServerImpl(keepers.WasmKeeper)
+
+			// when
+			_, gotErr := msgServer.UpdateContractMetadata(sdk.WrapSDKContext(ctx), &types.MsgUpdateContractMetadata{
+				Sender:   spec.sender(example),
+				Contract: spec.contract(example),
+				Metadata: myMetadata,
+			})
+			// then
+			if spec.expErr != nil {
+				require.True(t, spec.expErr.Is(gotErr), gotErr)
+				assert.Nil(t, keepers.WasmKeeper.GetContractMetadata(ctx, example.Contract))
+				assert.Empty(t, ctx.EventManager().Events())
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expMetadata, keepers.WasmKeeper.GetContractMetadata(ctx, example.Contract))
+			expEvts := sdk.Events{sdk.NewEvent(types.EventTypeUpdateContractMetadata,
+				sdk.NewAttribute(types.AttributeKeyContractAddr, example.Contract.String()),
+			)}
+			assert.Equal(t, expEvts, ctx.EventManager().Events())
+		})
+	}
+}
+
+func TestContractMetadataTagIndex(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := StoreHackatomExampleContract(t, ctx, keepers)
+	initMsg := HackatomExampleInitMsg{Verifier: RandomAccountAddress(t), Beneficiary: RandomAccountAddress(t)}.GetBytes(t)
+
+	contracts := make([]sdk.AccAddress, 3)
+	for i := range contracts {
+		var err error
+		contracts[i], _, err = keepers.ContractKeeper.Instantiate(ctx, example.CodeID, example.CreatorAddr, example.CreatorAddr, initMsg, "my label", nil)
+		require.NoError(t, err)
+	}
+	setTags := func(t *testing.T, contract sdk.AccAddress, tags ...types.MetadataTag) {
+		t.Helper()
+		err := keepers.ContractKeeper.UpdateContractMetadata(ctx, contract, example.CreatorAddr, types.ContractMetadata{Tags: tags})
+		require.NoError(t, err)
+	}
+	setTags(t, contracts[0], types.MetadataTag{Key: "type", Value: "token"}, types.MetadataTag{Key: "audited", Value: "yes"})
+	setTags(t, contracts[1], types.MetadataTag{Key: "type", Value: "token"})
+	setTags(t, contracts[2], types.MetadataTag{Key: "type", Value: "dex"})
+
+	queryTag := func(t *testing.T, req types.QueryContractsByTagRequest) []string {
+		t.Helper()
+		rsp, err := Querier(k).ContractsByTag(sdk.WrapSDKContext(ctx), &req)
+		require.NoError(t, err)
+		return rsp.ContractAddresses
+	}
+	// when querying by key and value
+	got := queryTag(t, types.QueryContractsByTagRequest{Key: "type", Value: "token"})
+	// then
+	assert.ElementsMatch(t, []string{contracts[0].String(), contracts[1].String()}, got)
+
+	// when querying by key only
+	got = queryTag(t, types.QueryContractsByTagRequest{Key: "type"})
+	// then
+	assert.ElementsMatch(t, []string{contracts[0].String(), contracts[1].String(), contracts[2].String()}, got)
+
+	// when paginated
+	got = queryTag(t, types.QueryContractsByTagRequest{Key: "type", Pagination: &query.PageRequest{Limit: 1}})
+	// then
+	assert.Len(t, got, 1)
+
+	// when tags replaced
+	setTags(t, contracts[0], types.MetadataTag{Key: "type", Value: "dex"})
+	// then old index entries are removed
+	assert.Equal(t, []string{contracts[1].String()}, queryTag(t, types.QueryContractsByTagRequest{Key: "type", Value: "token"}))
+	assert.Empty(t, queryTag(t, types.QueryContractsByTagRequest{Key: "audited"}))
+	assert.ElementsMatch(t, []string{contracts[0].String(), contracts[2].String()}, queryTag(t, types.QueryContractsByTagRequest{Key: "type", Value: "dex"}))
+
+	// when metadata cleared
+	setTags(t, contracts[0])
+	// then
+	assert.Nil(t, k.GetContractMetadata(ctx, contracts[0]))
+	assert.Equal(t, []string{contracts[2].String()}, queryTag(t, types.QueryContractsByTagRequest{Key: "type", Value: "dex"}))
+}
+
+func TestQueryContractMetadata(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	myMetadata := types.ContractMetadata{Description: "my contract", Tags: []types.MetadataTag{{Key: "type", Value: "token"}}}
+	require.NoError(t, keepers.ContractKeeper.UpdateContractMetadata(ctx, example.Contract, example.CreatorAddr, myMetadata))
+	otherContract := InstantiateHackatomExampleContract(t, ctx, keepers).Contract
+
+	specs := map[string]struct {
+		src    string
+		exp    types.ContractMetadata
+		expErr bool
+	}{
+		"with metadata": {
+			src: example.Contract.String(),
+			exp: myMetadata,
+		},
+		"without metadata": {
+			src: otherContract.String(),
+		},
+		"unknown contract": {
+			src:    RandomBech32AccountAddress(t),
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			rsp, gotErr := Querier(keepers.WasmKeeper).ContractMetadata(sdk.WrapSDKContext(ctx), &types.QueryContractMetadataRequest{Address: spec.src})
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, rsp.Metadata)
+		})
+	}
+}
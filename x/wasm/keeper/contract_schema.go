@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// setContractSchema sets the JSON schema of the messages of a code. Only the code creator is allowed to set the
+// schema. The schema can be set once and only before the first contract is instantiated from the code, so that
+// the messages of existing contracts can not be rejected by a new schema.
+func (k Keeper) setContractSchema(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, schema types.ContractSchema, authZ AuthorizationPolicy) error {
+	info := k.GetCodeInfo(ctx, codeID)
+	if info == nil {
+		return types.ErrNoSuchCodeFn(codeID).Wrapf("code id %d", codeID)
+	}
+	if !authZ.CanModifyCodeAccessConfig(sdk.MustAccAddressFromBech32(info.Creator), caller, true) {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "can not modify contract schema")
+	}
+	if schema.Normalize().IsEmpty() {
+		return errorsmod.Wrap(types.ErrEmpty, "contract schema")
+	}
+	if k.GetContractSchema(ctx, codeID) != nil {
+		return errorsmod.Wrap(types.ErrDuplicate, "contract schema already set")
+	}
+	if k.hasContractsByCode(ctx, codeID) {
+		return errorsmod.Wrap(types.ErrInvalid, "contracts instantiated from the code already")
+	}
+	if err := k.storeContractSchema(ctx, codeID, schema); err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSetContractSchema,
+		sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(codeID, 10)),
+	))
+	return nil
+}
+
+// storeContractSchema persists the schema. An empty schema deletes the record.
+func (k Keeper) storeContractSchema(ctx sdk.Context, codeID uint64, schema types.ContractSchema) error {
+	if err := schema.ValidateBasic(); err != nil {
+		return err
+	}
+	schema = schema.Normalize()
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetContractSchemaKey(codeID)
+	if schema.IsEmpty() {
+		store.Delete(key)
+		return nil
+	}
+	store.Set(key, k.cdc.MustMarshal(&schema))
+	return nil
+}
+
+// hasContractsByCode returns true when at least one contract was instantiated from or migrated to the code
+func (k Keeper) hasContractsByCode(ctx sdk.Context, codeID uint64) bool {
+	var found bool
+	k.IterateContractsByCode(ctx, codeID, func(sdk.AccAddress) bool {
+		found = true
+		return true
+	})
+	return found
+}
+
+// GetContractSchema returns the JSON schema of the messages of a code or nil when not set
+func (k Keeper) GetContractSchema(ctx sdk.Context, codeID uint64) *types.ContractSchema {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetContractSchemaKey(codeID))
+	if bz == nil {
+		return nil
+	}
+	var schema types.ContractSchema
+	k.cdc.MustUnmarshal(bz, &schema)
+	return &schema
+}
+
+// validateContractMsg checks the message against the schema registered for the code, when enabled in the params.
+func (k Keeper) validateContractMsg(ctx sdk.Context, codeID uint64, msg []byte, schemaOf func(types.ContractSchema) types.RawContractMessage) error {
+	if !k.GetParams(ctx.WithGasMeter(sdk.NewInfiniteGasMeter())).ContractSchemaValidation {
+		return nil
+	}
+	s := k.GetContractSchema(ctx, codeID)
+	if s == nil || len(schemaOf(*s)) == 0 {
+		return nil
+	}
+	ctx.GasMeter().ConsumeGas(k.gasRegister.SchemaCompileCosts(len(schemaOf(*s))), "Contract message schema compilation")
+	schema, err := types.CompileJSONSchema(schemaOf(*s))
+	if err != nil { // should not happen as the schema was validated before it was stored
+		return errorsmod.Wrap(err, "contract schema")
+	}
+	steps, err := schema.Validate(msg)
+	ctx.GasMeter().ConsumeGas(k.gasRegister.SchemaValidationCosts(steps), "Contract message schema validation")
+	return err
+}
+
+func instantiateSchema(s types.ContractSchema) types.RawContractMessage { return s.Instantiate }
+
+func executeSchema(s types.ContractSchema) types.RawContractMessage { return s.Execute }
+
+func migrateSchema(s types.ContractSchema) types.RawContractMessage { return s.Migrate }
@@ -0,0 +1,280 @@
+package keeper
+
+import (
+	"fmt"
+	"testing"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// hackatomSchema is a partial schema of the hackatom messages
+var hackatomSchema = types.ContractSchema{
+	Instantiate: []byte(`{"type": "object", "required": ["verifier", "beneficiary"], "properties": {"verifier": {"type": "string"}, "beneficiary": {"type": "string"}}}`),
+	Execute:     []byte(`{"oneOf": [{"type": "object", "required": ["release"], "properties": {"release": {"type": "object"}}, "additionalProperties": false}]}`),
+	Query:       []byte(`{"oneOf": [{"type": "object", "required": ["verifier"], "properties": {"verifier": {"type": "object"}}, "additionalProperties": false}]}`),
+	Migrate:     []byte(`{"type": "object", "required": ["verifier"], "properties": {"verifier": {"type": "string"}}}`),
+}
+
+func TestSetContractSchema(t *testing.T) {
+	specs := map[string]struct {
+		sender    func(example ExampleContract, authority string) string
+		codeID    func(example ExampleContract) uint64
+		expErr    error
+		expSchema *types.ContractSchema
+	}{
+		"code creator": {
+			sender:    func(example ExampleContract, _ string) string { return example.CreatorAddr.String() },
+			codeID:    func(example ExampleContract) uint64 { return example.CodeID },
+			expSchema: &hackatomSchema,
+		},
+		"gov authority": {
+			sender:    func(_ ExampleContract, authority string) string { return authority },
+			codeID:    func(example ExampleContract) uint64 { return example.CodeID },
+			expSchema: &hackatomSchema,
+		},
+		"not code creator": {
+			sender: func(ExampleContract, string) string { return RandomBech32AccountAddress(t) },
+			codeID: func(example ExampleContract) uint64 { return example.CodeID },
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"unknown code": {
+			sender: func(example ExampleContract, _ string) string { return example.CreatorAddr.String() },
+			codeID: func(ExampleContract) uint64 { return 99999 },
+			expErr: types.ErrNoSuchCodeFn(99999),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+			example := StoreHackatomExampleContract(t, ctx, keepers)
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			msgServer := NewMsgServerImpl(keepers.WasmKeeper)
+			msg := &types.MsgSetContractSchema{
+				Sender: spec.sender(example, keepers.WasmKeeper.GetAuthority()),
+				CodeID: spec.codeID(example),
+				Schema: hackatomSchema,
+			}
+
+			// when
+			_, gotErr := msgServer.SetContractSchema(sdk.WrapSDKContext(ctx), msg)
+			// then
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				assert.Nil(t, keepers.WasmKeeper.GetContractSchema(ctx, example.CodeID))
+				assert.Empty(t, ctx.EventManager().Events())
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expSchema, keepers.WasmKeeper.GetContractSchema(ctx, example.CodeID))
+			expEvts := sdk.Events{sdk.NewEvent(types.EventTypeSetContractSchema,
+				sdk.NewAttribute(types.AttributeKeyCodeID, fmt.Sprint(example.CodeID)),
+			)}
+			assert.Equal(t, expEvts, ctx.EventManager().Events())
+
+			// and when set again
+			_, gotErr = msgServer.SetContractSchema(sdk.WrapSDKContext(ctx), &types.MsgSetContractSchema{Sender: msg.Sender, CodeID: msg.CodeID, Schema: types.ContractSchema{Execute: []byte(`{"not": {}}`)}})
+			// then
+			require.ErrorIs(t, gotErr, types.ErrDuplicate)
+			assert.Equal(t, spec.expSchema, keepers.WasmKeeper.GetContractSchema(ctx, example.CodeID))
+		})
+	}
+}
+
+func TestSetContractSchemaWithExistingContracts(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	params := keepers.WasmKeeper.GetParams(ctx)
+	params.ContractSchemaValidation = true
+	require.NoError(t, keepers.WasmKeeper.SetParams(ctx, params))
+	lockOutSchema := types.ContractSchema{Execute: []byte(`{"not": {}}`), Migrate: []byte(`{"not": {}}`)}
+
+	// when the code creator sets a schema that rejects any message
+	gotErr := keepers.ContractKeeper.SetContractSchema(ctx, example.CodeID, example.CreatorAddr, lockOutSchema)
+	// then
+	require.ErrorIs(t, gotErr, types.ErrInvalid)
+	assert.Nil(t, keepers.WasmKeeper.GetContractSchema(ctx, example.CodeID))
+	// and the existing contract can still be executed
+	_, err := keepers.ContractKeeper.Execute(ctx, example.Contract, example.VerifierAddr, []byte(`{"release": {}}`), nil)
+	require.NoError(t, err)
+
+	// when the governance authority sets the schema
+	gotErr = keepers.WasmKeeper.setContractSchema(ctx, example.CodeID, sdk.MustAccAddressFromBech32(keepers.WasmKeeper.GetAuthority()), lockOutSchema, GovAuthorizationPolicy{})
+	// then
+	require.ErrorIs(t, gotErr, types.ErrInvalid)
+}
+
+func TestContractSchemaValidation(t *testing.T) {
+	specs := map[string]struct {
+		disabled bool
+		noSchema bool
+		exec     func(t *testing.T, ctx sdk.Context, keepers TestKeepers, example HackatomExampleInstance) error
+		expErr   *errorsmod.Error
+	}{
+		"instantiate - valid msg": {
+			exec: func(t *testing.T, ctx sdk.Context, keepers TestKeepers, example HackatomExampleInstance) error {
+				msg := HackatomExampleInitMsg{Verifier: RandomAccountAddress(t), Beneficiary: RandomAccountAddress(t)}.GetBytes(t)
+				_, _, err := keepers.ContractKeeper.Instantiate(ctx, example.CodeID, example.CreatorAddr, nil, msg, "label", nil)
+				return err
+			},
+		},
+		"instantiate - invalid msg": {
+			exec: func(t *testing.T, ctx sdk.Context, keepers TestKeepers, example HackatomExampleInstance) error {
+				_, _, err := keepers.ContractKeeper.Instantiate(ctx, example.CodeID, example.CreatorAddr, nil, []byte(`{"verifier": 1}`), "label", nil)
+				return err
+			},
+			expErr: types.ErrSchemaValidation,
+		},
+		"instantiate - invalid msg with validation disabled": {
+			disabled: true,
+			exec: func(t *testing.T, ctx sdk.Context, keepers TestKeepers, example HackatomExampleInstance) error {
+				_, _, err := keepers.ContractKeeper.Instantiate(ctx, example.CodeID, example.CreatorAddr, nil, []byte(`{"verifier": 1}`), "label", nil)
+				return err
+			},
+			expErr: types.ErrInstantiateFailed,
+		},
+		"instantiate - invalid msg without schema": {
+			noSchema: true,
+			exec: func(t *testing.T, ctx sdk.Context, keepers TestKeepers, example HackatomExampleInstance) error {
+				_, _, err := keepers.ContractKeeper.Instantiate(ctx, example.CodeID, example.CreatorAddr, nil, []byte(`{"verifier": 1}`), "label", nil)
+				return err
+			},
+			expErr: types.ErrInstantiateFailed,
+		},
+		"execute - valid msg": {
+			exec: func(t *testing.T, ctx sdk.Context, keepers TestKeepers, example HackatomExampleInstance) error {
+				_, err := keepers.ContractKeeper.Execute(ctx, example.Contract, example.VerifierAddr, []byte(`{"release": {}}`), nil)
+				return err
+			},
+		},
+		"execute - invalid msg": {
+			exec: func(t *testing.T, ctx sdk.Context, keepers TestKeepers, example HackatomExampleInstance) error {
+				_, err := keepers.ContractKeeper.Execute(ctx, example.Contract, example.VerifierAddr, []byte(`{"unknown": {}}`), nil)
+				return err
+			},
+			expErr: types.ErrSchemaValidation,
+		},
+		"execute - invalid msg with validation disabled": {
+			disabled: true,
+			exec: func(t *testing.T, ctx sdk.Context, keepers TestKeepers, example HackatomExampleInstance) error {
+				_, err := keepers.ContractKeeper.Execute(ctx, example.Contract, example.VerifierAddr, []byte(`{"unknown": {}}`), nil)
+				return err
+			},
+			expErr: types.ErrExecuteFailed,
+		},
+		"migrate - valid msg": {
+			exec: func(t *testing.T, ctx sdk.Context, keepers TestKeepers, example HackatomExampleInstance) error {
+				msg := []byte(fmt.Sprintf(`{"verifier": %q}`, RandomBech32AccountAddress(t)))
+				_, err := keepers.ContractKeeper.Migrate(ctx, example.Contract, example.CreatorAddr, example.CodeID, msg)
+				return err
+			},
+		},
+		"migrate - invalid msg": {
+			exec: func(t *testing.T, ctx sdk.Context, keepers TestKeepers, example HackatomExampleInstance) error {
+				_, err := keepers.ContractKeeper.Migrate(ctx, example.Contract, example.CreatorAddr, example.CodeID, []byte(`{"verifier": 1}`))
+				return err
+			},
+			expErr: types.ErrSchemaValidation,
+		},
+		"migrate - invalid msg with validation disabled": {
+			disabled: true,
+			exec: func(t *testing.T, ctx sdk.Context, keepers TestKeepers, example HackatomExampleInstance) error {
+				_, err := keepers.ContractKeeper.Migrate(ctx, example.Contract, example.CreatorAddr, example.CodeID, []byte(`{"verifier": 1}`))
+				return err
+			},
+			expErr: types.ErrMigrationFailed,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+			example := InstantiateHackatomExampleContract(t, ctx, keepers)
+			params := keepers.WasmKeeper.GetParams(ctx)
+			params.ContractSchemaValidation = !spec.disabled
+			require.NoError(t, keepers.WasmKeeper.SetParams(ctx, params))
+			if !spec.noSchema {
+				require.NoError(t, keepers.WasmKeeper.storeContractSchema(ctx, example.CodeID, hackatomSchema))
+			}
+
+			// when
+			gotErr := spec.exec(t, ctx, keepers, example)
+			// then
+			if spec.expErr != nil {
+				require.True(t, spec.expErr.Is(gotErr), gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+}
+
+func TestContractSchemaValidationGas(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	require.NoError(t, keepers.WasmKeeper.storeContractSchema(ctx, example.CodeID, hackatomSchema))
+	msg := []byte(`{"unknown": {}}`)
+
+	// when validation disabled
+	gasBefore := ctx.GasMeter().GasConsumed()
+	_, err := keepers.ContractKeeper.Execute(ctx, example.Contract, example.VerifierAddr, msg, nil)
+	// then no gas is charged before the contract fails
+	require.Error(t, err)
+	disabledGas := ctx.GasMeter().GasConsumed() - gasBefore
+
+	// when validation enabled
+	params := keepers.WasmKeeper.GetParams(ctx)
+	params.ContractSchemaValidation = true
+	require.NoError(t, keepers.WasmKeeper.SetParams(ctx, params))
+	gasBefore = ctx.GasMeter().GasConsumed()
+	_, err = keepers.ContractKeeper.Execute(ctx, example.Contract, example.VerifierAddr, msg, nil)
+	// then the message is rejected before the VM is called
+	require.True(t, types.ErrSchemaValidation.Is(err), err)
+	assert.Less(t, ctx.GasMeter().GasConsumed()-gasBefore, disabledGas)
+	// and the schema compilation is charged
+	assert.GreaterOrEqual(t, ctx.GasMeter().GasConsumed()-gasBefore, DefaultSchemaCompileCost*uint64(len(hackatomSchema.Execute)))
+}
+
+func TestQueryContractSchema(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	example := StoreHackatomExampleContract(t, ctx, keepers)
+	require.NoError(t, keepers.ContractKeeper.SetContractSchema(ctx, example.CodeID, example.CreatorAddr, hackatomSchema))
+	otherCode := StoreHackatomExampleContract(t, ctx, keepers)
+
+	specs := map[string]struct {
+		src    uint64
+		exp    types.ContractSchema
+		expErr bool
+	}{
+		"with schema": {
+			src: example.CodeID,
+			exp: hackatomSchema,
+		},
+		"without schema": {
+			src: otherCode.CodeID,
+		},
+		"unknown code": {
+			src:    99999,
+			expErr: true,
+		},
+		"empty code id": {
+			src:    0,
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			rsp, gotErr := Querier(keepers.WasmKeeper).ContractSchema(sdk.WrapSDKContext(ctx), &types.QueryContractSchemaRequest{CodeId: spec.src})
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, rsp.Schema)
+		})
+	}
+}
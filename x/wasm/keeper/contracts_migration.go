@@ -0,0 +1,287 @@
+package keeper
+
+import (
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// startContractsMigration stores a new migration of all contracts of a code to the new code. The contracts are
+// migrated in batches at the end of the blocks, starting with the current one.
+func (k Keeper) startContractsMigration(ctx sdk.Context, codeID, newCodeID uint64, msg types.RawContractMessage, batchSize uint32) (uint64, error) {
+	if k.GetCodeInfo(ctx, codeID) == nil {
+		return 0, types.ErrNoSuchCodeFn(codeID).Wrapf("code id %d", codeID)
+	}
+	if k.GetCodeInfo(ctx, newCodeID) == nil {
+		return 0, types.ErrNoSuchCodeFn(newCodeID).Wrapf("new code id %d", newCodeID)
+	}
+	var pending bool
+	var pendingCount int
+	k.IteratePendingContractsMigrations(ctx, func(m types.ContractsMigration) bool {
+		pending = m.CodeID == codeID
+		pendingCount++
+		return pending
+	})
+	if pending {
+		return 0, errorsmod.Wrapf(types.ErrDuplicate, "migration of code %d in progress", codeID)
+	}
+	if pendingCount >= types.MaxPendingContractsMigrations {
+		return 0, errorsmod.Wrapf(types.ErrLimit, "max %d contracts migrations in progress", types.MaxPendingContractsMigrations)
+	}
+	if batchSize == 0 {
+		batchSize = types.DefaultContractsMigrationBatchSize
+	}
+	m := types.ContractsMigration{
+		ID:          k.autoIncrementID(ctx, types.KeyLastContractsMigrationID),
+		CodeID:      codeID,
+		NewCodeID:   newCodeID,
+		Msg:         msg,
+		BatchSize:   batchSize,
+		StartHeight: ctx.BlockHeight(),
+	}
+	if err := k.storeContractsMigration(ctx, m); err != nil {
+		return 0, err
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeMigrateContractsByCode,
+		sdk.NewAttribute(types.AttributeKeyMigrationID, strconv.FormatUint(m.ID, 10)),
+		sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(codeID, 10)),
+		sdk.NewAttribute(types.AttributeKeyNewCodeID, strconv.FormatUint(newCodeID, 10)),
+	))
+	return m.ID, nil
+}
+
+// MigrateContractsBatches migrates the next batch of contracts of all migrations in progress. A contract that fails
+// to migrate is kept on the old code and does not stop the migration. It is called at the end of every block.
+// All migrations share a budget of contracts and gas per block. The migrations are processed in the order they were
+// started and continue in the next block when the budget is used up.
+func (k Keeper) MigrateContractsBatches(ctx sdk.Context) {
+	var pending []types.ContractsMigration
+	k.IteratePendingContractsMigrations(ctx, func(m types.ContractsMigration) bool {
+		pending = append(pending, m)
+		return false
+	})
+	budget := contractsMigrationBudget{
+		contracts: types.ContractsMigrationBlockContractsLimit,
+		gas:       types.ContractsMigrationBlockGasLimit,
+	}
+	for _, m := range pending {
+		if !budget.hasNext() {
+			return
+		}
+		m = k.migrateContractsBatch(ctx, m, &budget)
+		if err := k.storeContractsMigration(ctx, m); err != nil {
+			panic(err) // should not happen as the record was valid before
+		}
+	}
+}
+
+// contractsMigrationBudget is what is left for the contracts migrations in the current block
+type contractsMigrationBudget struct {
+	contracts uint32
+	gas       uint64
+}
+
+// hasNext returns true when the budget covers the migration of another contract
+func (b contractsMigrationBudget) hasNext() bool {
+	return b.contracts > 0 && b.gas >= types.ContractsMigrationGasLimit
+}
+
+// consume deducts a migrated contract with the gas used from the budget
+func (b *contractsMigrationBudget) consume(gasUsed uint64) {
+	b.contracts--
+	if gasUsed > b.gas {
+		gasUsed = b.gas
+	}
+	b.gas -= gasUsed
+}
+
+func (k Keeper) migrateContractsBatch(ctx sdk.Context, m types.ContractsMigration, budget *contractsMigrationBudget) types.ContractsMigration {
+	authority, err := sdk.AccAddressFromBech32(k.GetAuthority())
+	if err != nil {
+		panic(err) // should not happen as the authority is set on startup
+	}
+	// collect the batch first as migrated contracts are removed from the index
+	type entry struct {
+		key  []byte
+		addr sdk.AccAddress
+	}
+	batchSize := m.BatchSize
+	if budget.contracts < batchSize {
+		batchSize = budget.contracts
+	}
+	batch := make([]entry, 0, batchSize)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetContractByCodeIDSecondaryIndexPrefix(m.CodeID))
+	var start []byte
+	if len(m.Cursor) != 0 {
+		start = append(append([]byte{}, m.Cursor...), 0) // exclusive
+	}
+	iter := store.Iterator(start, nil)
+	for ; iter.Valid() && len(batch) < int(batchSize); iter.Next() {
+		key := append([]byte{}, iter.Key()...)
+		batch = append(batch, entry{key: key, addr: key[types.AbsoluteTxPositionLen:]})
+	}
+	done := !iter.Valid()
+	iter.Close()
+
+	for _, e := range batch {
+		if !budget.hasNext() {
+			done = false
+			break
+		}
+		result := types.ContractMigrationResult{
+			Contract: e.addr.String(),
+			Height:   ctx.BlockHeight(),
+		}
+		gasUsed, err := k.migrateContractIsolated(ctx, e.addr, authority, m)
+		budget.consume(gasUsed)
+		if err != nil {
+			result.Error = redactError(err).Error()
+			m.Failed++
+		} else {
+			result.Success = true
+			m.Succeeded++
+		}
+		k.storeContractMigrationResult(ctx, m.ID, e.addr, result)
+		attrs := []sdk.Attribute{
+			sdk.NewAttribute(types.AttributeKeyMigrationID, strconv.FormatUint(m.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyContractAddr, result.Contract),
+			sdk.NewAttribute(types.AttributeKeyAckSuccess, strconv.FormatBool(result.Success)),
+		}
+		if !result.Success {
+			attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyAckError, result.Error))
+		}
+		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeContractMigration, attrs...))
+		m.Cursor = e.key
+	}
+	if done {
+		m.EndHeight = ctx.BlockHeight()
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeContractsMigrationDone,
+			sdk.NewAttribute(types.AttributeKeyMigrationID, strconv.FormatUint(m.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeySucceeded, strconv.FormatUint(m.Succeeded, 10)),
+			sdk.NewAttribute(types.AttributeKeyFailed, strconv.FormatUint(m.Failed, 10)),
+		))
+	}
+	return m
+}
+
+// migrateContractIsolated migrates a single contract with the gov permissions and returns the gas used. The state
+// changes and events are only committed on success and the gas is limited by types.ContractsMigrationGasLimit.
+// Panics are returned as errors so that a single contract can not halt the chain in the end blocker.
+func (k Keeper) migrateContractIsolated(ctx sdk.Context, contractAddr, authority sdk.AccAddress, m types.ContractsMigration) (gasUsed uint64, err error) {
+	cacheCtx, commit := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(sdk.NewGasMeter(types.ContractsMigrationGasLimit))
+	defer func() {
+		gasUsed = cacheCtx.GasMeter().GasConsumedToLimit() // for all returns and panics
+		if r := recover(); r != nil {
+			switch rType := r.(type) {
+			case sdk.ErrorOutOfGas:
+				err = errorsmod.Wrap(sdkerrors.ErrOutOfGas, rType.Descriptor)
+			default:
+				err = sdkerrors.ErrPanic
+			}
+			moduleLogger(ctx).Info("contracts migration", "error", "recovering panic", "contract-address", contractAddr.String())
+		}
+	}()
+	if _, err := k.migrate(cacheCtx, contractAddr, authority, m.NewCodeID, m.Msg, GovAuthorizationPolicy{}); err != nil {
+		return 0, err
+	}
+	commit()
+	return 0, nil
+}
+
+func (k Keeper) storeContractsMigration(ctx sdk.Context, m types.ContractsMigration) error {
+	if err := m.ValidateBasic(); err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetContractsMigrationKey(m.ID), k.cdc.MustMarshal(&m))
+	if m.IsDone() {
+		store.Delete(types.GetPendingContractsMigrationKey(m.ID))
+	} else {
+		store.Set(types.GetPendingContractsMigrationKey(m.ID), []byte{})
+	}
+	return nil
+}
+
+// GetContractsMigration returns the record of a contracts migration or nil when not found
+func (k Keeper) GetContractsMigration(ctx sdk.Context, id uint64) *types.ContractsMigration {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetContractsMigrationKey(id))
+	if bz == nil {
+		return nil
+	}
+	var m types.ContractsMigration
+	k.cdc.MustUnmarshal(bz, &m)
+	return &m
+}
+
+// IterateContractsMigrations iterates over all contracts migrations ordered by id
+func (k Keeper) IterateContractsMigrations(ctx sdk.Context, cb func(types.ContractsMigration) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.ContractsMigrationPrefix).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var m types.ContractsMigration
+		k.cdc.MustUnmarshal(iter.Value(), &m)
+		if cb(m) {
+			return
+		}
+	}
+}
+
+// IteratePendingContractsMigrations iterates over the contracts migrations in progress ordered by id
+func (k Keeper) IteratePendingContractsMigrations(ctx sdk.Context, cb func(types.ContractsMigration) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingContractsMigrationPrefix).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		m := k.GetContractsMigration(ctx, sdk.BigEndianToUint64(iter.Key()))
+		if m == nil {
+			panic("contracts migration not found") // should not happen as the index is maintained with the record
+		}
+		if cb(*m) {
+			return
+		}
+	}
+}
+
+// storeContractMigrationResult stores the outcome of the migration of a contract. The results are kept permanently
+// as the audit trail of the migration.
+func (k Keeper) storeContractMigrationResult(ctx sdk.Context, id uint64, contractAddr sdk.AccAddress, r types.ContractMigrationResult) {
+	ctx.KVStore(k.storeKey).Set(types.GetContractMigrationResultKey(id, contractAddr), k.cdc.MustMarshal(&r))
+}
+
+// IterateContractMigrationResults iterates over the results of a contracts migration ordered by contract address
+func (k Keeper) IterateContractMigrationResults(ctx sdk.Context, id uint64, cb func(types.ContractMigrationResult) bool) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetContractMigrationResultPrefix(id)).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var r types.ContractMigrationResult
+		k.cdc.MustUnmarshal(iter.Value(), &r)
+		if cb(r) {
+			return
+		}
+	}
+}
+
+// importContractsMigration stores a migration with the results from genesis
+func (k Keeper) importContractsMigration(ctx sdk.Context, s types.ContractsMigrationState) error {
+	if k.GetContractsMigration(ctx, s.Migration.ID) != nil {
+		return errorsmod.Wrapf(types.ErrDuplicate, "contracts migration %d", s.Migration.ID)
+	}
+	if err := k.storeContractsMigration(ctx, s.Migration); err != nil {
+		return err
+	}
+	for _, r := range s.Results {
+		contractAddr, err := sdk.AccAddressFromBech32(r.Contract)
+		if err != nil {
+			return errorsmod.Wrap(err, "result contract")
+		}
+		k.storeContractMigrationResult(ctx, s.Migration.ID, contractAddr, r)
+	}
+	return nil
+}
@@ -0,0 +1,243 @@
+package keeper
+
+import (
+	"errors"
+	"testing"
+
+	wasmvm "github.com/CosmWasm/wasmvm"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper/wasmtesting"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestMigrateContractsByCode(t *testing.T) {
+	var failingContract, panickingContract string
+	mockWasmVM := wasmtesting.MockWasmer{MigrateFn: func(codeID wasmvm.Checksum, env wasmvmtypes.Env, migrateMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+		switch env.Contract.Address {
+		case failingContract:
+			return nil, 1, errors.New("testing")
+		case panickingContract:
+			panic("testing")
+		}
+		store.Set([]byte("migrated"), migrateMsg)
+		return &wasmvmtypes.Response{}, 1, nil
+	}}
+	wasmtesting.MakeInstantiable(&mockWasmVM)
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities, WithWasmEngine(&mockWasmVM))
+	k := keepers.WasmKeeper
+	example := StoreRandomContract(t, ctx, keepers, &mockWasmVM)
+	newCodeID := StoreRandomContract(t, ctx, keepers, &mockWasmVM).CodeID
+	otherCodeID := StoreRandomContract(t, ctx, keepers, &mockWasmVM).CodeID
+
+	contracts := make([]sdk.AccAddress, 5)
+	for i := range contracts {
+		var err error
+		contracts[i], _, err = keepers.ContractKeeper.Instantiate(ctx, example.CodeID, example.CreatorAddr, nil, []byte(`{}`), "", nil)
+		require.NoError(t, err)
+	}
+	// other code is not migrated
+	otherContract, _, err := keepers.ContractKeeper.Instantiate(ctx, otherCodeID, example.CreatorAddr, nil, []byte(`{}`), "", nil)
+	require.NoError(t, err)
+
+	// contracts are processed in the order of the code index
+	var ordered []sdk.AccAddress
+	k.IterateContractsByCode(ctx, example.CodeID, func(addr sdk.AccAddress) bool {
+		ordered = append(ordered, addr)
+		return false
+	})
+	require.Len(t, ordered, len(contracts))
+	failingContract, panickingContract = ordered[1].String(), ordered[3].String()
+
+	msgServer := NewMsgServerImpl(k)
+	msg := &types.MsgMigrateContractsByCode{
+		Authority: k.GetAuthority(),
+		CodeID:    example.CodeID,
+		NewCodeID: newCodeID,
+		Msg:       []byte(`{"patch":1}`),
+		BatchSize: 2,
+	}
+
+	// when started by a non authority
+	_, err = msgServer.MigrateContractsByCode(sdk.WrapSDKContext(ctx), &types.MsgMigrateContractsByCode{
+		Authority: example.CreatorAddr.String(),
+		CodeID:    msg.CodeID,
+		NewCodeID: msg.NewCodeID,
+		Msg:       msg.Msg,
+	})
+	// then
+	require.ErrorIs(t, err, types.ErrInvalid)
+
+	// when started by the authority
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	rsp, err := msgServer.MigrateContractsByCode(sdk.WrapSDKContext(ctx), msg)
+	// then
+	require.NoError(t, err)
+	migrationID := rsp.MigrationID
+	assert.Equal(t, uint64(1), migrationID)
+	expEvts := sdk.Events{sdk.NewEvent(types.EventTypeMigrateContractsByCode,
+		sdk.NewAttribute(types.AttributeKeyMigrationID, "1"),
+		sdk.NewAttribute(types.AttributeKeyCodeID, "1"),
+		sdk.NewAttribute(types.AttributeKeyNewCodeID, "2"),
+	)}
+	assert.Equal(t, expEvts, ctx.EventManager().Events())
+
+	// and a second migration of the same code is rejected while in progress
+	_, err = msgServer.MigrateContractsByCode(sdk.WrapSDKContext(ctx), msg)
+	require.ErrorIs(t, err, types.ErrDuplicate)
+
+	// when processed over multiple blocks
+	expCounts := [][2]uint64{{1, 1}, {2, 2}, {3, 2}}
+	for i, exp := range expCounts {
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithEventManager(sdk.NewEventManager())
+		k.MigrateContractsBatches(ctx)
+
+		m := k.GetContractsMigration(ctx, migrationID)
+		require.NotNil(t, m)
+		assert.Equal(t, exp[0], m.Succeeded, "block %d", i)
+		assert.Equal(t, exp[1], m.Failed, "block %d", i)
+		assert.Equal(t, i == len(expCounts)-1, m.IsDone(), "block %d", i)
+	}
+	// then the done event is emitted with the last batch
+	lastEvts := ctx.EventManager().Events()
+	require.NotEmpty(t, lastEvts)
+	assert.Equal(t, sdk.NewEvent(types.EventTypeContractsMigrationDone,
+		sdk.NewAttribute(types.AttributeKeyMigrationID, "1"),
+		sdk.NewAttribute(types.AttributeKeySucceeded, "3"),
+		sdk.NewAttribute(types.AttributeKeyFailed, "2"),
+	), lastEvts[len(lastEvts)-1])
+
+	// and the contracts are on the new code, except the failed ones
+	for _, addr := range ordered {
+		info := k.GetContractInfo(ctx, addr)
+		if s := addr.String(); s == failingContract || s == panickingContract {
+			assert.Equal(t, example.CodeID, info.CodeID, s)
+			assert.Nil(t, k.QueryRaw(ctx, addr, []byte("migrated")), s)
+			continue
+		}
+		assert.Equal(t, newCodeID, info.CodeID, addr.String())
+		assert.Equal(t, msg.Msg.Bytes(), k.QueryRaw(ctx, addr, []byte("migrated")))
+	}
+	assert.Equal(t, otherCodeID, k.GetContractInfo(ctx, otherContract).CodeID)
+
+	// and the results can be queried
+	querier := NewGrpcQuerier(k.cdc, k.storeKey, k, k.queryGasLimit)
+	gotMigration, err := querier.ContractsMigration(sdk.WrapSDKContext(ctx), &types.QueryContractsMigrationRequest{MigrationId: migrationID})
+	require.NoError(t, err)
+	assert.Equal(t, msg.Msg, gotMigration.Migration.Msg)
+	assert.Equal(t, ordered[len(ordered)-1], sdk.AccAddress(gotMigration.Migration.Cursor[types.AbsoluteTxPositionLen:]))
+
+	gotResults, err := querier.ContractsMigrationResults(sdk.WrapSDKContext(ctx), &types.QueryContractsMigrationResultsRequest{
+		MigrationId: migrationID,
+		Pagination:  &query.PageRequest{Limit: 10},
+	})
+	require.NoError(t, err)
+	require.Len(t, gotResults.Results, len(contracts))
+	results := make(map[string]types.ContractMigrationResult, len(gotResults.Results))
+	for _, r := range gotResults.Results {
+		results[r.Contract] = r
+	}
+	assert.False(t, results[failingContract].Success)
+	assert.Equal(t, redactError(types.ErrMigrationFailed).Error(), results[failingContract].Error)
+	assert.False(t, results[panickingContract].Success)
+	assert.NotEmpty(t, results[panickingContract].Error)
+	assert.True(t, results[ordered[0].String()].Success)
+	assert.Empty(t, results[ordered[0].String()].Error)
+
+	// and a new migration can be started for the contracts left behind
+	rsp, err = msgServer.MigrateContractsByCode(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), rsp.MigrationID)
+}
+
+func TestMigrateContractsBatchesBudget(t *testing.T) {
+	mockWasmVM := wasmtesting.MockWasmer{MigrateFn: func(codeID wasmvm.Checksum, env wasmvmtypes.Env, migrateMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+		return &wasmvmtypes.Response{}, 1, nil
+	}}
+	wasmtesting.MakeInstantiable(&mockWasmVM)
+
+	specs := map[string]struct {
+		contractsLimit uint32
+		gasLimit       uint64
+		expSucceeded   [][2]uint64
+	}{
+		"contracts limit shared": {
+			contractsLimit: 3,
+			gasLimit:       types.ContractsMigrationBlockGasLimit,
+			expSucceeded:   [][2]uint64{{2, 1}, {2, 2}},
+		},
+		"gas limit shared": {
+			contractsLimit: types.ContractsMigrationBlockContractsLimit,
+			gasLimit:       types.ContractsMigrationGasLimit,
+			expSucceeded:   [][2]uint64{{1, 0}, {2, 0}, {2, 1}, {2, 2}},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, keepers := CreateTestInput(t, false, AvailableCapabilities, WithWasmEngine(&mockWasmVM))
+			k := keepers.WasmKeeper
+			newCodeID := StoreRandomContract(t, ctx, keepers, &mockWasmVM).CodeID
+			var migrationIDs []uint64
+			for i := 0; i < 2; i++ {
+				example := StoreRandomContract(t, ctx, keepers, &mockWasmVM)
+				for j := 0; j < 2; j++ {
+					_, _, err := keepers.ContractKeeper.Instantiate(ctx, example.CodeID, example.CreatorAddr, nil, []byte(`{}`), "", nil)
+					require.NoError(t, err)
+				}
+				id, err := k.startContractsMigration(ctx, example.CodeID, newCodeID, []byte(`{}`), 2)
+				require.NoError(t, err)
+				migrationIDs = append(migrationIDs, id)
+			}
+			contractsLimit, gasLimit := types.ContractsMigrationBlockContractsLimit, types.ContractsMigrationBlockGasLimit
+			t.Cleanup(func() {
+				types.ContractsMigrationBlockContractsLimit, types.ContractsMigrationBlockGasLimit = contractsLimit, gasLimit
+			})
+			types.ContractsMigrationBlockContractsLimit, types.ContractsMigrationBlockGasLimit = spec.contractsLimit, spec.gasLimit
+
+			// when processed over multiple blocks
+			for i, exp := range spec.expSucceeded {
+				ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+				k.MigrateContractsBatches(ctx)
+				// then the migrations continue within the budget
+				for j, id := range migrationIDs {
+					m := k.GetContractsMigration(ctx, id)
+					assert.Equal(t, exp[j], m.Succeeded, "block %d, migration %d", i, id)
+					assert.Equal(t, exp[j] == 2, m.IsDone(), "block %d, migration %d", i, id)
+				}
+			}
+		})
+	}
+}
+
+func TestMaxPendingContractsMigrations(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	newCodeID := StoreHackatomExampleContract(t, ctx, keepers).CodeID
+	pendingLimit := types.MaxPendingContractsMigrations
+	t.Cleanup(func() { types.MaxPendingContractsMigrations = pendingLimit })
+	types.MaxPendingContractsMigrations = 1
+
+	_, err := k.startContractsMigration(ctx, StoreHackatomExampleContract(t, ctx, keepers).CodeID, newCodeID, []byte(`{}`), 0)
+	require.NoError(t, err)
+	// when
+	_, err = k.startContractsMigration(ctx, StoreHackatomExampleContract(t, ctx, keepers).CodeID, newCodeID, []byte(`{}`), 0)
+	// then
+	require.ErrorIs(t, err, types.ErrLimit)
+}
+
+func TestQueryContractsMigration(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	querier := NewGrpcQuerier(k.cdc, k.storeKey, k, k.queryGasLimit)
+
+	_, err := querier.ContractsMigration(sdk.WrapSDKContext(ctx), &types.QueryContractsMigrationRequest{})
+	require.ErrorIs(t, err, types.ErrInvalid)
+	_, err = querier.ContractsMigration(sdk.WrapSDKContext(ctx), &types.QueryContractsMigrationRequest{MigrationId: 1})
+	require.ErrorIs(t, err, types.ErrNotFound)
+	_, err = querier.ContractsMigrationResults(sdk.WrapSDKContext(ctx), &types.QueryContractsMigrationResultsRequest{MigrationId: 1})
+	require.ErrorIs(t, err, types.ErrNotFound)
+}
@@ -53,6 +53,10 @@ const (
 	DefaultPerCustomEventCost uint64 = 20
 	// DefaultEventAttributeDataFreeTier number of bytes of total attribute data we do not charge.
 	DefaultEventAttributeDataFreeTier = 100
+	// DefaultSchemaValidationStepCost is how much SDK gas we charge per step of a contract message schema validation.
+	DefaultSchemaValidationStepCost uint64 = 2
+	// DefaultSchemaCompileCost is how much SDK gas we charge *per byte* of a contract message schema that is compiled.
+	DefaultSchemaCompileCost uint64 = 2
 )
 
 // default: 0.15 gas.
@@ -81,6 +85,10 @@ type GasRegister interface {
 	ReplyCosts(pinned bool, reply wasmvmtypes.Reply) sdk.Gas
 	// EventCosts costs to persist an event
 	EventCosts(attrs []wasmvmtypes.EventAttribute, events wasmvmtypes.Events) sdk.Gas
+	// SchemaValidationCosts costs to validate a contract message against the registered schema
+	SchemaValidationCosts(steps int) sdk.Gas
+	// SchemaCompileCosts costs to compile the registered schema of a contract message
+	SchemaCompileCosts(schemaLen int) sdk.Gas
 	// ToWasmVMGas converts from sdk gas to wasmvm gas
 	ToWasmVMGas(source sdk.Gas) uint64
 	// FromWasmVMGas converts from wasmvm gas to sdk gas
@@ -111,6 +119,10 @@ type WasmGasRegisterConfig struct {
 	ContractMessageDataCost sdk.Gas
 	// CustomEventCost cost per custom event
 	CustomEventCost uint64
+	// SchemaValidationStepCost SDK gas charged per step of a contract message schema validation
+	SchemaValidationStepCost sdk.Gas
+	// SchemaCompileCost SDK gas charged *per byte* of a contract message schema that is compiled
+	SchemaCompileCost sdk.Gas
 }
 
 // DefaultGasRegisterConfig default values
@@ -125,6 +137,8 @@ func DefaultGasRegisterConfig() WasmGasRegisterConfig {
 		EventAttributeDataFreeTier: DefaultEventAttributeDataFreeTier,
 		ContractMessageDataCost:    DefaultContractMessageDataCost,
 		UncompressCost:             DefaultPerByteUncompressCost(),
+		SchemaValidationStepCost:   DefaultSchemaValidationStepCost,
+		SchemaCompileCost:          DefaultSchemaCompileCost,
 	}
 }
 
@@ -169,6 +183,22 @@ func (g WasmGasRegister) UncompressCosts(byteLength int) sdk.Gas {
 	return g.c.UncompressCost.Mul(uint64(byteLength)).Floor()
 }
 
+// SchemaValidationCosts costs to validate a contract message against the registered schema
+func (g WasmGasRegister) SchemaValidationCosts(steps int) sdk.Gas {
+	if steps < 0 {
+		panic(errorsmod.Wrap(types.ErrInvalid, "negative steps"))
+	}
+	return g.c.SchemaValidationStepCost * uint64(steps)
+}
+
+// SchemaCompileCosts costs to compile the registered schema of a contract message
+func (g WasmGasRegister) SchemaCompileCosts(schemaLen int) sdk.Gas {
+	if schemaLen < 0 {
+		panic(errorsmod.Wrap(types.ErrInvalid, "negative length"))
+	}
+	return g.c.SchemaCompileCost * uint64(schemaLen)
+}
+
 // InstantiateContractCosts costs when interacting with a wasm contract
 func (g WasmGasRegister) InstantiateContractCosts(pinned bool, msgLen int) sdk.Gas {
 	if msgLen < 0 {
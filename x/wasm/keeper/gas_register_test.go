@@ -470,3 +470,71 @@ func TestUncompressCosts(t *testing.T) {
 		})
 	}
 }
+
+func TestSchemaValidationCosts(t *testing.T) {
+	specs := map[string]struct {
+		stepsIn  int
+		exp      sdk.Gas
+		expPanic bool
+	}{
+		"0": {
+			exp: 0,
+		},
+		"some steps": {
+			stepsIn: 100,
+			exp:     200,
+		},
+		"max steps": {
+			stepsIn: types.MaxJSONSchemaValidationSteps,
+			exp:     sdk.Gas(2 * types.MaxJSONSchemaValidationSteps),
+		},
+		"invalid steps": {
+			stepsIn:  -1,
+			expPanic: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			if spec.expPanic {
+				assert.Panics(t, func() { NewDefaultWasmGasRegister().SchemaValidationCosts(spec.stepsIn) })
+				return
+			}
+			got := NewDefaultWasmGasRegister().SchemaValidationCosts(spec.stepsIn)
+			assert.Equal(t, spec.exp, got)
+		})
+	}
+}
+
+func TestSchemaCompileCosts(t *testing.T) {
+	specs := map[string]struct {
+		lenIn    int
+		exp      sdk.Gas
+		expPanic bool
+	}{
+		"0": {
+			exp: 0,
+		},
+		"some bytes": {
+			lenIn: 100,
+			exp:   200,
+		},
+		"max schema size": {
+			lenIn: types.MaxContractSchemaSize,
+			exp:   sdk.Gas(2 * types.MaxContractSchemaSize),
+		},
+		"invalid length": {
+			lenIn:    -1,
+			expPanic: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			if spec.expPanic {
+				assert.Panics(t, func() { NewDefaultWasmGasRegister().SchemaCompileCosts(spec.lenIn) })
+				return
+			}
+			got := NewDefaultWasmGasRegister().SchemaCompileCosts(spec.lenIn)
+			assert.Equal(t, spec.exp, got)
+		})
+	}
+}
@@ -37,6 +37,16 @@ func InitGenesis(ctx sdk.Context, keeper *Keeper, data types.GenesisState) ([]ab
 				return nil, errorsmod.Wrapf(err, "contract number %d", i)
 			}
 		}
+		if len(code.StargateQueryPaths) != 0 {
+			if err := keeper.SetCodeStargateQueryAllowlist(ctx, code.CodeID, code.StargateQueryPaths); err != nil {
+				return nil, errorsmod.Wrapf(err, "stargate query paths of code %d", i)
+			}
+		}
+		if code.Schema != nil {
+			if err := keeper.storeContractSchema(ctx, code.CodeID, *code.Schema); err != nil {
+				return nil, errorsmod.Wrapf(err, "schema of code %d", i)
+			}
+		}
 	}
 
 	var maxContractID int
@@ -49,9 +59,35 @@ func InitGenesis(ctx sdk.Context, keeper *Keeper, data types.GenesisState) ([]ab
 		if err != nil {
 			return nil, errorsmod.Wrapf(err, "contract number %d", i)
 		}
+		if len(contract.StargateQueryPaths) != 0 {
+			if err := keeper.SetContractStargateQueryAllowlist(ctx, contractAddr, contract.StargateQueryPaths); err != nil {
+				return nil, errorsmod.Wrapf(err, "stargate query paths of contract number %d", i)
+			}
+		}
+		if contract.Metadata != nil {
+			if err := keeper.storeContractMetadata(ctx, contractAddr, *contract.Metadata); err != nil {
+				return nil, errorsmod.Wrapf(err, "metadata of contract number %d", i)
+			}
+		}
 		maxContractID = i + 1 // not ideal but max(contractID) is not persisted otherwise
 	}
 
+	var maxContractsMigrationID uint64
+	for i, m := range data.ContractsMigrations {
+		if err := keeper.importContractsMigration(ctx, m); err != nil {
+			return nil, errorsmod.Wrapf(err, "contracts migration number %d", i)
+		}
+		if m.Migration.ID > maxContractsMigrationID {
+			maxContractsMigrationID = m.Migration.ID
+		}
+	}
+
+	for i, transfer := range data.PendingAdminTransfers {
+		if err := keeper.importPendingAdminTransfer(ctx, transfer); err != nil {
+			return nil, errorsmod.Wrapf(err, "pending admin transfer number %d", i)
+		}
+	}
+
 	for i, seq := range data.Sequences {
 		err := keeper.importAutoIncrementID(ctx, seq.IDKey, seq.Value)
 		if err != nil {
@@ -68,6 +104,15 @@ func InitGenesis(ctx sdk.Context, keeper *Keeper, data types.GenesisState) ([]ab
 	if seqVal <= uint64(maxContractID) {
 		return nil, errorsmod.Wrapf(types.ErrInvalid, "seq %s with value: %d must be greater than: %d ", string(types.KeyLastInstanceID), seqVal, maxContractID)
 	}
+	seqVal = keeper.PeekAutoIncrementID(ctx, types.KeyLastContractsMigrationID)
+	if seqVal <= maxContractsMigrationID {
+		return nil, errorsmod.Wrapf(types.ErrInvalid, "seq %s with value: %d must be greater than: %d ", string(types.KeyLastContractsMigrationID), seqVal, maxContractsMigrationID)
+	}
+	if keeper.IBCQueryHostEnabled() {
+		if err := keeper.BindIBCQueryPort(ctx); err != nil {
+			return nil, errorsmod.Wrap(err, "bind ibc query port")
+		}
+	}
 	return nil, nil
 }
 
@@ -83,10 +128,12 @@ func ExportGenesis(ctx sdk.Context, keeper *Keeper) *types.GenesisState {
 			panic(err)
 		}
 		genState.Codes = append(genState.Codes, types.Code{
-			CodeID:    codeID,
-			CodeInfo:  info,
-			CodeBytes: bytecode,
-			Pinned:    keeper.IsPinnedCode(ctx, codeID),
+			CodeID:             codeID,
+			CodeInfo:           info,
+			CodeBytes:          bytecode,
+			Pinned:             keeper.IsPinnedCode(ctx, codeID),
+			StargateQueryPaths: keeper.GetCodeStargateQueryAllowlist(ctx, codeID),
+			Schema:             keeper.GetContractSchema(ctx, codeID),
 		})
 		return false
 	})
@@ -105,11 +152,28 @@ func ExportGenesis(ctx sdk.Context, keeper *Keeper) *types.GenesisState {
 			ContractInfo:        contract,
 			ContractState:       state,
 			ContractCodeHistory: contractCodeHistory,
+			StargateQueryPaths:  keeper.GetContractStargateQueryAllowlist(ctx, addr),
+			Metadata:            keeper.GetContractMetadata(ctx, addr),
 		})
 		return false
 	})
 
-	for _, k := range [][]byte{types.KeyLastCodeID, types.KeyLastInstanceID} {
+	keeper.IterateContractsMigrations(ctx, func(m types.ContractsMigration) bool {
+		state := types.ContractsMigrationState{Migration: m}
+		keeper.IterateContractMigrationResults(ctx, m.ID, func(r types.ContractMigrationResult) bool {
+			state.Results = append(state.Results, r)
+			return false
+		})
+		genState.ContractsMigrations = append(genState.ContractsMigrations, state)
+		return false
+	})
+
+	keeper.IteratePendingAdminTransfers(ctx, func(transfer types.PendingAdminTransfer) bool {
+		genState.PendingAdminTransfers = append(genState.PendingAdminTransfers, transfer)
+		return false
+	})
+
+	for _, k := range [][]byte{types.KeyLastCodeID, types.KeyLastInstanceID, types.KeyLastContractsMigrationID} {
 		genState.Sequences = append(genState.Sequences, types.Sequence{
 			IDKey: k,
 			Value: keeper.PeekAutoIncrementID(ctx, k),
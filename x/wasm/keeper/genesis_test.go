@@ -71,6 +71,12 @@ func TestGenesisExportImport(t *testing.T) {
 			err = contractKeeper.PinCode(srcCtx, codeID)
 			require.NoError(t, err)
 		}
+		if i%3 == 0 {
+			err = wasmKeeper.storeContractSchema(srcCtx, codeID, types.ContractSchema{
+				Execute: []byte(fmt.Sprintf(`{"type":"object","required":["index_%d"]}`, i)),
+			})
+			require.NoError(t, err)
+		}
 		if contractExtension {
 			anyTime := time.Now().UTC()
 			var nestedType v1beta1.TextProposal
@@ -87,7 +93,39 @@ func TestGenesisExportImport(t *testing.T) {
 		wasmKeeper.appendToContractHistory(srcCtx, contractAddr, history...)
 		err = wasmKeeper.importContractState(srcCtx, contractAddr, stateModels)
 		require.NoError(t, err)
+		if i%2 == 0 {
+			err = wasmKeeper.storeContractMetadata(srcCtx, contractAddr, types.ContractMetadata{
+				Description: fmt.Sprintf("contract %d", i),
+				Tags:        []types.MetadataTag{{Key: "index", Value: fmt.Sprintf("%d", i)}},
+			})
+			require.NoError(t, err)
+		}
+	}
+	migration := types.ContractsMigration{
+		ID:          wasmKeeper.autoIncrementID(srcCtx, types.KeyLastContractsMigrationID),
+		CodeID:      1,
+		NewCodeID:   2,
+		Msg:         []byte(`{}`),
+		BatchSize:   types.DefaultContractsMigrationBatchSize,
+		StartHeight: 1,
+		Succeeded:   1,
 	}
+	require.NoError(t, wasmKeeper.storeContractsMigration(srcCtx, migration))
+	migratedAddr := BuildContractAddressClassic(1, 1)
+	wasmKeeper.storeContractMigrationResult(srcCtx, migration.ID, migratedAddr, types.ContractMigrationResult{
+		Contract: migratedAddr.String(),
+		Success:  true,
+		Height:   1,
+	})
+
+	proposedAdmin := RandomAccountAddress(t)
+	wasmKeeper.storePendingAdminTransfer(srcCtx, migratedAddr, proposedAdmin, types.PendingAdminTransfer{
+		Contract:     migratedAddr.String(),
+		NewAdmin:     proposedAdmin.String(),
+		Proposer:     RandomBech32AccountAddress(t),
+		ExpiryHeight: 100,
+	})
+
 	var wasmParams types.Params
 	f.NilChance(0).Fuzz(&wasmParams)
 	err = wasmKeeper.SetParams(srcCtx, wasmParams)
@@ -118,6 +156,10 @@ func TestGenesisExportImport(t *testing.T) {
 
 		wasmKeeper.addToContractCodeSecondaryIndex(srcCtx, address, history[len(history)-1])
 		wasmKeeper.addToContractCreatorSecondaryIndex(srcCtx, creatorAddress, history[0].Updated, address)
+		if admin := info.AdminAddr(); admin != nil {
+			wasmKeeper.addToContractAdminSecondaryIndex(srcCtx, admin, address)
+		}
+		wasmKeeper.addToContractLabelSecondaryIndex(srcCtx, info.Label, address)
 		return false
 	})
 
@@ -578,6 +620,7 @@ func TestImportContractWithCodeHistoryPreserved(t *testing.T) {
 			Permission: types.AccessTypeOnlyAddress,
 			Address:    codeCreatorAddr,
 		},
+		Analysis: &hackatomCodeAnalysis,
 	}
 	assert.Equal(t, expCodeInfo, *gotCodeInfo)
 
@@ -11,6 +11,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ibcfeetypes "github.com/cosmos/ibc-go/v7/modules/apps/29-fee/types"
 	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
 
@@ -192,6 +193,50 @@ func (h IBCRawPacketHandler) DispatchMsg(ctx sdk.Context, _ sdk.AccAddress, cont
 	return nil, [][]byte{val}, nil
 }
 
+// IBCPacketFeeHandler lets contracts attach ICS-29 relayer fees to the packets they send via the IBCRawPacketHandler.
+// The contract dispatches a `MsgPayPacketFee` stargate message for its own port right before the IBC `SendPacket`
+// message so that the fee is escrowed for the next packet sequence on the channel.
+type IBCPacketFeeHandler struct {
+	feeKeeper types.ICS29FeeKeeper
+}
+
+// NewIBCPacketFeeHandler constructor
+func NewIBCPacketFeeHandler(feeKeeper types.ICS29FeeKeeper) IBCPacketFeeHandler {
+	return IBCPacketFeeHandler{feeKeeper: feeKeeper}
+}
+
+// DispatchMsg escrows the relayer fee for the next packet sent by the contract on the channel.
+func (h IBCPacketFeeHandler) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) ([]sdk.Event, [][]byte, error) {
+	if msg.Stargate == nil || msg.Stargate.TypeURL != sdk.MsgTypeURL(&ibcfeetypes.MsgPayPacketFee{}) {
+		return nil, nil, types.ErrUnknownMsg
+	}
+	if contractIBCPortID == "" {
+		return nil, nil, errorsmod.Wrapf(types.ErrUnsupportedForContract, "ibc not supported")
+	}
+	var feeMsg ibcfeetypes.MsgPayPacketFee
+	if err := feeMsg.Unmarshal(msg.Stargate.Value); err != nil {
+		return nil, nil, errorsmod.Wrap(types.ErrInvalidMsg, err.Error())
+	}
+	if err := feeMsg.ValidateBasic(); err != nil {
+		return nil, nil, err
+	}
+	if feeMsg.SourcePortId != contractIBCPortID {
+		return nil, nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "port not owned by contract")
+	}
+	if feeMsg.Signer != contractAddr.String() {
+		return nil, nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "contract doesn't have permission")
+	}
+	res, err := h.feeKeeper.PayPacketFee(sdk.WrapSDKContext(ctx), &feeMsg)
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "pay packet fee")
+	}
+	val, err := res.Marshal()
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "failed to marshal pay packet fee response")
+	}
+	return nil, [][]byte{val}, nil
+}
+
 var _ Messenger = MessageHandlerFunc(nil)
 
 // MessageHandlerFunc is a helper to construct a function based message handler.
@@ -40,6 +40,12 @@ type MessageEncoders struct {
 	Stargate     func(sender sdk.AccAddress, msg *wasmvmtypes.StargateMsg) ([]sdk.Msg, error)
 	Wasm         func(sender sdk.AccAddress, msg *wasmvmtypes.WasmMsg) ([]sdk.Msg, error)
 	Gov          func(sender sdk.AccAddress, msg *wasmvmtypes.GovMsg) ([]sdk.Msg, error)
+	// Authz, Feegrant, NFT and Group are opt-in encoders for module messages sent as `custom` variant.
+	// See types.ModuleMsg
+	Authz    AuthzEncoder
+	Feegrant FeegrantEncoder
+	NFT      NFTEncoder
+	Group    GroupEncoder
 }
 
 func DefaultEncoders(unpacker codectypes.AnyUnpacker, portSource types.ICS20TransferPortSource) MessageEncoders {
@@ -83,6 +89,18 @@ func (e MessageEncoders) Merge(o *MessageEncoders) MessageEncoders {
 	if o.Gov != nil {
 		e.Gov = o.Gov
 	}
+	if o.Authz != nil {
+		e.Authz = o.Authz
+	}
+	if o.Feegrant != nil {
+		e.Feegrant = o.Feegrant
+	}
+	if o.NFT != nil {
+		e.NFT = o.NFT
+	}
+	if o.Group != nil {
+		e.Group = o.Group
+	}
 	return e
 }
 
@@ -91,7 +109,7 @@ func (e MessageEncoders) Encode(ctx sdk.Context, contractAddr sdk.AccAddress, co
 	case msg.Bank != nil:
 		return e.Bank(contractAddr, msg.Bank)
 	case msg.Custom != nil:
-		return e.Custom(contractAddr, msg.Custom)
+		return e.encodeCustom(contractAddr, msg.Custom)
 	case msg.Distribution != nil:
 		return e.Distribution(contractAddr, msg.Distribution)
 	case msg.IBC != nil:
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"context"
 	"encoding/json"
 	"testing"
 
@@ -14,6 +15,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	ibcfeetypes "github.com/cosmos/ibc-go/v7/modules/apps/29-fee/types"
 	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 	"github.com/stretchr/testify/assert"
@@ -328,6 +330,95 @@ func TestIBCRawPacketHandler(t *testing.T) {
 	}
 }
 
+func TestIBCPacketFeeHandler(t *testing.T) {
+	ctx := sdk.Context{}.WithContext(context.Background())
+	const ibcPort = "contractsIBCPort"
+	contractAddr := RandomAccountAddress(t)
+	myFee := ibcfeetypes.NewFee(sdk.NewCoins(sdk.NewInt64Coin("stake", 1)), sdk.NewCoins(sdk.NewInt64Coin("stake", 2)), sdk.NewCoins(sdk.NewInt64Coin("stake", 3)))
+	stargateMsg := func(t *testing.T, mutators ...func(*ibcfeetypes.MsgPayPacketFee)) wasmvmtypes.CosmosMsg {
+		msg := ibcfeetypes.NewMsgPayPacketFee(myFee, ibcPort, "channel-1", contractAddr.String(), nil)
+		for _, m := range mutators {
+			m(msg)
+		}
+		bz, err := msg.Marshal()
+		require.NoError(t, err)
+		return wasmvmtypes.CosmosMsg{Stargate: &wasmvmtypes.StargateMsg{TypeURL: sdk.MsgTypeURL(msg), Value: bz}}
+	}
+	specs := map[string]struct {
+		srcMsg     wasmvmtypes.CosmosMsg
+		srcIBCPort string
+		expPaidFee *ibcfeetypes.MsgPayPacketFee
+		expErr     *errorsmod.Error
+		keeperErr  error
+	}{
+		"all good": {
+			srcMsg:     stargateMsg(t),
+			srcIBCPort: ibcPort,
+			expPaidFee: ibcfeetypes.NewMsgPayPacketFee(myFee, ibcPort, "channel-1", contractAddr.String(), nil),
+		},
+		"other stargate msg": {
+			srcMsg:     wasmvmtypes.CosmosMsg{Stargate: &wasmvmtypes.StargateMsg{TypeURL: "/foo.bar", Value: []byte{}}},
+			srcIBCPort: ibcPort,
+			expErr:     types.ErrUnknownMsg,
+		},
+		"non stargate msg": {
+			srcMsg:     wasmvmtypes.CosmosMsg{Bank: &wasmvmtypes.BankMsg{}},
+			srcIBCPort: ibcPort,
+			expErr:     types.ErrUnknownMsg,
+		},
+		"non ibc contract": {
+			srcMsg: stargateMsg(t),
+			expErr: types.ErrUnsupportedForContract,
+		},
+		"other port": {
+			srcMsg: stargateMsg(t, func(msg *ibcfeetypes.MsgPayPacketFee) {
+				msg.SourcePortId = "otherPort"
+			}),
+			srcIBCPort: ibcPort,
+			expErr:     sdkerrors.ErrUnauthorized,
+		},
+		"other signer": {
+			srcMsg: stargateMsg(t, func(msg *ibcfeetypes.MsgPayPacketFee) {
+				msg.Signer = RandomBech32AccountAddress(t)
+			}),
+			srcIBCPort: ibcPort,
+			expErr:     sdkerrors.ErrUnauthorized,
+		},
+		"fee keeper fails": {
+			srcMsg:     stargateMsg(t),
+			srcIBCPort: ibcPort,
+			keeperErr:  ibcfeetypes.ErrFeeNotEnabled,
+			expErr:     ibcfeetypes.ErrFeeNotEnabled,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			var gotPaidFee *ibcfeetypes.MsgPayPacketFee
+			feeKeeper := wasmtesting.MockICS29FeeKeeper{
+				PayPacketFeeFn: func(goCtx context.Context, msg *ibcfeetypes.MsgPayPacketFee) (*ibcfeetypes.MsgPayPacketFeeResponse, error) {
+					if spec.keeperErr != nil {
+						return nil, spec.keeperErr
+					}
+					gotPaidFee = msg
+					return &ibcfeetypes.MsgPayPacketFeeResponse{}, nil
+				},
+			}
+			h := NewIBCPacketFeeHandler(feeKeeper)
+			// when
+			evts, data, gotErr := h.DispatchMsg(ctx, contractAddr, spec.srcIBCPort, spec.srcMsg)
+			// then
+			require.True(t, spec.expErr.Is(gotErr), "exp %v but got %#+v", spec.expErr, gotErr)
+			if spec.expErr != nil {
+				assert.Nil(t, gotPaidFee)
+				return
+			}
+			assert.Nil(t, evts)
+			require.Len(t, data, 1)
+			assert.Equal(t, spec.expPaidFee, gotPaidFee)
+		})
+	}
+}
+
 func TestBurnCoinMessageHandlerIntegration(t *testing.T) {
 	// testing via full keeper setup so that we are confident the
 	// module permissions are set correct and no other handler
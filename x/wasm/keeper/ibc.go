@@ -5,7 +5,10 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
 
 	"github.com/CosmWasm/wasmd/x/wasm/types"
@@ -44,6 +47,88 @@ func ContractFromPortID(portID string) (sdk.AccAddress, error) {
 	return sdk.AccAddressFromBech32(portID[len(portIDPrefix):])
 }
 
+// GetIBCChannelsByPort returns the channels bound to the given port together with their
+// sequence counters, the packet commitments that are still outstanding and the acknowledgements
+// written. An optional channel id restricts the result to this channel. The paginations are applied
+// to the packet commitments and acknowledgements of each channel. Page keys are only valid for the
+// channel that they were returned for so that the channel id is required with a key.
+func (k Keeper) GetIBCChannelsByPort(
+	ctx sdk.Context,
+	portID, channelID string,
+	commitmentsPagination, acknowledgementsPagination *query.PageRequest,
+) ([]types.ContractIBCChannel, error) {
+	if channelID == "" && (hasPageKey(commitmentsPagination) || hasPageKey(acknowledgementsPagination)) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("channel id required for pagination key")
+	}
+	var channels []channeltypes.IdentifiedChannel
+	if channelID != "" {
+		ch, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
+		if !found {
+			return nil, types.ErrNotFound.Wrapf("channel %s on port %s", channelID, portID)
+		}
+		channels = append(channels, channeltypes.NewIdentifiedChannel(portID, channelID, ch))
+	} else {
+		channels = k.channelKeeper.GetAllChannelsWithPortPrefix(ctx, portID)
+	}
+	var result []types.ContractIBCChannel
+	for _, ch := range channels {
+		if ch.PortId != portID { // prefix match only
+			continue
+		}
+		entry := types.ContractIBCChannel{
+			ChannelID:             ch.ChannelId,
+			State:                 ch.State.String(),
+			Ordering:              ch.Ordering.String(),
+			Version:               ch.Version,
+			ConnectionHops:        ch.ConnectionHops,
+			CounterpartyPortID:    ch.Counterparty.PortId,
+			CounterpartyChannelID: ch.Counterparty.ChannelId,
+		}
+		entry.NextSequenceSend, _ = k.channelKeeper.GetNextSequenceSend(ctx, ch.PortId, ch.ChannelId)
+		entry.NextSequenceRecv, _ = k.channelKeeper.GetNextSequenceRecv(ctx, ch.PortId, ch.ChannelId)
+		entry.NextSequenceAck, _ = k.channelKeeper.GetNextSequenceAck(ctx, ch.PortId, ch.ChannelId)
+
+		commitments, err := k.channelKeeper.PacketCommitments(sdk.WrapSDKContext(ctx), &channeltypes.QueryPacketCommitmentsRequest{
+			PortId:     ch.PortId,
+			ChannelId:  ch.ChannelId,
+			Pagination: commitmentsPagination,
+		})
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "packet commitments of %s", ch.ChannelId)
+		}
+		entry.PacketCommitments = toPacketStates(commitments.Commitments)
+		entry.CommitmentsPagination = commitments.Pagination
+
+		acks, err := k.channelKeeper.PacketAcknowledgements(sdk.WrapSDKContext(ctx), &channeltypes.QueryPacketAcknowledgementsRequest{
+			PortId:     ch.PortId,
+			ChannelId:  ch.ChannelId,
+			Pagination: acknowledgementsPagination,
+		})
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "packet acknowledgements of %s", ch.ChannelId)
+		}
+		entry.PacketAcknowledgements = toPacketStates(acks.Acknowledgements)
+		entry.AcknowledgementsPagination = acks.Pagination
+		result = append(result, entry)
+	}
+	return result, nil
+}
+
+func hasPageKey(pagination *query.PageRequest) bool {
+	return pagination != nil && len(pagination.Key) != 0
+}
+
+func toPacketStates(src []*channeltypes.PacketState) []types.PacketState {
+	if len(src) == 0 {
+		return nil
+	}
+	result := make([]types.PacketState, len(src))
+	for i, p := range src {
+		result[i] = types.PacketState{Sequence: p.Sequence, Hash: p.Data}
+	}
+	return result
+}
+
 // AuthenticateCapability wraps the scopedKeeper's AuthenticateCapability function
 func (k Keeper) AuthenticateCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) bool {
 	return k.capabilityKeeper.AuthenticateCapability(ctx, cap, name)
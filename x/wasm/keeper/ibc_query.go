@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// IBCQueryAuthorizer decides if a query that was received from a counterparty chain over the given channel
+// can be executed on this chain.
+type IBCQueryAuthorizer func(ctx sdk.Context, channelID string, request wasmvmtypes.QueryRequest) bool
+
+// AcceptContractQueriesOnly is the default IBCQueryAuthorizer. It accepts smart, raw and contract info
+// queries to wasm contracts only.
+func AcceptContractQueriesOnly(_ sdk.Context, _ string, request wasmvmtypes.QueryRequest) bool {
+	if request.Wasm == nil {
+		return false
+	}
+	return request.Wasm.Smart != nil || request.Wasm.Raw != nil || request.Wasm.ContractInfo != nil
+}
+
+// IBCQueryHostEnabled returns true when this chain answers contract queries received over IBC
+func (k Keeper) IBCQueryHostEnabled() bool {
+	return k.ibcQueryAuthorizer != nil
+}
+
+// BindIBCQueryPort binds the cross-chain query host port when not done before.
+// This should be called on chain upgrades, when the query host is enabled for an existing chain.
+func (k Keeper) BindIBCQueryPort(ctx sdk.Context) error {
+	if _, ok := k.capabilityKeeper.GetCapability(ctx, host.PortPath(types.IBCQueryPortID)); ok {
+		return nil
+	}
+	return k.bindIbcPort(ctx, types.IBCQueryPortID)
+}
+
+// OnRecvIBCQuery executes a query that was received from a counterparty chain and returns the raw result.
+// The query must be accepted by the IBCQueryAuthorizer and is limited by the smart query gas limit.
+func (k Keeper) OnRecvIBCQuery(ctx sdk.Context, channelID string, request wasmvmtypes.QueryRequest) (res []byte, err error) {
+	if !k.IBCQueryHostEnabled() {
+		return nil, errorsmod.Wrap(types.ErrUnsupportedForContract, "ibc query host disabled")
+	}
+	if !k.ibcQueryAuthorizer(ctx, channelID, request) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "query not allowed")
+	}
+	// recover from out-of-gas panic
+	defer func() {
+		if r := recover(); r != nil {
+			oog, ok := r.(sdk.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+			res, err = nil, errorsmod.Wrapf(sdkerrors.ErrOutOfGas, "out of gas in location: %v", oog.Descriptor)
+		}
+	}()
+	gasLimit := k.gasRegister.ToWasmVMGas(k.queryGasLimit)
+	return NewQueryHandler(ctx, k.wasmVMQueryHandler, nil, k.gasRegister).Query(request, gasLimit)
+}
@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"fmt"
+	"testing"
+
+	errorsmod "cosmossdk.io/errors"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestOnRecvIBCQuery(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities, WithIBCQueryHost(AcceptContractQueriesOnly))
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+
+	specs := map[string]struct {
+		src    wasmvmtypes.QueryRequest
+		expRsp []byte
+		expErr *errorsmod.Error
+	}{
+		"smart query": {
+			src: wasmvmtypes.QueryRequest{Wasm: &wasmvmtypes.WasmQuery{Smart: &wasmvmtypes.SmartQuery{
+				ContractAddr: example.Contract.String(),
+				Msg:          []byte(`{"verifier":{}}`),
+			}}},
+			expRsp: []byte(fmt.Sprintf(`{"verifier":"%s"}`, example.VerifierAddr.String())),
+		},
+		"raw query": {
+			src: wasmvmtypes.QueryRequest{Wasm: &wasmvmtypes.WasmQuery{Raw: &wasmvmtypes.RawQuery{
+				ContractAddr: example.Contract.String(),
+				Key:          []byte("unknown"),
+			}}},
+			expRsp: nil,
+		},
+		"bank query rejected": {
+			src: wasmvmtypes.QueryRequest{Bank: &wasmvmtypes.BankQuery{AllBalances: &wasmvmtypes.AllBalancesQuery{
+				Address: example.Contract.String(),
+			}}},
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"code info query rejected": {
+			src:    wasmvmtypes.QueryRequest{Wasm: &wasmvmtypes.WasmQuery{}},
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotRsp, gotErr := keepers.WasmKeeper.OnRecvIBCQuery(ctx, "channel-0", spec.src)
+			if spec.expErr != nil {
+				assert.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expRsp, gotRsp)
+		})
+	}
+}
+
+func TestOnRecvIBCQueryHostDisabled(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+
+	_, gotErr := keepers.WasmKeeper.OnRecvIBCQuery(ctx, "channel-0", wasmvmtypes.QueryRequest{Wasm: &wasmvmtypes.WasmQuery{Smart: &wasmvmtypes.SmartQuery{
+		ContractAddr: example.Contract.String(),
+		Msg:          []byte(`{"verifier":{}}`),
+	}}})
+	assert.ErrorIs(t, gotErr, types.ErrUnsupportedForContract)
+}
+
+func TestBindIBCQueryPort(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities, WithIBCQueryHost(AcceptContractQueriesOnly))
+	k := keepers.WasmKeeper
+	require.NoError(t, k.BindIBCQueryPort(ctx))
+	_, ok := k.capabilityKeeper.GetCapability(ctx, host.PortPath(types.IBCQueryPortID))
+	assert.True(t, ok)
+	// can be called multiple times
+	require.NoError(t, k.BindIBCQueryPort(ctx))
+}
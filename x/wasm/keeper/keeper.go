@@ -91,17 +91,30 @@ type Keeper struct {
 	accountKeeper         types.AccountKeeper
 	bank                  CoinTransferrer
 	portKeeper            types.PortKeeper
+	channelKeeper         types.ChannelKeeper
 	capabilityKeeper      types.CapabilityKeeper
 	wasmVM                types.WasmerEngine
 	wasmVMQueryHandler    WasmVMQueryHandler
 	wasmVMResponseHandler WasmVMResponseHandler
 	messenger             Messenger
 	// queryGasLimit is the max wasmvm gas that can be spent on executing a query with a contract
-	queryGasLimit        uint64
-	gasRegister          GasRegister
-	maxQueryStackSize    uint32
-	acceptedAccountTypes map[reflect.Type]struct{}
-	accountPruner        AccountPruner
+	queryGasLimit uint64
+	// maxIBCCallbackGas is the max sdk gas that can be spent by a contract in an IBC callback. 0 means no limit.
+	maxIBCCallbackGas uint64
+	// defaultSubMsgGasLimit is the max sdk gas for submessages that do not set a gas limit. 0 means no limit.
+	defaultSubMsgGasLimit uint64
+	gasRegister           GasRegister
+	maxQueryStackSize     uint32
+	acceptedAccountTypes  map[reflect.Type]struct{}
+	accountPruner         AccountPruner
+	// ibcQueryAuthorizer decides which queries from counterparty chains are answered. Nil disables the query host.
+	ibcQueryAuthorizer IBCQueryAuthorizer
+	// authzKeeper is used to query the authz grants for contracts. Nil disables the query.
+	authzKeeper types.AuthzKeeper
+	// addressGeneratorStrategies are the registered strategies for contracts instantiated with a salt
+	addressGeneratorStrategies map[string]AddressGeneratorStrategy
+	// availableCapabilities are the capabilities supported by the chain
+	availableCapabilities []string
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
 	authority string
@@ -133,6 +146,9 @@ func (k Keeper) SetParams(ctx sdk.Context, ps types.Params) error {
 	if err := ps.ValidateBasic(); err != nil {
 		return err
 	}
+	if _, err := k.addressGeneratorStrategy(ps.AddressGenerator); err != nil {
+		return err
+	}
 
 	store := ctx.KVStore(k.storeKey)
 	bz, err := k.cdc.Marshal(&ps)
@@ -185,9 +201,14 @@ func (k Keeper) create(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte,
 	if err != nil {
 		return 0, checksum, errorsmod.Wrap(types.ErrCreateFailed, err.Error())
 	}
+	analysis, err := newCodeAnalysis(*report, wasmCode)
+	if err != nil {
+		return 0, checksum, errorsmod.Wrap(types.ErrCreateFailed, err.Error())
+	}
 	codeID = k.autoIncrementID(ctx, types.KeyLastCodeID)
 	k.Logger(ctx).Debug("storing new contract", "capabilities", report.RequiredCapabilities, "code_id", codeID)
 	codeInfo := types.NewCodeInfo(checksum, creator, *instantiateAccess)
+	codeInfo.Analysis = &analysis
 	k.storeCodeInfo(ctx, codeID, codeInfo)
 
 	evt := sdk.NewEvent(
@@ -209,6 +230,28 @@ func (k Keeper) storeCodeInfo(ctx sdk.Context, codeID uint64, codeInfo types.Cod
 	store.Set(types.GetCodeKey(codeID), k.cdc.MustMarshal(&codeInfo))
 }
 
+// setCodeVerificationInfo stores the source and builder of the code when the given code hash matches the checksum.
+// Nothing is stored when all values are empty. The values are validated with types.ValidateVerificationInfo before.
+func (k Keeper) setCodeVerificationInfo(ctx sdk.Context, codeID uint64, source, builder string, codeHash []byte) error {
+	if len(source) == 0 && len(builder) == 0 && len(codeHash) == 0 {
+		return nil
+	}
+	codeInfo := k.GetCodeInfo(ctx, codeID)
+	if codeInfo == nil {
+		return types.ErrNoSuchCodeFn(codeID).Wrapf("code id %d", codeID)
+	}
+	if !bytes.Equal(codeInfo.CodeHash, codeHash) {
+		return errorsmod.Wrapf(types.ErrInvalid, "code-hash mismatch: %X, checksum: %X", codeHash, codeInfo.CodeHash)
+	}
+	if len(codeInfo.Source) != 0 || len(codeInfo.Builder) != 0 {
+		return errorsmod.Wrap(types.ErrDuplicate, "code verification info")
+	}
+	codeInfo.Source = source
+	codeInfo.Builder = builder
+	k.storeCodeInfo(ctx, codeID, *codeInfo)
+	return nil
+}
+
 func (k Keeper) importCode(ctx sdk.Context, codeID uint64, codeInfo types.CodeInfo, wasmCode []byte) error {
 	if ioutils.IsGzip(wasmCode) {
 		var err error
@@ -225,6 +268,18 @@ func (k Keeper) importCode(ctx sdk.Context, codeID uint64, codeInfo types.CodeIn
 		return errorsmod.Wrap(types.ErrInvalid, "code hashes not same")
 	}
 
+	if codeInfo.Analysis == nil { // exported before the analysis was persisted
+		report, err := k.wasmVM.AnalyzeCode(newCodeHash)
+		if err != nil {
+			return errorsmod.Wrap(types.ErrCreateFailed, err.Error())
+		}
+		analysis, err := newCodeAnalysis(*report, wasmCode)
+		if err != nil {
+			return errorsmod.Wrap(types.ErrCreateFailed, err.Error())
+		}
+		codeInfo.Analysis = &analysis
+	}
+
 	store := ctx.KVStore(k.storeKey)
 	key := types.GetCodeKey(codeID)
 	if store.Has(key) {
@@ -250,6 +305,9 @@ func (k Keeper) instantiate(
 	if creator == nil {
 		return nil, nil, types.ErrEmpty.Wrap("creator")
 	}
+	if err := k.validateContractMsg(ctx, codeID, initMsg, instantiateSchema); err != nil {
+		return nil, nil, err
+	}
 	instanceCosts := k.gasRegister.NewContractInstanceCosts(k.IsPinnedCode(ctx, codeID), len(initMsg))
 	ctx.GasMeter().ConsumeGas(instanceCosts, "Loading CosmWasm module: instantiate")
 
@@ -330,11 +388,11 @@ func (k Keeper) instantiate(
 	contractInfo := types.NewContractInfo(codeID, creator, admin, label, createdAt)
 
 	// check for IBC flag
-	report, err := k.wasmVM.AnalyzeCode(codeInfo.CodeHash)
+	analysis, err := k.codeAnalysis(*codeInfo)
 	if err != nil {
 		return nil, nil, errorsmod.Wrap(types.ErrInstantiateFailed, err.Error())
 	}
-	if report.HasIBCEntryPoints {
+	if analysis.HasIBCEntryPoints {
 		// register IBC port
 		ibcPort, err := k.ensureIbcPort(ctx, contractAddress)
 		if err != nil {
@@ -347,6 +405,10 @@ func (k Keeper) instantiate(
 	historyEntry := contractInfo.InitialHistory(initMsg)
 	k.addToContractCodeSecondaryIndex(ctx, contractAddress, historyEntry)
 	k.addToContractCreatorSecondaryIndex(ctx, creator, historyEntry.Updated, contractAddress)
+	if admin != nil {
+		k.addToContractAdminSecondaryIndex(ctx, admin, contractAddress)
+	}
+	k.addToContractLabelSecondaryIndex(ctx, label, contractAddress)
 	k.appendToContractHistory(ctx, contractAddress, historyEntry)
 	k.storeContractInfo(ctx, contractAddress, &contractInfo)
 
@@ -372,6 +434,9 @@ func (k Keeper) execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller
 		return nil, err
 	}
 
+	if err := k.validateContractMsg(ctx, contractInfo.CodeID, msg, executeSchema); err != nil {
+		return nil, err
+	}
 	executeCosts := k.gasRegister.InstantiateContractCosts(k.IsPinnedCode(ctx, contractInfo.CodeID), len(msg))
 	ctx.GasMeter().ConsumeGas(executeCosts, "Loading CosmWasm module: execute")
 
@@ -409,6 +474,9 @@ func (k Keeper) execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller
 
 func (k Keeper) migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, newCodeID uint64, msg []byte, authZ AuthorizationPolicy) ([]byte, error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "migrate")
+	if err := k.validateContractMsg(ctx, newCodeID, msg, migrateSchema); err != nil {
+		return nil, err
+	}
 	migrateSetupCosts := k.gasRegister.InstantiateContractCosts(k.IsPinnedCode(ctx, newCodeID), len(msg))
 	ctx.GasMeter().ConsumeGas(migrateSetupCosts, "Loading CosmWasm module: migrate")
 
@@ -430,13 +498,13 @@ func (k Keeper) migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller
 	}
 
 	// check for IBC flag
-	switch report, err := k.wasmVM.AnalyzeCode(newCodeInfo.CodeHash); {
+	switch analysis, err := k.codeAnalysis(*newCodeInfo); {
 	case err != nil:
 		return nil, errorsmod.Wrap(types.ErrMigrationFailed, err.Error())
-	case !report.HasIBCEntryPoints && contractInfo.IBCPortID != "":
+	case !analysis.HasIBCEntryPoints && contractInfo.IBCPortID != "":
 		// prevent update to non ibc contract
 		return nil, errorsmod.Wrap(types.ErrMigrationFailed, "requires ibc callbacks")
-	case report.HasIBCEntryPoints && contractInfo.IBCPortID == "":
+	case analysis.HasIBCEntryPoints && contractInfo.IBCPortID == "":
 		// add ibc port
 		ibcPort, err := k.ensureIbcPort(ctx, contractAddress)
 		if err != nil {
@@ -553,6 +621,49 @@ func (k Keeper) reply(ctx sdk.Context, contractAddress sdk.AccAddress, reply was
 	return data, nil
 }
 
+// requiresCapability returns true when the code of the contract requires the given capability.
+// No gas is charged for the lookup as the contract instance is loaded and paid for in the reply anyway.
+func (k Keeper) requiresCapability(ctx sdk.Context, contractAddress sdk.AccAddress, capability string) (bool, error) {
+	_, codeInfo, _, err := k.contractInstance(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()), contractAddress)
+	if err != nil {
+		return false, err
+	}
+	analysis, err := k.codeAnalysis(codeInfo)
+	if err != nil {
+		return false, err
+	}
+	return analysis.RequiresCapability(capability), nil
+}
+
+// codeAnalysis returns the persisted analysis report of the code. Codes stored before the report was persisted are
+// analyzed by the VM.
+func (k Keeper) codeAnalysis(codeInfo types.CodeInfo) (*types.CodeAnalysis, error) {
+	if codeInfo.Analysis != nil {
+		return codeInfo.Analysis, nil
+	}
+	report, err := k.wasmVM.AnalyzeCode(codeInfo.CodeHash)
+	if err != nil {
+		return nil, err
+	}
+	wasmCode, err := k.wasmVM.GetCode(codeInfo.CodeHash)
+	if err != nil {
+		return nil, err
+	}
+	analysis, err := newCodeAnalysis(*report, wasmCode)
+	if err != nil {
+		return nil, err
+	}
+	return &analysis, nil
+}
+
+func newCodeAnalysis(report wasmvmtypes.AnalysisReport, wasmCode []byte) (types.CodeAnalysis, error) {
+	exports, err := ioutils.ExportedFunctions(wasmCode)
+	if err != nil {
+		return types.CodeAnalysis{}, errorsmod.Wrap(err, "exported functions")
+	}
+	return types.NewCodeAnalysis(report, exports), nil
+}
+
 // addToContractCodeSecondaryIndex adds element to the index for contracts-by-codeid queries
 func (k Keeper) addToContractCodeSecondaryIndex(ctx sdk.Context, contractAddress sdk.AccAddress, entry types.ContractCodeHistoryEntry) {
 	store := ctx.KVStore(k.storeKey)
@@ -570,6 +681,50 @@ func (k Keeper) addToContractCreatorSecondaryIndex(ctx sdk.Context, creatorAddre
 	store.Set(types.GetContractByCreatorSecondaryIndexKey(creatorAddress, position.Bytes(), contractAddress), []byte{})
 }
 
+// addToContractAdminSecondaryIndex adds element to the index for contracts-by-admin queries
+func (k Keeper) addToContractAdminSecondaryIndex(ctx sdk.Context, adminAddress, contractAddress sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetContractByAdminSecondaryIndexKey(adminAddress, contractAddress), []byte{})
+}
+
+// removeFromContractAdminSecondaryIndex removes element from the index for contracts-by-admin queries
+func (k Keeper) removeFromContractAdminSecondaryIndex(ctx sdk.Context, adminAddress, contractAddress sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Delete(types.GetContractByAdminSecondaryIndexKey(adminAddress, contractAddress))
+}
+
+// addToContractLabelSecondaryIndex adds element to the index for contracts-by-label queries
+func (k Keeper) addToContractLabelSecondaryIndex(ctx sdk.Context, label string, contractAddress sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetContractByLabelSecondaryIndexKey(label, contractAddress), []byte{})
+}
+
+// IterateContractsByAdmin iterates over all contracts with given admin address in order of the contract address.
+func (k Keeper) IterateContractsByAdmin(ctx sdk.Context, admin sdk.AccAddress, cb func(address sdk.AccAddress) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetContractsByAdminPrefix(admin))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if cb(iter.Key()) {
+			return
+		}
+	}
+}
+
+// IterateContractsByLabelPrefix iterates over all contracts with a label that starts with the given prefix in
+// order of the label.
+func (k Keeper) IterateContractsByLabelPrefix(ctx sdk.Context, labelPrefix string, cb func(address sdk.AccAddress) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetContractsByLabelPrefix(labelPrefix))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if cb(types.ParseContractByLabelSecondaryIndexKey(iter.Key())) {
+			return
+		}
+	}
+}
+
 // IterateContractsByCreator iterates over all contracts with given creator address in order of creation time asc.
 func (k Keeper) IterateContractsByCreator(ctx sdk.Context, creator sdk.AccAddress, cb func(address sdk.AccAddress) bool) {
 	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetContractsByCreatorPrefix(creator))
@@ -603,16 +758,28 @@ func (k Keeper) setContractAdmin(ctx sdk.Context, contractAddress, caller, newAd
 	if !authZ.CanModifyContract(contractInfo.AdminAddr(), caller) {
 		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "can not modify contract")
 	}
+	k.updateContractAdmin(ctx, contractAddress, contractInfo, newAdmin)
+	return nil
+}
+
+// updateContractAdmin stores the new admin with the admin index. A pending admin transfer is dropped as it was
+// proposed by the old admin.
+func (k Keeper) updateContractAdmin(ctx sdk.Context, contractAddress sdk.AccAddress, contractInfo *types.ContractInfo, newAdmin sdk.AccAddress) {
+	if oldAdmin := contractInfo.AdminAddr(); oldAdmin != nil {
+		k.removeFromContractAdminSecondaryIndex(ctx, oldAdmin, contractAddress)
+	}
+	if newAdmin != nil {
+		k.addToContractAdminSecondaryIndex(ctx, newAdmin, contractAddress)
+	}
 	newAdminStr := newAdmin.String()
 	contractInfo.Admin = newAdminStr
 	k.storeContractInfo(ctx, contractAddress, contractInfo)
+	k.deletePendingAdminTransfer(ctx, contractAddress)
 	ctx.EventManager().EmitEvent(sdk.NewEvent(
 		types.EventTypeUpdateContractAdmin,
 		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddress.String()),
 		sdk.NewAttribute(types.AttributeKeyNewAdmin, newAdminStr),
 	))
-
-	return nil
 }
 
 func (k Keeper) appendToContractHistory(ctx sdk.Context, contractAddr sdk.AccAddress, newEntries ...types.ContractCodeHistoryEntry) {
@@ -1067,6 +1234,10 @@ func (k Keeper) importContract(ctx sdk.Context, contractAddr sdk.AccAddress, c *
 	k.storeContractInfo(ctx, contractAddr, c)
 	k.addToContractCodeSecondaryIndex(ctx, contractAddr, entries[len(entries)-1])
 	k.addToContractCreatorSecondaryIndex(ctx, creatorAddress, entries[0].Updated, contractAddr)
+	if admin := c.AdminAddr(); admin != nil {
+		k.addToContractAdminSecondaryIndex(ctx, admin, contractAddr)
+	}
+	k.addToContractLabelSecondaryIndex(ctx, c.Label, contractAddr)
 	return k.importContractState(ctx, contractAddr, state)
 }
 
@@ -48,6 +48,7 @@ func NewKeeper(
 		bank:                 NewBankCoinTransferrer(bankKeeper),
 		accountPruner:        NewVestingCoinBurner(bankKeeper),
 		portKeeper:           portKeeper,
+		channelKeeper:        channelKeeper,
 		capabilityKeeper:     capabilityKeeper,
 		messenger:            NewDefaultMessageHandler(router, ics4Wrapper, channelKeeper, capabilityKeeper, bankKeeper, cdc, portSource),
 		queryGasLimit:        wasmConfig.SmartQueryGasLimit,
@@ -57,10 +58,14 @@ func NewKeeper(
 		authority:            authority,
 	}
 	keeper.wasmVMQueryHandler = DefaultQueryPlugins(bankKeeper, stakingKeeper, distrKeeper, channelKeeper, keeper)
+	keeper.addressGeneratorStrategies = defaultAddressGeneratorStrategies()
+	keeper.availableCapabilities = parseCapabilities(availableCapabilities)
 	for _, o := range opts {
 		o.apply(keeper)
 	}
 	// not updateable, yet
-	keeper.wasmVMResponseHandler = NewDefaultWasmVMContractResponseHandler(NewMessageDispatcher(keeper.messenger, keeper))
+	dispatcher := NewMessageDispatcher(keeper.messenger, keeper)
+	dispatcher.defaultGasLimit = keeper.defaultSubMsgGasLimit
+	keeper.wasmVMResponseHandler = NewDefaultWasmVMContractResponseHandler(dispatcher)
 	return *keeper
 }
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"bytes"
+	"crypto/sha256"
 	_ "embed"
 	"encoding/json"
 	"errors"
@@ -33,6 +34,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/CosmWasm/wasmd/x/wasm/keeper/testdata"
 	"github.com/CosmWasm/wasmd/x/wasm/keeper/wasmtesting"
 	"github.com/CosmWasm/wasmd/x/wasm/types"
 )
@@ -141,6 +143,105 @@ func TestCreateStoresInstantiatePermission(t *testing.T) {
 	}
 }
 
+func TestCreateStoresCodeAnalysis(t *testing.T) {
+	specs := map[string]struct {
+		srcCode []byte
+		exp     types.CodeAnalysis
+	}{
+		"hackatom": {
+			srcCode: hackatomWasm,
+			exp: types.CodeAnalysis{
+				EntryPoints: []string{"instantiate", "execute", "query", "migrate", "sudo"},
+			},
+		},
+		"ibc reflect": {
+			srcCode: testdata.IBCReflectContractWasm(),
+			exp: types.CodeAnalysis{
+				HasIBCEntryPoints:    true,
+				RequiredCapabilities: []string{"iterator", "stargate"},
+				EntryPoints: []string{
+					"instantiate", "query", "migrate", "reply", "ibc_channel_open", "ibc_channel_connect",
+					"ibc_channel_close", "ibc_packet_receive", "ibc_packet_ack", "ibc_packet_timeout",
+				},
+			},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+			creator := keepers.Faucet.NewFundedRandomAccount(ctx, sdk.NewInt64Coin("denom", 100000))
+
+			codeID, _, err := keepers.ContractKeeper.Create(ctx, creator, spec.srcCode, nil)
+			require.NoError(t, err)
+
+			codeInfo := keepers.WasmKeeper.GetCodeInfo(ctx, codeID)
+			require.NotNil(t, codeInfo)
+			require.NotNil(t, codeInfo.Analysis)
+			assert.Equal(t, spec.exp, *codeInfo.Analysis)
+		})
+	}
+}
+
+func TestSetCodeVerificationInfo(t *testing.T) {
+	hackatomHash := sha256.Sum256(hackatomWasm)
+	const (
+		mySource  = "https://example.com/hackatom/v0.16.0"
+		myBuilder = "cosmwasm/workspace-optimizer:v0.12.9"
+	)
+	specs := map[string]struct {
+		srcSource   string
+		srcBuilder  string
+		srcCodeHash []byte
+		preSet      bool
+		expSource   string
+		expBuilder  string
+		expErr      *errorsmod.Error
+	}{
+		"all set": {
+			srcSource:   mySource,
+			srcBuilder:  myBuilder,
+			srcCodeHash: hackatomHash[:],
+			expSource:   mySource,
+			expBuilder:  myBuilder,
+		},
+		"none set": {},
+		"code hash mismatch": {
+			srcSource:   mySource,
+			srcBuilder:  myBuilder,
+			srcCodeHash: []byte{1},
+			expErr:      types.ErrInvalid,
+		},
+		"already set": {
+			srcSource:   mySource,
+			srcBuilder:  myBuilder,
+			srcCodeHash: hackatomHash[:],
+			preSet:      true,
+			expSource:   mySource,
+			expBuilder:  myBuilder,
+			expErr:      types.ErrDuplicate,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+			creator := keepers.Faucet.NewFundedRandomAccount(ctx, sdk.NewInt64Coin("denom", 100000))
+			codeID, _, err := keepers.ContractKeeper.Create(ctx, creator, hackatomWasm, nil)
+			require.NoError(t, err)
+			if spec.preSet {
+				require.NoError(t, keepers.ContractKeeper.SetCodeVerificationInfo(ctx, codeID, mySource, myBuilder, hackatomHash[:]))
+			}
+			// when
+			gotErr := keepers.ContractKeeper.SetCodeVerificationInfo(ctx, codeID, spec.srcSource, spec.srcBuilder, spec.srcCodeHash)
+			// then
+			require.True(t, spec.expErr.Is(gotErr), gotErr)
+			codeInfo := keepers.WasmKeeper.GetCodeInfo(ctx, codeID)
+			require.NotNil(t, codeInfo)
+			assert.Equal(t, spec.expSource, codeInfo.Source)
+			assert.Equal(t, spec.expBuilder, codeInfo.Builder)
+		})
+	}
+}
+
 func TestCreateWithParamPermissions(t *testing.T) {
 	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
 	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
@@ -416,7 +517,7 @@ func TestInstantiate(t *testing.T) {
 
 	gasAfter := ctx.GasMeter().GasConsumed()
 	if types.EnableGasVerification {
-		require.Equal(t, uint64(0x1b5bc), gasAfter-gasBefore)
+		require.Equal(t, uint64(0x1c3f2), gasAfter-gasBefore)
 	}
 
 	// ensure it is stored properly
@@ -723,6 +824,27 @@ func TestInstantiateWithContractDataResponse(t *testing.T) {
 	assert.Equal(t, []byte("my-response-data"), data)
 }
 
+func TestInstantiateUsesStoredCodeAnalysis(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+
+	wasmerMock := &wasmtesting.MockWasmer{
+		InstantiateFn: func(codeID wasmvm.Checksum, env wasmvmtypes.Env, info wasmvmtypes.MessageInfo, initMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.Response, uint64, error) {
+			return &wasmvmtypes.Response{}, 0, nil
+		},
+		AnalyzeCodeFn: wasmtesting.HasIBCAnalyzeFn,
+		CreateFn:      wasmtesting.NoOpCreateFn,
+	}
+	example := StoreRandomContract(t, ctx, keepers, wasmerMock)
+	wasmerMock.AnalyzeCodeFn = func(wasmvm.Checksum) (*wasmvmtypes.AnalysisReport, error) {
+		t.Fatal("unexpected call to analyze code")
+		return nil, nil
+	}
+
+	contractAddr, _, err := keepers.ContractKeeper.Instantiate(ctx, example.CodeID, example.CreatorAddr, nil, nil, "test", nil)
+	require.NoError(t, err)
+	assert.NotEmpty(t, keepers.WasmKeeper.GetContractInfo(ctx, contractAddr).IBCPortID)
+}
+
 func TestInstantiateWithContractFactoryChildQueriesParent(t *testing.T) {
 	// Scenario:
 	// 	given a factory contract stored
@@ -859,7 +981,7 @@ func TestExecute(t *testing.T) {
 	// make sure gas is properly deducted from ctx
 	gasAfter := ctx.GasMeter().GasConsumed()
 	if types.EnableGasVerification {
-		require.Equal(t, uint64(0x1a154), gasAfter-gasBefore)
+		require.Equal(t, uint64(0x1a1de), gasAfter-gasBefore)
 	}
 	// ensure bob now exists and got both payments released
 	bobAcct = accKeeper.GetAccount(ctx, bob)
@@ -873,7 +995,7 @@ func TestExecute(t *testing.T) {
 	assert.Equal(t, sdk.Coins{}, bankKeeper.GetAllBalances(ctx, contractAcct.GetAddress()))
 
 	// and events emitted
-	require.Len(t, em.Events(), 9)
+	require.Len(t, em.Events(), 10)
 	expEvt := sdk.NewEvent("execute",
 		sdk.NewAttribute("_contract_address", addr.String()))
 	assert.Equal(t, expEvt, em.Events()[3], prettyEvents(t, em.Events()))
@@ -1409,6 +1531,14 @@ func TestMigrateWithDispatchedMessage(t *testing.T) {
 				{"amount": "100000denom"},
 			},
 		},
+		{
+			"Type": "submsg",
+			"Attr": []dict{
+				{"_contract_address": contractAddr},
+				{"submsg_id": "0"},
+				{"submsg_gas_used": "27602"},
+			},
+		},
 	}
 	expJSONEvts := string(mustMarshal(t, expEvents))
 	assert.JSONEq(t, expJSONEvts, prettyEvents(t, ctx.EventManager().Events()), prettyEvents(t, ctx.EventManager().Events()))
@@ -1560,7 +1690,7 @@ func TestSudo(t *testing.T) {
 	balance := bankKeeper.GetBalance(ctx, comAcct.GetAddress(), "denom")
 	assert.Equal(t, sdk.NewInt64Coin("denom", 76543), balance)
 	// and events emitted
-	require.Len(t, em.Events(), 4, prettyEvents(t, em.Events()))
+	require.Len(t, em.Events(), 5, prettyEvents(t, em.Events()))
 	expEvt := sdk.NewEvent("sudo",
 		sdk.NewAttribute("_contract_address", addr.String()))
 	assert.Equal(t, expEvt, em.Events()[0])
@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// SimulateMigrate runs the migration of a contract to the new code in a cached context. No state is persisted. The
+// contract admin is required when a sender is given, otherwise the migration is run with the governance permissions.
+// The gas is consumed from the context gas meter. The migration is not executed when the new code requires an IBC port
+// that the contract does not have yet, as binding a port can not be rolled back.
+func (k Keeper) SimulateMigrate(ctx sdk.Context, contractAddr, sender sdk.AccAddress, newCodeID uint64, msg []byte) (*types.QuerySimulateMigrateResponse, error) {
+	codeInfo := k.GetCodeInfo(ctx, newCodeID)
+	if codeInfo == nil {
+		return nil, types.ErrNoSuchCodeFn(newCodeID).Wrapf("code id %d", newCodeID)
+	}
+	contractInfo := k.GetContractInfo(ctx, contractAddr)
+	if contractInfo == nil {
+		return nil, types.ErrNoSuchContractFn(contractAddr.String()).Wrapf("address %s", contractAddr.String())
+	}
+	analysis, err := k.codeAnalysis(*codeInfo)
+	if err != nil {
+		return nil, err
+	}
+	if analysis.HasIBCEntryPoints && contractInfo.IBCPortID == "" {
+		return &types.QuerySimulateMigrateResponse{
+			Events:              make([]types.SimulatedEvent, 0),
+			StateChanges:        make([]types.ContractStateChange, 0),
+			MissingCapabilities: k.missingCapabilities(analysis.RequiredCapabilities),
+			RequiresIBCPort:     true,
+		}, nil
+	}
+	var policy AuthorizationPolicy = DefaultAuthorizationPolicy{}
+	if sender == nil {
+		if sender, err = sdk.AccAddressFromBech32(k.GetAuthority()); err != nil {
+			return nil, err
+		}
+		policy = GovAuthorizationPolicy{}
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	gasBefore := ctx.GasMeter().GasConsumed()
+	data, err := k.migrate(cacheCtx, contractAddr, sender, newCodeID, msg, policy)
+	if err != nil {
+		return nil, err
+	}
+	rsp := types.QuerySimulateMigrateResponse{
+		Data:                data,
+		Events:              make([]types.SimulatedEvent, 0),
+		GasUsed:             ctx.GasMeter().GasConsumed() - gasBefore,
+		StateChanges:        k.contractStateChanges(ctx, cacheCtx, contractAddr),
+		MissingCapabilities: k.missingCapabilities(analysis.RequiredCapabilities),
+	}
+	for _, e := range cacheCtx.EventManager().Events() {
+		evt := types.SimulatedEvent{Type: e.Type, Attributes: make([]types.SimulatedEventAttribute, len(e.Attributes))}
+		for i, a := range e.Attributes {
+			evt.Attributes[i] = types.SimulatedEventAttribute{Key: a.Key, Value: a.Value}
+		}
+		rsp.Events = append(rsp.Events, evt)
+	}
+	return &rsp, nil
+}
+
+// contractStateChanges compares the contract storage before and after by iterating both in key order
+func (k Keeper) contractStateChanges(before, after sdk.Context, contractAddr sdk.AccAddress) []types.ContractStateChange {
+	storePrefix := types.GetContractStorePrefix(contractAddr)
+	beforeIter := prefix.NewStore(before.KVStore(k.storeKey), storePrefix).Iterator(nil, nil)
+	defer beforeIter.Close()
+	afterIter := prefix.NewStore(after.KVStore(k.storeKey), storePrefix).Iterator(nil, nil)
+	defer afterIter.Close()
+
+	changes := make([]types.ContractStateChange, 0)
+	for beforeIter.Valid() || afterIter.Valid() {
+		cmp := 1 // only after left
+		switch {
+		case !afterIter.Valid():
+			cmp = -1
+		case beforeIter.Valid():
+			cmp = bytes.Compare(beforeIter.Key(), afterIter.Key())
+		}
+		switch {
+		case cmp < 0: // deleted
+			changes = append(changes, types.ContractStateChange{Key: append([]byte{}, beforeIter.Key()...), Deleted: true})
+			beforeIter.Next()
+		case cmp > 0: // added
+			changes = append(changes, types.ContractStateChange{Key: append([]byte{}, afterIter.Key()...), Value: append([]byte{}, afterIter.Value()...)})
+			afterIter.Next()
+		default:
+			if !bytes.Equal(beforeIter.Value(), afterIter.Value()) {
+				changes = append(changes, types.ContractStateChange{Key: append([]byte{}, afterIter.Key()...), Value: append([]byte{}, afterIter.Value()...)})
+			}
+			beforeIter.Next()
+			afterIter.Next()
+		}
+	}
+	return changes
+}
+
+// missingCapabilities returns the required capabilities that are not supported by the chain
+func (k Keeper) missingCapabilities(required []string) []string {
+	available := make(map[string]struct{}, len(k.availableCapabilities))
+	for _, c := range k.availableCapabilities {
+		available[c] = struct{}{}
+	}
+	var missing []string
+	for _, c := range required {
+		if _, ok := available[c]; !ok {
+			missing = append(missing, c)
+		}
+	}
+	return missing
+}
+
+// parseCapabilities splits the comma separated list of capabilities
+func parseCapabilities(s string) []string {
+	var capabilities []string
+	for _, c := range strings.Split(s, ",") {
+		if c = strings.TrimSpace(c); c != "" {
+			capabilities = append(capabilities, c)
+		}
+	}
+	return capabilities
+}
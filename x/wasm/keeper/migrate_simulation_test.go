@@ -0,0 +1,149 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper/testdata"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestSimulateMigrate(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	newCodeID := StoreHackatomExampleContract(t, ctx, keepers).CodeID
+	newVerifier := RandomAccountAddress(t)
+	migMsg := []byte(fmt.Sprintf(`{"verifier":%q}`, newVerifier.String()))
+
+	specs := map[string]struct {
+		sender    string
+		codeID    uint64
+		msg       []byte
+		expErr    error
+		expEvents []string
+	}{
+		"gov authority": {
+			codeID:    newCodeID,
+			msg:       migMsg,
+			expEvents: []string{types.EventTypeMigrate},
+		},
+		"contract admin": {
+			sender:    example.CreatorAddr.String(),
+			codeID:    newCodeID,
+			msg:       migMsg,
+			expEvents: []string{types.EventTypeMigrate},
+		},
+		"not admin": {
+			sender: RandomBech32AccountAddress(t),
+			codeID: newCodeID,
+			msg:    migMsg,
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"unknown code": {
+			codeID: 99999,
+			msg:    migMsg,
+			expErr: types.ErrNoSuchCodeFn(99999),
+		},
+		"failing migration": {
+			codeID: newCodeID,
+			msg:    []byte(`{}`),
+			expErr: types.ErrMigrationFailed,
+		},
+	}
+	querier := NewGrpcQuerier(k.cdc, k.storeKey, k, k.queryGasLimit)
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			// when
+			rsp, gotErr := querier.SimulateMigrate(sdk.WrapSDKContext(ctx), &types.QuerySimulateMigrateRequest{
+				Address: example.Contract.String(),
+				CodeId:  spec.codeID,
+				Msg:     spec.msg,
+				Sender:  spec.sender,
+			})
+			// then
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.NotZero(t, rsp.GasUsed)
+			assert.False(t, rsp.RequiresIBCPort)
+			assert.Empty(t, rsp.MissingCapabilities)
+			var gotEvents []string
+			for _, e := range rsp.Events {
+				gotEvents = append(gotEvents, e.Type)
+			}
+			assert.Equal(t, spec.expEvents, gotEvents)
+
+			// and the changed config is reported
+			require.Len(t, rsp.StateChanges, 1)
+			change := rsp.StateChanges[0]
+			assert.Equal(t, []byte("config"), change.Key.Bytes())
+			assert.False(t, change.Deleted)
+			var config map[string]string
+			require.NoError(t, json.Unmarshal(change.Value, &config))
+			assert.Equal(t, newVerifier.String(), config["verifier"])
+
+			// and nothing was persisted
+			assert.Equal(t, example.CodeID, k.GetContractInfo(ctx, example.Contract).CodeID)
+			assert.Len(t, k.GetContractHistory(ctx, example.Contract), 1)
+		})
+	}
+}
+
+func TestSimulateMigrateRequiresIBCPort(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	ibcCodeID, _, err := keepers.ContractKeeper.Create(ctx, example.CreatorAddr, testdata.IBCReflectContractWasm(), nil)
+	require.NoError(t, err)
+	querier := NewGrpcQuerier(k.cdc, k.storeKey, k, k.queryGasLimit)
+
+	// when
+	rsp, err := querier.SimulateMigrate(sdk.WrapSDKContext(ctx), &types.QuerySimulateMigrateRequest{
+		Address: example.Contract.String(),
+		CodeId:  ibcCodeID,
+		Msg:     []byte(`{}`),
+	})
+	// then
+	require.NoError(t, err)
+	assert.True(t, rsp.RequiresIBCPort)
+	assert.Empty(t, rsp.Events)
+	assert.Empty(t, rsp.StateChanges)
+	// and no port was bound
+	gotInfo := k.GetContractInfo(ctx, example.Contract)
+	assert.Empty(t, gotInfo.IBCPortID)
+	portID := PortIDForContract(example.Contract)
+	_, bound := k.capabilityKeeper.GetCapability(ctx, host.PortPath(portID))
+	assert.False(t, bound)
+}
+
+func TestMissingCapabilities(t *testing.T) {
+	k := Keeper{availableCapabilities: parseCapabilities("iterator, staking,")}
+	specs := map[string]struct {
+		required []string
+		exp      []string
+	}{
+		"none required": {},
+		"all supported": {
+			required: []string{"staking", "iterator"},
+		},
+		"not supported": {
+			required: []string{"iterator", "stargate", "cosmwasm_1_3"},
+			exp:      []string{"stargate", "cosmwasm_1_3"},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, spec.exp, k.missingCapabilities(spec.required))
+		})
+	}
+}
@@ -5,6 +5,8 @@ import (
 
 	v1 "github.com/CosmWasm/wasmd/x/wasm/migrations/v1"
 	v2 "github.com/CosmWasm/wasmd/x/wasm/migrations/v2"
+	v3 "github.com/CosmWasm/wasmd/x/wasm/migrations/v3"
+	v4 "github.com/CosmWasm/wasmd/x/wasm/migrations/v4"
 
 	"github.com/CosmWasm/wasmd/x/wasm/exported"
 )
@@ -30,3 +32,15 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 func (m Migrator) Migrate2to3(ctx sdk.Context) error {
 	return v2.MigrateStore(ctx, m.keeper.storeKey, m.legacySubspace, m.keeper.cdc)
 }
+
+// Migrate3to4 migrates the x/wasm module state from the consensus
+// version 3 to version 4.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v3.NewMigrator(m.keeper, m.keeper.codeAnalysis, m.keeper.storeCodeInfo).Migrate3to4(ctx)
+}
+
+// Migrate4to5 migrates the x/wasm module state from the consensus
+// version 4 to version 5.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	return v4.NewMigrator(m.keeper, m.keeper.addToContractAdminSecondaryIndex, m.keeper.addToContractLabelSecondaryIndex).Migrate4to5(ctx)
+}
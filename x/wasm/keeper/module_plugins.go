@@ -0,0 +1,393 @@
+package keeper
+
+import (
+	"encoding/json"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+type (
+	AuthzEncoder    func(sender sdk.AccAddress, msg *types.AuthzMsg) ([]sdk.Msg, error)
+	FeegrantEncoder func(sender sdk.AccAddress, msg *types.FeegrantMsg) ([]sdk.Msg, error)
+	NFTEncoder      func(sender sdk.AccAddress, msg *types.NFTMsg) ([]sdk.Msg, error)
+	GroupEncoder    func(sender sdk.AccAddress, msg *types.GroupMsg) ([]sdk.Msg, error)
+)
+
+// encodeCustom routes the opt-in module messages to their encoders. Any other custom message
+// or a module message without an encoder set is passed to the custom encoder.
+func (e MessageEncoders) encodeCustom(sender sdk.AccAddress, msg json.RawMessage) ([]sdk.Msg, error) {
+	var m types.ModuleMsg
+	if err := json.Unmarshal(msg, &m); err == nil {
+		switch {
+		case m.Authz != nil && e.Authz != nil:
+			return e.Authz(sender, m.Authz)
+		case m.Feegrant != nil && e.Feegrant != nil:
+			return e.Feegrant(sender, m.Feegrant)
+		case m.NFT != nil && e.NFT != nil:
+			return e.NFT(sender, m.NFT)
+		case m.Group != nil && e.Group != nil:
+			return e.Group(sender, m.Group)
+		}
+	}
+	return e.Custom(sender, msg)
+}
+
+// EncodeAuthzMsg encodes authz messages with the contract as grantee for exec and as granter for grant and revoke
+func EncodeAuthzMsg(unpacker codectypes.AnyUnpacker) AuthzEncoder {
+	return func(sender sdk.AccAddress, msg *types.AuthzMsg) ([]sdk.Msg, error) {
+		switch {
+		case msg.Exec != nil:
+			msgs, err := unpackAnyMsgs(unpacker, msg.Exec.Msgs)
+			if err != nil {
+				return nil, err
+			}
+			sdkMsg := authz.NewMsgExec(sender, msgs)
+			return []sdk.Msg{&sdkMsg}, nil
+		case msg.Grant != nil:
+			grantee, err := sdk.AccAddressFromBech32(msg.Grant.Grantee)
+			if err != nil {
+				return nil, errorsmod.Wrap(err, "grantee")
+			}
+			var a authz.Authorization
+			if err := unpacker.UnpackAny(&codectypes.Any{TypeUrl: msg.Grant.Authorization.TypeURL, Value: msg.Grant.Authorization.Value}, &a); err != nil {
+				return nil, errorsmod.Wrapf(types.ErrInvalidMsg, "cannot unpack authorization with type URL: %s", msg.Grant.Authorization.TypeURL)
+			}
+			sdkMsg, err := authz.NewMsgGrant(sender, grantee, a, unixTime(msg.Grant.Expiration))
+			if err != nil {
+				return nil, errorsmod.Wrap(types.ErrInvalidMsg, err.Error())
+			}
+			return []sdk.Msg{sdkMsg}, nil
+		case msg.Revoke != nil:
+			grantee, err := sdk.AccAddressFromBech32(msg.Revoke.Grantee)
+			if err != nil {
+				return nil, errorsmod.Wrap(err, "grantee")
+			}
+			sdkMsg := authz.NewMsgRevoke(sender, grantee, msg.Revoke.MsgTypeURL)
+			return []sdk.Msg{&sdkMsg}, nil
+		default:
+			return nil, errorsmod.Wrap(types.ErrUnknownMsg, "unknown variant of Authz")
+		}
+	}
+}
+
+// EncodeFeegrantMsg encodes feegrant messages with the contract as granter
+func EncodeFeegrantMsg(sender sdk.AccAddress, msg *types.FeegrantMsg) ([]sdk.Msg, error) {
+	switch {
+	case msg.GrantAllowance != nil:
+		grantee, err := sdk.AccAddressFromBech32(msg.GrantAllowance.Grantee)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "grantee")
+		}
+		spendLimit, err := ConvertWasmCoinsToSdkCoins(msg.GrantAllowance.SpendLimit)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "spend limit")
+		}
+		allowance := &feegrant.BasicAllowance{SpendLimit: spendLimit, Expiration: unixTime(msg.GrantAllowance.Expiration)}
+		sdkMsg, err := feegrant.NewMsgGrantAllowance(allowance, sender, grantee)
+		if err != nil {
+			return nil, errorsmod.Wrap(types.ErrInvalidMsg, err.Error())
+		}
+		return []sdk.Msg{sdkMsg}, nil
+	case msg.RevokeAllowance != nil:
+		grantee, err := sdk.AccAddressFromBech32(msg.RevokeAllowance.Grantee)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "grantee")
+		}
+		sdkMsg := feegrant.NewMsgRevokeAllowance(sender, grantee)
+		return []sdk.Msg{&sdkMsg}, nil
+	default:
+		return nil, errorsmod.Wrap(types.ErrUnknownMsg, "unknown variant of Feegrant")
+	}
+}
+
+// EncodeNFTMsg encodes nft messages with the contract as sender
+func EncodeNFTMsg(sender sdk.AccAddress, msg *types.NFTMsg) ([]sdk.Msg, error) {
+	switch {
+	case msg.Send != nil:
+		sdkMsg := nft.MsgSend{
+			ClassId:  msg.Send.ClassID,
+			Id:       msg.Send.ID,
+			Sender:   sender.String(),
+			Receiver: msg.Send.Receiver,
+		}
+		return []sdk.Msg{&sdkMsg}, nil
+	default:
+		return nil, errorsmod.Wrap(types.ErrUnknownMsg, "unknown variant of NFT")
+	}
+}
+
+// EncodeGroupMsg encodes group proposal messages with the contract as proposer, voter or executor
+func EncodeGroupMsg(unpacker codectypes.AnyUnpacker) GroupEncoder {
+	return func(sender sdk.AccAddress, msg *types.GroupMsg) ([]sdk.Msg, error) {
+		switch {
+		case msg.SubmitProposal != nil:
+			msgs, err := unpackAnyMsgs(unpacker, msg.SubmitProposal.Messages)
+			if err != nil {
+				return nil, err
+			}
+			sdkMsg := group.MsgSubmitProposal{
+				GroupPolicyAddress: msg.SubmitProposal.GroupPolicyAddress,
+				Proposers:          []string{sender.String()},
+				Metadata:           msg.SubmitProposal.Metadata,
+				Exec:               convertGroupExec(msg.SubmitProposal.TryExec),
+				Title:              msg.SubmitProposal.Title,
+				Summary:            msg.SubmitProposal.Summary,
+			}
+			if err := sdkMsg.SetMsgs(msgs); err != nil {
+				return nil, errorsmod.Wrap(types.ErrInvalidMsg, err.Error())
+			}
+			return []sdk.Msg{&sdkMsg}, nil
+		case msg.Vote != nil:
+			option, err := convertGroupVoteOption(msg.Vote.Option)
+			if err != nil {
+				return nil, errorsmod.Wrap(err, "vote option")
+			}
+			sdkMsg := group.MsgVote{
+				ProposalId: msg.Vote.ProposalID,
+				Voter:      sender.String(),
+				Option:     option,
+				Metadata:   msg.Vote.Metadata,
+				Exec:       convertGroupExec(msg.Vote.TryExec),
+			}
+			return []sdk.Msg{&sdkMsg}, nil
+		case msg.Exec != nil:
+			sdkMsg := group.MsgExec{
+				ProposalId: msg.Exec.ProposalID,
+				Executor:   sender.String(),
+			}
+			return []sdk.Msg{&sdkMsg}, nil
+		case msg.WithdrawProposal != nil:
+			sdkMsg := group.MsgWithdrawProposal{
+				ProposalId: msg.WithdrawProposal.ProposalID,
+				Address:    sender.String(),
+			}
+			return []sdk.Msg{&sdkMsg}, nil
+		default:
+			return nil, errorsmod.Wrap(types.ErrUnknownMsg, "unknown variant of Group")
+		}
+	}
+}
+
+func convertGroupExec(tryExec bool) group.Exec {
+	if tryExec {
+		return group.Exec_EXEC_TRY
+	}
+	return group.Exec_EXEC_UNSPECIFIED
+}
+
+func convertGroupVoteOption(s string) (group.VoteOption, error) {
+	switch s {
+	case "yes":
+		return group.VOTE_OPTION_YES, nil
+	case "no":
+		return group.VOTE_OPTION_NO, nil
+	case "abstain":
+		return group.VOTE_OPTION_ABSTAIN, nil
+	case "no_with_veto":
+		return group.VOTE_OPTION_NO_WITH_VETO, nil
+	default:
+		return group.VOTE_OPTION_UNSPECIFIED, types.ErrInvalid
+	}
+}
+
+func unpackAnyMsgs(unpacker codectypes.AnyUnpacker, anyMsgs []types.AnyMsg) ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(anyMsgs))
+	for i, m := range anyMsgs {
+		codecAny := codectypes.Any{TypeUrl: m.TypeURL, Value: m.Value}
+		if err := unpacker.UnpackAny(&codecAny, &msgs[i]); err != nil {
+			return nil, errorsmod.Wrapf(types.ErrInvalidMsg, "cannot unpack proto message with type URL: %s", m.TypeURL)
+		}
+		if err := codectypes.UnpackInterfaces(msgs[i], unpacker); err != nil {
+			return nil, errorsmod.Wrapf(types.ErrInvalidMsg, "UnpackInterfaces inside msg: %s", err)
+		}
+	}
+	return msgs, nil
+}
+
+// unixTime converts the optional unix timestamp in seconds
+func unixTime(seconds *uint64) *time.Time {
+	if seconds == nil {
+		return nil
+	}
+	t := time.Unix(int64(*seconds), 0).UTC()
+	return &t
+}
+
+// handleCustom routes the opt-in module queries to their query plugins. Any other custom query
+// or a module query without a plugin set is passed to the custom querier.
+func (e QueryPlugins) handleCustom(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+	var q types.ModuleQuery
+	if err := json.Unmarshal(request, &q); err == nil {
+		switch {
+		case q.Authz != nil && e.Authz != nil:
+			return e.Authz(ctx, q.Authz)
+		case q.Feegrant != nil && e.Feegrant != nil:
+			return e.Feegrant(ctx, q.Feegrant)
+		case q.NFT != nil && e.NFT != nil:
+			return e.NFT(ctx, q.NFT)
+		case q.Group != nil && e.Group != nil:
+			return e.Group(ctx, q.Group)
+		}
+	}
+	return e.Custom(ctx, request)
+}
+
+// AuthzQuerier answers authz grant queries
+func AuthzQuerier(k types.AuthzKeeper) func(ctx sdk.Context, request *types.AuthzQuery) ([]byte, error) {
+	return func(ctx sdk.Context, request *types.AuthzQuery) ([]byte, error) {
+		if request.Grant == nil {
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown authz query variant"}
+		}
+		granter, err := sdk.AccAddressFromBech32(request.Grant.Granter)
+		if err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, request.Grant.Granter)
+		}
+		grantee, err := sdk.AccAddressFromBech32(request.Grant.Grantee)
+		if err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, request.Grant.Grantee)
+		}
+		var res types.AuthzGrantResponse
+		a, expiration := k.GetAuthorization(ctx, grantee, granter, request.Grant.MsgTypeURL)
+		if a != nil {
+			anyMsg, err := newAnyMsg(a)
+			if err != nil {
+				return nil, err
+			}
+			res.Authorization = anyMsg
+			if expiration != nil {
+				seconds := uint64(expiration.Unix())
+				res.Expiration = &seconds
+			}
+		}
+		return json.Marshal(res)
+	}
+}
+
+// FeegrantQuerier answers feegrant allowance queries
+func FeegrantQuerier(k types.FeegrantKeeper) func(ctx sdk.Context, request *types.FeegrantQuery) ([]byte, error) {
+	return func(ctx sdk.Context, request *types.FeegrantQuery) ([]byte, error) {
+		if request.Allowance == nil {
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown feegrant query variant"}
+		}
+		granter, err := sdk.AccAddressFromBech32(request.Allowance.Granter)
+		if err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, request.Allowance.Granter)
+		}
+		grantee, err := sdk.AccAddressFromBech32(request.Allowance.Grantee)
+		if err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, request.Allowance.Grantee)
+		}
+		var res types.FeegrantAllowanceResponse
+		allowance, err := k.GetAllowance(ctx, granter, grantee)
+		switch {
+		case sdkerrors.ErrNotFound.Is(err):
+		case err != nil:
+			return nil, err
+		default:
+			msg, ok := allowance.(proto.Message)
+			if !ok {
+				return nil, errorsmod.Wrapf(types.ErrInvalid, "allowance type: %T", allowance)
+			}
+			if res.Allowance, err = newAnyMsg(msg); err != nil {
+				return nil, err
+			}
+		}
+		return json.Marshal(res)
+	}
+}
+
+// NFTQuerier answers nft owner, balance and nft queries
+func NFTQuerier(k types.NFTKeeper) func(ctx sdk.Context, request *types.NFTQuery) ([]byte, error) {
+	return func(ctx sdk.Context, request *types.NFTQuery) ([]byte, error) {
+		switch {
+		case request.Owner != nil:
+			res := types.NFTOwnerResponse{Owner: k.GetOwner(ctx, request.Owner.ClassID, request.Owner.ID).String()}
+			return json.Marshal(res)
+		case request.Balance != nil:
+			owner, err := sdk.AccAddressFromBech32(request.Balance.Owner)
+			if err != nil {
+				return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, request.Balance.Owner)
+			}
+			res := types.NFTBalanceResponse{Amount: k.GetBalance(ctx, request.Balance.ClassID, owner)}
+			return json.Marshal(res)
+		case request.NFT != nil:
+			var res types.NFTNFTResponse
+			if n, found := k.GetNFT(ctx, request.NFT.ClassID, request.NFT.ID); found {
+				res.NFT = &types.NFT{ClassID: n.ClassId, ID: n.Id, URI: n.Uri, URIHash: n.UriHash}
+			}
+			return json.Marshal(res)
+		default:
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown nft query variant"}
+		}
+	}
+}
+
+// GroupQuerier answers group proposal and vote queries
+func GroupQuerier(k types.GroupKeeper) func(ctx sdk.Context, request *types.GroupQuery) ([]byte, error) {
+	return func(ctx sdk.Context, request *types.GroupQuery) ([]byte, error) {
+		switch {
+		case request.Proposal != nil:
+			rsp, err := k.Proposal(sdk.WrapSDKContext(ctx), &group.QueryProposalRequest{ProposalId: request.Proposal.ProposalID})
+			if err != nil {
+				return nil, err
+			}
+			p := rsp.Proposal
+			res := types.GroupProposalResponse{Proposal: types.GroupProposal{
+				ID:                 p.Id,
+				GroupPolicyAddress: p.GroupPolicyAddress,
+				Metadata:           p.Metadata,
+				Proposers:          p.Proposers,
+				Status:             p.Status.String(),
+				ExecutorResult:     p.ExecutorResult.String(),
+				FinalTallyResult: types.GroupTallyResult{
+					Yes:        p.FinalTallyResult.YesCount,
+					Abstain:    p.FinalTallyResult.AbstainCount,
+					No:         p.FinalTallyResult.NoCount,
+					NoWithVeto: p.FinalTallyResult.NoWithVetoCount,
+				},
+				VotingPeriodEnd: uint64(p.VotingPeriodEnd.Unix()),
+				Title:           p.Title,
+				Summary:         p.Summary,
+			}}
+			return json.Marshal(res)
+		case request.Vote != nil:
+			rsp, err := k.VoteByProposalVoter(sdk.WrapSDKContext(ctx), &group.QueryVoteByProposalVoterRequest{
+				ProposalId: request.Vote.ProposalID,
+				Voter:      request.Vote.Voter,
+			})
+			if err != nil {
+				return nil, err
+			}
+			v := rsp.Vote
+			res := types.GroupVoteResponse{Vote: types.GroupVote{
+				ProposalID: v.ProposalId,
+				Voter:      v.Voter,
+				Option:     v.Option.String(),
+				Metadata:   v.Metadata,
+			}}
+			return json.Marshal(res)
+		default:
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown group query variant"}
+		}
+	}
+}
+
+func newAnyMsg(v proto.Message) (*types.AnyMsg, error) {
+	codecAny, err := codectypes.NewAnyWithValue(v)
+	if err != nil {
+		return nil, err
+	}
+	return &types.AnyMsg{TypeURL: codecAny.TypeUrl, Value: codecAny.Value}, nil
+}
@@ -0,0 +1,460 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestEncodeModuleMsgs(t *testing.T) {
+	var (
+		contractAddr = RandomAccountAddress(t)
+		otherAddr    = RandomAccountAddress(t)
+		myTime       = time.Unix(1700000000, 0).UTC()
+		mySeconds    = uint64(1700000000)
+	)
+	encodingConfig := MakeEncodingConfig(t)
+	authz.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+	encoders := DefaultEncoders(encodingConfig.Marshaler, nil).Merge(&MessageEncoders{
+		Authz:    EncodeAuthzMsg(encodingConfig.Marshaler),
+		Feegrant: EncodeFeegrantMsg,
+		NFT:      EncodeNFTMsg,
+		Group:    EncodeGroupMsg(encodingConfig.Marshaler),
+		Custom: func(sender sdk.AccAddress, msg json.RawMessage) ([]sdk.Msg, error) {
+			return []sdk.Msg{&banktypes.MsgSend{FromAddress: sender.String()}}, nil
+		},
+	})
+
+	bankMsg := &banktypes.MsgSend{FromAddress: otherAddr.String(), ToAddress: contractAddr.String(), Amount: sdk.NewCoins(sdk.NewInt64Coin("denom", 1))}
+	bankMsgBin, err := proto.Marshal(bankMsg)
+	require.NoError(t, err)
+	sendAuthorization := banktypes.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("denom", 1)), nil)
+	sendAuthorizationBin, err := proto.Marshal(sendAuthorization)
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		src    types.ModuleMsg
+		exp    func(t *testing.T) []sdk.Msg
+		expErr bool
+	}{
+		"authz exec": {
+			src: types.ModuleMsg{Authz: &types.AuthzMsg{Exec: &types.AuthzExecMsg{
+				Msgs: []types.AnyMsg{{TypeURL: sdk.MsgTypeURL(bankMsg), Value: bankMsgBin}},
+			}}},
+			exp: func(t *testing.T) []sdk.Msg {
+				msg := authz.NewMsgExec(contractAddr, []sdk.Msg{bankMsg})
+				return []sdk.Msg{&msg}
+			},
+		},
+		"authz exec with unknown type url": {
+			src: types.ModuleMsg{Authz: &types.AuthzMsg{Exec: &types.AuthzExecMsg{
+				Msgs: []types.AnyMsg{{TypeURL: "/unknown", Value: bankMsgBin}},
+			}}},
+			expErr: true,
+		},
+		"authz grant": {
+			src: types.ModuleMsg{Authz: &types.AuthzMsg{Grant: &types.AuthzGrantMsg{
+				Grantee:       otherAddr.String(),
+				Authorization: types.AnyMsg{TypeURL: "/cosmos.bank.v1beta1.SendAuthorization", Value: sendAuthorizationBin},
+				Expiration:    &mySeconds,
+			}}},
+			exp: func(t *testing.T) []sdk.Msg {
+				msg, err := authz.NewMsgGrant(contractAddr, otherAddr, sendAuthorization, &myTime)
+				require.NoError(t, err)
+				return []sdk.Msg{msg}
+			},
+		},
+		"authz grant with invalid grantee": {
+			src: types.ModuleMsg{Authz: &types.AuthzMsg{Grant: &types.AuthzGrantMsg{
+				Grantee:       "invalid",
+				Authorization: types.AnyMsg{TypeURL: "/cosmos.bank.v1beta1.SendAuthorization", Value: sendAuthorizationBin},
+			}}},
+			expErr: true,
+		},
+		"authz revoke": {
+			src: types.ModuleMsg{Authz: &types.AuthzMsg{Revoke: &types.AuthzRevokeMsg{
+				Grantee:    otherAddr.String(),
+				MsgTypeURL: sdk.MsgTypeURL(bankMsg),
+			}}},
+			exp: func(t *testing.T) []sdk.Msg {
+				msg := authz.NewMsgRevoke(contractAddr, otherAddr, sdk.MsgTypeURL(bankMsg))
+				return []sdk.Msg{&msg}
+			},
+		},
+		"authz unknown variant": {
+			src:    types.ModuleMsg{Authz: &types.AuthzMsg{}},
+			expErr: true,
+		},
+		"feegrant grant allowance": {
+			src: types.ModuleMsg{Feegrant: &types.FeegrantMsg{GrantAllowance: &types.FeegrantGrantAllowanceMsg{
+				Grantee:    otherAddr.String(),
+				SpendLimit: []wasmvmtypes.Coin{wasmvmtypes.NewCoin(1, "denom")},
+				Expiration: &mySeconds,
+			}}},
+			exp: func(t *testing.T) []sdk.Msg {
+				msg, err := feegrant.NewMsgGrantAllowance(&feegrant.BasicAllowance{
+					SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("denom", 1)),
+					Expiration: &myTime,
+				}, contractAddr, otherAddr)
+				require.NoError(t, err)
+				return []sdk.Msg{msg}
+			},
+		},
+		"feegrant revoke allowance": {
+			src: types.ModuleMsg{Feegrant: &types.FeegrantMsg{RevokeAllowance: &types.FeegrantRevokeAllowanceMsg{
+				Grantee: otherAddr.String(),
+			}}},
+			exp: func(t *testing.T) []sdk.Msg {
+				msg := feegrant.NewMsgRevokeAllowance(contractAddr, otherAddr)
+				return []sdk.Msg{&msg}
+			},
+		},
+		"nft send": {
+			src: types.ModuleMsg{NFT: &types.NFTMsg{Send: &types.NFTSendMsg{
+				ClassID:  "myClass",
+				ID:       "myID",
+				Receiver: otherAddr.String(),
+			}}},
+			exp: func(t *testing.T) []sdk.Msg {
+				return []sdk.Msg{&nft.MsgSend{ClassId: "myClass", Id: "myID", Sender: contractAddr.String(), Receiver: otherAddr.String()}}
+			},
+		},
+		"group submit proposal": {
+			src: types.ModuleMsg{Group: &types.GroupMsg{SubmitProposal: &types.GroupSubmitProposalMsg{
+				GroupPolicyAddress: otherAddr.String(),
+				Messages:           []types.AnyMsg{{TypeURL: sdk.MsgTypeURL(bankMsg), Value: bankMsgBin}},
+				Title:              "myTitle",
+				TryExec:            true,
+			}}},
+			exp: func(t *testing.T) []sdk.Msg {
+				msg := group.MsgSubmitProposal{
+					GroupPolicyAddress: otherAddr.String(),
+					Proposers:          []string{contractAddr.String()},
+					Exec:               group.Exec_EXEC_TRY,
+					Title:              "myTitle",
+				}
+				require.NoError(t, msg.SetMsgs([]sdk.Msg{bankMsg}))
+				return []sdk.Msg{&msg}
+			},
+		},
+		"group vote": {
+			src: types.ModuleMsg{Group: &types.GroupMsg{Vote: &types.GroupVoteMsg{ProposalID: 1, Option: "no_with_veto"}}},
+			exp: func(t *testing.T) []sdk.Msg {
+				return []sdk.Msg{&group.MsgVote{ProposalId: 1, Voter: contractAddr.String(), Option: group.VOTE_OPTION_NO_WITH_VETO}}
+			},
+		},
+		"group vote with invalid option": {
+			src:    types.ModuleMsg{Group: &types.GroupMsg{Vote: &types.GroupVoteMsg{ProposalID: 1, Option: "maybe"}}},
+			expErr: true,
+		},
+		"group exec": {
+			src: types.ModuleMsg{Group: &types.GroupMsg{Exec: &types.GroupExecMsg{ProposalID: 1}}},
+			exp: func(t *testing.T) []sdk.Msg {
+				return []sdk.Msg{&group.MsgExec{ProposalId: 1, Executor: contractAddr.String()}}
+			},
+		},
+		"group withdraw proposal": {
+			src: types.ModuleMsg{Group: &types.GroupMsg{WithdrawProposal: &types.GroupWithdrawProposalMsg{ProposalID: 1}}},
+			exp: func(t *testing.T) []sdk.Msg {
+				return []sdk.Msg{&group.MsgWithdrawProposal{ProposalId: 1, Address: contractAddr.String()}}
+			},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			bz, err := json.Marshal(spec.src)
+			require.NoError(t, err)
+			gotMsgs, gotErr := encoders.Encode(sdk.Context{}, contractAddr, "", wasmvmtypes.CosmosMsg{Custom: bz})
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp(t), gotMsgs)
+		})
+	}
+}
+
+func TestEncodeModuleMsgsFallbackToCustom(t *testing.T) {
+	contractAddr := RandomAccountAddress(t)
+	var customCalled bool
+	encoders := DefaultEncoders(nil, nil).Merge(&MessageEncoders{
+		NFT: EncodeNFTMsg,
+		Custom: func(sender sdk.AccAddress, msg json.RawMessage) ([]sdk.Msg, error) {
+			customCalled = true
+			return nil, nil
+		},
+	})
+	specs := map[string]struct {
+		src       string
+		expCustom bool
+	}{
+		"module msg with encoder": {
+			src: `{"wasmd_nft":{"send":{"class_id":"a","id":"b","receiver":"c"}}}`,
+		},
+		"module msg without encoder": {
+			src:       `{"wasmd_authz":{"revoke":{"grantee":"a","msg_type_url":"b"}}}`,
+			expCustom: true,
+		},
+		"chain custom msg with module name as key": {
+			src:       `{"nft":{"send":{"class_id":"a","id":"b","receiver":"c"}}}`,
+			expCustom: true,
+		},
+		"other custom msg": {
+			src:       `{"foo":{}}`,
+			expCustom: true,
+		},
+		"non object custom msg": {
+			src:       `"foo"`,
+			expCustom: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			customCalled = false
+			_, err := encoders.Encode(sdk.Context{}, contractAddr, "", wasmvmtypes.CosmosMsg{Custom: []byte(spec.src)})
+			require.NoError(t, err)
+			assert.Equal(t, spec.expCustom, customCalled)
+		})
+	}
+}
+
+func TestModuleQueriers(t *testing.T) {
+	var (
+		granter    = RandomAccountAddress(t)
+		grantee    = RandomAccountAddress(t)
+		expiration = time.Unix(1700000000, 0).UTC()
+	)
+	authorization := authz.NewGenericAuthorization("/cosmos.bank.v1beta1.MsgSend")
+	authorizationBin, err := proto.Marshal(authorization)
+	require.NoError(t, err)
+	allowance := &feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("denom", 1))}
+	allowanceBin, err := proto.Marshal(allowance)
+	require.NoError(t, err)
+
+	queriers := DefaultQueryPlugins(nil, nil, nil, nil, nil).Merge(&QueryPlugins{
+		Authz: AuthzQuerier(authzKeeperMock{grants: []authzKeeperMockGrant{
+			{granter: granter, grantee: grantee, authorization: authorization, expiration: &expiration},
+		}}),
+		Feegrant: FeegrantQuerier(feegrantKeeperMock{granter: granter, grantee: grantee, allowance: allowance}),
+		NFT:      NFTQuerier(nftKeeperMock{owner: grantee, nft: nft.NFT{ClassId: "myClass", Id: "myID", Uri: "myURI"}}),
+		Group: GroupQuerier(groupKeeperMock{proposal: group.Proposal{
+			Id:                 1,
+			Proposers:          []string{grantee.String()},
+			Status:             group.PROPOSAL_STATUS_ACCEPTED,
+			ExecutorResult:     group.PROPOSAL_EXECUTOR_RESULT_NOT_RUN,
+			FinalTallyResult:   group.TallyResult{YesCount: "1", AbstainCount: "0", NoCount: "0", NoWithVetoCount: "0"},
+			VotingPeriodEnd:    expiration,
+			GroupPolicyAddress: granter.String(),
+		}}),
+	})
+
+	specs := map[string]struct {
+		src    types.ModuleQuery
+		exp    any
+		expErr error
+	}{
+		"authz grant": {
+			src: types.ModuleQuery{Authz: &types.AuthzQuery{Grant: &types.AuthzGrantQuery{
+				Granter: granter.String(), Grantee: grantee.String(), MsgTypeURL: "/cosmos.bank.v1beta1.MsgSend",
+			}}},
+			exp: types.AuthzGrantResponse{
+				Authorization: &types.AnyMsg{TypeURL: "/cosmos.authz.v1beta1.GenericAuthorization", Value: authorizationBin},
+				Expiration:    ptr(uint64(1700000000)),
+			},
+		},
+		"authz grant not found": {
+			src: types.ModuleQuery{Authz: &types.AuthzQuery{Grant: &types.AuthzGrantQuery{
+				Granter: grantee.String(), Grantee: granter.String(), MsgTypeURL: "/cosmos.bank.v1beta1.MsgSend",
+			}}},
+			exp: types.AuthzGrantResponse{},
+		},
+		"authz grant with invalid granter": {
+			src: types.ModuleQuery{Authz: &types.AuthzQuery{Grant: &types.AuthzGrantQuery{
+				Granter: "invalid", Grantee: granter.String(),
+			}}},
+			expErr: sdkerrors.ErrInvalidAddress,
+		},
+		"feegrant allowance": {
+			src: types.ModuleQuery{Feegrant: &types.FeegrantQuery{Allowance: &types.FeegrantAllowanceQuery{
+				Granter: granter.String(), Grantee: grantee.String(),
+			}}},
+			exp: types.FeegrantAllowanceResponse{
+				Allowance: &types.AnyMsg{TypeURL: "/cosmos.feegrant.v1beta1.BasicAllowance", Value: allowanceBin},
+			},
+		},
+		"feegrant allowance not found": {
+			src: types.ModuleQuery{Feegrant: &types.FeegrantQuery{Allowance: &types.FeegrantAllowanceQuery{
+				Granter: grantee.String(), Grantee: granter.String(),
+			}}},
+			exp: types.FeegrantAllowanceResponse{},
+		},
+		"nft owner": {
+			src: types.ModuleQuery{NFT: &types.NFTQuery{Owner: &types.NFTOwnerQuery{ClassID: "myClass", ID: "myID"}}},
+			exp: types.NFTOwnerResponse{Owner: grantee.String()},
+		},
+		"nft balance": {
+			src: types.ModuleQuery{NFT: &types.NFTQuery{Balance: &types.NFTBalanceQuery{ClassID: "myClass", Owner: grantee.String()}}},
+			exp: types.NFTBalanceResponse{Amount: 1},
+		},
+		"nft nft": {
+			src: types.ModuleQuery{NFT: &types.NFTQuery{NFT: &types.NFTNFTQuery{ClassID: "myClass", ID: "myID"}}},
+			exp: types.NFTNFTResponse{NFT: &types.NFT{ClassID: "myClass", ID: "myID", URI: "myURI"}},
+		},
+		"nft nft not found": {
+			src: types.ModuleQuery{NFT: &types.NFTQuery{NFT: &types.NFTNFTQuery{ClassID: "myClass", ID: "other"}}},
+			exp: types.NFTNFTResponse{},
+		},
+		"group proposal": {
+			src: types.ModuleQuery{Group: &types.GroupQuery{Proposal: &types.GroupProposalQuery{ProposalID: 1}}},
+			exp: types.GroupProposalResponse{Proposal: types.GroupProposal{
+				ID:                 1,
+				GroupPolicyAddress: granter.String(),
+				Proposers:          []string{grantee.String()},
+				Status:             "PROPOSAL_STATUS_ACCEPTED",
+				ExecutorResult:     "PROPOSAL_EXECUTOR_RESULT_NOT_RUN",
+				FinalTallyResult:   types.GroupTallyResult{Yes: "1", Abstain: "0", No: "0", NoWithVeto: "0"},
+				VotingPeriodEnd:    1700000000,
+			}},
+		},
+		"group proposal not found": {
+			src:    types.ModuleQuery{Group: &types.GroupQuery{Proposal: &types.GroupProposalQuery{ProposalID: 2}}},
+			expErr: sdkerrors.ErrNotFound,
+		},
+		"group vote": {
+			src: types.ModuleQuery{Group: &types.GroupQuery{Vote: &types.GroupVoteQuery{ProposalID: 1, Voter: grantee.String()}}},
+			exp: types.GroupVoteResponse{Vote: types.GroupVote{ProposalID: 1, Voter: grantee.String(), Option: "VOTE_OPTION_YES"}},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			bz, err := json.Marshal(spec.src)
+			require.NoError(t, err)
+			gotBz, gotErr := queriers.HandleQuery(sdk.Context{}, nil, wasmvmtypes.QueryRequest{Custom: bz})
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			expBz, err := json.Marshal(spec.exp)
+			require.NoError(t, err)
+			assert.JSONEq(t, string(expBz), string(gotBz))
+		})
+	}
+}
+
+func TestModuleQueriesFallbackToCustom(t *testing.T) {
+	var customCalled bool
+	queriers := DefaultQueryPlugins(nil, nil, nil, nil, nil).Merge(&QueryPlugins{
+		NFT: func(ctx sdk.Context, request *types.NFTQuery) ([]byte, error) {
+			return []byte(`{}`), nil
+		},
+		Custom: func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+			customCalled = true
+			return []byte(`{}`), nil
+		},
+	})
+	specs := map[string]struct {
+		src       string
+		expCustom bool
+	}{
+		"module query with plugin": {
+			src: `{"wasmd_nft":{"owner":{"class_id":"a","id":"b"}}}`,
+		},
+		"module query without plugin": {
+			src:       `{"wasmd_authz":{"grant":{"granter":"a","grantee":"b","msg_type_url":"c"}}}`,
+			expCustom: true,
+		},
+		"chain custom query with module name as key": {
+			src:       `{"nft":{"owner":{"class_id":"a","id":"b"}}}`,
+			expCustom: true,
+		},
+		"other custom query": {
+			src:       `{"foo":{}}`,
+			expCustom: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			customCalled = false
+			_, err := queriers.HandleQuery(sdk.Context{}, nil, wasmvmtypes.QueryRequest{Custom: []byte(spec.src)})
+			require.NoError(t, err)
+			assert.Equal(t, spec.expCustom, customCalled)
+		})
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+type feegrantKeeperMock struct {
+	granter, grantee sdk.AccAddress
+	allowance        feegrant.FeeAllowanceI
+}
+
+func (m feegrantKeeperMock) GetAllowance(_ sdk.Context, granter, grantee sdk.AccAddress) (feegrant.FeeAllowanceI, error) {
+	if !m.granter.Equals(granter) || !m.grantee.Equals(grantee) {
+		return nil, sdkerrors.ErrNotFound.Wrap("fee-grant not found")
+	}
+	return m.allowance, nil
+}
+
+type nftKeeperMock struct {
+	owner sdk.AccAddress
+	nft   nft.NFT
+}
+
+func (m nftKeeperMock) GetOwner(_ sdk.Context, classID, nftID string) sdk.AccAddress {
+	if classID != m.nft.ClassId || nftID != m.nft.Id {
+		return nil
+	}
+	return m.owner
+}
+
+func (m nftKeeperMock) GetBalance(_ sdk.Context, classID string, owner sdk.AccAddress) uint64 {
+	if classID != m.nft.ClassId || !owner.Equals(m.owner) {
+		return 0
+	}
+	return 1
+}
+
+func (m nftKeeperMock) GetNFT(_ sdk.Context, classID, nftID string) (nft.NFT, bool) {
+	if classID != m.nft.ClassId || nftID != m.nft.Id {
+		return nft.NFT{}, false
+	}
+	return m.nft, true
+}
+
+type groupKeeperMock struct {
+	proposal group.Proposal
+}
+
+func (m groupKeeperMock) Proposal(_ context.Context, req *group.QueryProposalRequest) (*group.QueryProposalResponse, error) {
+	if req.ProposalId != m.proposal.Id {
+		return nil, sdkerrors.ErrNotFound.Wrap("proposal")
+	}
+	return &group.QueryProposalResponse{Proposal: &m.proposal}, nil
+}
+
+func (m groupKeeperMock) VoteByProposalVoter(_ context.Context, req *group.QueryVoteByProposalVoterRequest) (*group.QueryVoteByProposalVoterResponse, error) {
+	if req.ProposalId != m.proposal.Id {
+		return nil, sdkerrors.ErrNotFound.Wrap("vote")
+	}
+	return &group.QueryVoteByProposalVoterResponse{Vote: &group.Vote{ProposalId: req.ProposalId, Voter: req.Voter, Option: group.VOTE_OPTION_YES}}, nil
+}
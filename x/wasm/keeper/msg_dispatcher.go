@@ -1,8 +1,9 @@
 package keeper
 
 import (
-	"fmt"
+	"errors"
 	"sort"
+	"strconv"
 	"strings"
 
 	errorsmod "cosmossdk.io/errors"
@@ -23,12 +24,16 @@ type Messenger interface {
 // replyer is a subset of keeper that can handle replies to submessages
 type replyer interface {
 	reply(ctx sdk.Context, contractAddress sdk.AccAddress, reply wasmvmtypes.Reply) ([]byte, error)
+	// requiresCapability returns true when the code of the contract requires the given capability
+	requiresCapability(ctx sdk.Context, contractAddress sdk.AccAddress, capability string) (bool, error)
 }
 
 // MessageDispatcher coordinates message sending and submessage reply/ state commits
 type MessageDispatcher struct {
 	messenger Messenger
 	keeper    replyer
+	// defaultGasLimit is applied to submessages without a gas limit. 0 means no limit.
+	defaultGasLimit uint64
 }
 
 // NewMessageDispatcher constructor
@@ -92,17 +97,23 @@ func (d MessageDispatcher) DispatchSubmessages(ctx sdk.Context, contractAddr sdk
 		subCtx = subCtx.WithEventManager(em)
 
 		// check how much gas left locally, optionally wrap the gas meter
-		gasRemaining := ctx.GasMeter().Limit() - ctx.GasMeter().GasConsumed()
-		limitGas := msg.GasLimit != nil && (*msg.GasLimit < gasRemaining)
+		gasLimit := msg.GasLimit
+		if gasLimit == nil && d.defaultGasLimit != 0 {
+			gasLimit = &d.defaultGasLimit
+		}
+		gasBefore := ctx.GasMeter().GasConsumed()
+		gasRemaining := ctx.GasMeter().Limit() - gasBefore
+		limitGas := gasLimit != nil && (*gasLimit < gasRemaining)
 
 		var err error
 		var events []sdk.Event
 		var data [][]byte
 		if limitGas {
-			events, data, err = d.dispatchMsgWithGasLimit(subCtx, contractAddr, ibcPort, msg.Msg, *msg.GasLimit)
+			events, data, err = d.dispatchMsgWithGasLimit(subCtx, contractAddr, ibcPort, msg.Msg, *gasLimit)
 		} else {
 			events, data, err = d.messenger.DispatchMsg(subCtx, contractAddr, ibcPort, msg.Msg)
 		}
+		gasUsed := ctx.GasMeter().GasConsumed() - gasBefore
 
 		// if it succeeds, commit state changes from submessage, and pass on events to Event Manager
 		var filteredEvents []sdk.Event
@@ -126,6 +137,13 @@ func (d MessageDispatcher) DispatchSubmessages(ctx sdk.Context, contractAddr sdk
 		if (msg.ReplyOn == wasmvmtypes.ReplySuccess || msg.ReplyOn == wasmvmtypes.ReplyNever) && err != nil {
 			return nil, err
 		}
+		// the wasmvm Reply type has no field to pass the gas used to the contract
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeSubMsg,
+			sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddr.String()),
+			sdk.NewAttribute(types.AttributeKeySubMsgID, strconv.FormatUint(msg.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeySubMsgGasUsed, strconv.FormatUint(gasUsed, 10)),
+		))
 		if msg.ReplyOn == wasmvmtypes.ReplyNever || (msg.ReplyOn == wasmvmtypes.ReplyError && err == nil) {
 			continue
 		}
@@ -148,8 +166,16 @@ func (d MessageDispatcher) DispatchSubmessages(ctx sdk.Context, contractAddr sdk
 		} else {
 			// Issue #759 - we don't return error string for worries of non-determinism
 			moduleLogger(ctx).Info("Redacting submessage error", "cause", err)
+			structured, capErr := d.keeper.requiresCapability(ctx, contractAddr, types.CapabilityStructuredSubMsgErrors)
+			if capErr != nil {
+				return nil, errorsmod.Wrap(capErr, "reply")
+			}
+			errMsg := redactError(err).Error()
+			if structured {
+				errMsg = types.NewSubMsgError(err).JSON()
+			}
 			result = wasmvmtypes.SubMsgResult{
-				Err: redactError(err).Error(),
+				Err: errMsg,
 			}
 		}
 
@@ -180,13 +206,11 @@ func redactError(err error) error {
 		return err
 	}
 
-	// FIXME: do we want to hardcode some constant string mappings here as well?
-	// Or better document them? (SDK error string may change on a patch release to fix wording)
+	// only the registered codespace and code are kept, for example:
 	// sdk/11 is out of gas
 	// sdk/5 is insufficient funds (on bank send)
-	// (we can theoretically redact less in the future, but this is a first step to safety)
-	codespace, code, _ := errorsmod.ABCIInfo(err, false)
-	return fmt.Errorf("codespace: %s, code: %d", codespace, code)
+	// contracts that require the CapabilityStructuredSubMsgErrors receive them as JSON instead
+	return errors.New(types.NewSubMsgError(err).String())
 }
 
 func filterEvents(events []sdk.Event) []sdk.Event {
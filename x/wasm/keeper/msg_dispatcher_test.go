@@ -9,23 +9,27 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/libs/log"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/CosmWasm/wasmd/x/wasm/keeper/wasmtesting"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
 )
 
 func TestDispatchSubmessages(t *testing.T) {
 	noReplyCalled := &mockReplyer{}
 	var anyGasLimit uint64 = 1
 	specs := map[string]struct {
-		msgs       []wasmvmtypes.SubMsg
-		replyer    *mockReplyer
-		msgHandler *wasmtesting.MockMessageHandler
-		expErr     bool
-		expData    []byte
-		expCommits []bool
-		expEvents  sdk.Events
+		msgs             []wasmvmtypes.SubMsg
+		replyer          *mockReplyer
+		msgHandler       *wasmtesting.MockMessageHandler
+		defaultGasLimit  uint64
+		expErr           bool
+		expData          []byte
+		expCommits       []bool
+		expEvents        sdk.Events
+		expSubMsgGasUsed []string
 	}{
 		"no reply on error without error": {
 			msgs:    []wasmvmtypes.SubMsg{{ReplyOn: wasmvmtypes.ReplyError}},
@@ -82,6 +86,80 @@ func TestDispatchSubmessages(t *testing.T) {
 			expData:    []byte("myReplyData"),
 			expCommits: []bool{false},
 		},
+		"reply on error - redacted error": {
+			msgs: []wasmvmtypes.SubMsg{{
+				ReplyOn: wasmvmtypes.ReplyError,
+			}},
+			replyer: &mockReplyer{
+				replyFn: func(ctx sdk.Context, contractAddress sdk.AccAddress, reply wasmvmtypes.Reply) ([]byte, error) {
+					return []byte(reply.Result.Err), nil
+				},
+			},
+			msgHandler: &wasmtesting.MockMessageHandler{
+				DispatchMsgFn: func(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) (events []sdk.Event, data [][]byte, err error) {
+					return nil, nil, sdkerrors.ErrInsufficientFunds.Wrap("non deterministic text")
+				},
+			},
+			expData:    []byte("codespace: sdk, code: 5"),
+			expCommits: []bool{false},
+		},
+		"reply on error - structured error with capability": {
+			msgs: []wasmvmtypes.SubMsg{{
+				ReplyOn: wasmvmtypes.ReplyError,
+			}},
+			replyer: &mockReplyer{
+				replyFn: func(ctx sdk.Context, contractAddress sdk.AccAddress, reply wasmvmtypes.Reply) ([]byte, error) {
+					return []byte(reply.Result.Err), nil
+				},
+				requiresCapabilityFn: func(ctx sdk.Context, contractAddress sdk.AccAddress, capability string) (bool, error) {
+					return capability == types.CapabilityStructuredSubMsgErrors, nil
+				},
+			},
+			msgHandler: &wasmtesting.MockMessageHandler{
+				DispatchMsgFn: func(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) (events []sdk.Event, data [][]byte, err error) {
+					return nil, nil, sdkerrors.ErrUnauthorized.Wrap("non deterministic text")
+				},
+			},
+			expData:    []byte(`{"codespace":"sdk","code":4}`),
+			expCommits: []bool{false},
+		},
+		"reply on error - structured system error with capability": {
+			msgs: []wasmvmtypes.SubMsg{{
+				ReplyOn: wasmvmtypes.ReplyError,
+			}},
+			replyer: &mockReplyer{
+				replyFn: func(ctx sdk.Context, contractAddress sdk.AccAddress, reply wasmvmtypes.Reply) ([]byte, error) {
+					return []byte(reply.Result.Err), nil
+				},
+				requiresCapabilityFn: func(ctx sdk.Context, contractAddress sdk.AccAddress, capability string) (bool, error) {
+					return true, nil
+				},
+			},
+			msgHandler: &wasmtesting.MockMessageHandler{
+				DispatchMsgFn: func(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) (events []sdk.Event, data [][]byte, err error) {
+					return nil, nil, wasmvmtypes.NoSuchContract{Addr: "foo"}
+				},
+			},
+			expData:    []byte(`{"codespace":"undefined","code":1,"system_error":"no such contract: foo"}`),
+			expCommits: []bool{false},
+		},
+		"reply on error - capability lookup fails": {
+			msgs: []wasmvmtypes.SubMsg{{
+				ReplyOn: wasmvmtypes.ReplyError,
+			}},
+			replyer: &mockReplyer{
+				requiresCapabilityFn: func(ctx sdk.Context, contractAddress sdk.AccAddress, capability string) (bool, error) {
+					return false, errors.New("testing")
+				},
+			},
+			msgHandler: &wasmtesting.MockMessageHandler{
+				DispatchMsgFn: func(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) (events []sdk.Event, data [][]byte, err error) {
+					return nil, nil, errors.New("my error")
+				},
+			},
+			expCommits: []bool{false},
+			expErr:     true,
+		},
 		"with reply events": {
 			msgs: []wasmvmtypes.SubMsg{{
 				ReplyOn: wasmvmtypes.ReplySuccess,
@@ -191,6 +269,109 @@ func TestDispatchSubmessages(t *testing.T) {
 			},
 			expCommits: []bool{true},
 		},
+		"gas used in event - reply on success": {
+			msgs: []wasmvmtypes.SubMsg{{
+				ID:      1,
+				ReplyOn: wasmvmtypes.ReplySuccess,
+			}},
+			replyer: &mockReplyer{
+				replyFn: func(ctx sdk.Context, contractAddress sdk.AccAddress, reply wasmvmtypes.Reply) ([]byte, error) {
+					return nil, nil
+				},
+			},
+			msgHandler: &wasmtesting.MockMessageHandler{
+				DispatchMsgFn: func(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) (events []sdk.Event, data [][]byte, err error) {
+					ctx.GasMeter().ConsumeGas(sdk.Gas(7), "testing")
+					return nil, nil, nil
+				},
+			},
+			expCommits:       []bool{true},
+			expSubMsgGasUsed: []string{"7"},
+		},
+		"gas used in event - never reply": {
+			msgs: []wasmvmtypes.SubMsg{{ID: 1, ReplyOn: wasmvmtypes.ReplyNever}, {ID: 2, ReplyOn: wasmvmtypes.ReplyNever}},
+			msgHandler: &wasmtesting.MockMessageHandler{
+				DispatchMsgFn: func(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) (events []sdk.Event, data [][]byte, err error) {
+					ctx.GasMeter().ConsumeGas(sdk.Gas(3), "testing")
+					return nil, nil, nil
+				},
+			},
+			expCommits:       []bool{true, true},
+			expSubMsgGasUsed: []string{"3", "3"},
+		},
+		"gas used in event - with gas limit out of gas charged": {
+			msgs: []wasmvmtypes.SubMsg{{
+				ID:       1,
+				GasLimit: &anyGasLimit,
+				ReplyOn:  wasmvmtypes.ReplyError,
+			}},
+			replyer: &mockReplyer{
+				replyFn: func(ctx sdk.Context, contractAddress sdk.AccAddress, reply wasmvmtypes.Reply) ([]byte, error) {
+					return nil, nil
+				},
+			},
+			msgHandler: &wasmtesting.MockMessageHandler{
+				DispatchMsgFn: func(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) (events []sdk.Event, data [][]byte, err error) {
+					ctx.GasMeter().ConsumeGas(sdk.Gas(2), "testing")
+					return nil, nil, nil
+				},
+			},
+			expCommits:       []bool{false},
+			expSubMsgGasUsed: []string{"1"},
+		},
+		"with default gas limit - out of gas": {
+			msgs: []wasmvmtypes.SubMsg{{
+				ReplyOn: wasmvmtypes.ReplyError,
+			}},
+			replyer: &mockReplyer{
+				replyFn: func(ctx sdk.Context, contractAddress sdk.AccAddress, reply wasmvmtypes.Reply) ([]byte, error) {
+					return []byte(reply.Result.Err), nil
+				},
+			},
+			msgHandler: &wasmtesting.MockMessageHandler{
+				DispatchMsgFn: func(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) (events []sdk.Event, data [][]byte, err error) {
+					ctx.GasMeter().ConsumeGas(sdk.Gas(11), "testing")
+					return nil, nil, nil
+				},
+			},
+			defaultGasLimit: 10,
+			expData:         []byte("codespace: sdk, code: 11"),
+			expCommits:      []bool{false},
+		},
+		"with default gas limit - contract gas limit takes precedence": {
+			msgs: []wasmvmtypes.SubMsg{{
+				GasLimit: &anyGasLimit,
+				ReplyOn:  wasmvmtypes.ReplyError,
+			}},
+			replyer: &mockReplyer{
+				replyFn: func(ctx sdk.Context, contractAddress sdk.AccAddress, reply wasmvmtypes.Reply) ([]byte, error) {
+					return []byte(reply.Result.Err), nil
+				},
+			},
+			msgHandler: &wasmtesting.MockMessageHandler{
+				DispatchMsgFn: func(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) (events []sdk.Event, data [][]byte, err error) {
+					ctx.GasMeter().ConsumeGas(sdk.Gas(2), "testing")
+					return nil, nil, nil
+				},
+			},
+			defaultGasLimit: 10,
+			expData:         []byte("codespace: sdk, code: 11"),
+			expCommits:      []bool{false},
+		},
+		"with default gas limit - within limit": {
+			msgs: []wasmvmtypes.SubMsg{{
+				ReplyOn: wasmvmtypes.ReplyError,
+			}},
+			replyer: noReplyCalled,
+			msgHandler: &wasmtesting.MockMessageHandler{
+				DispatchMsgFn: func(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) (events []sdk.Event, data [][]byte, err error) {
+					ctx.GasMeter().ConsumeGas(sdk.Gas(9), "testing")
+					return nil, nil, nil
+				},
+			},
+			defaultGasLimit: 10,
+			expCommits:      []bool{true},
+		},
 		"never reply - with nil response": {
 			msgs:    []wasmvmtypes.SubMsg{{ID: 1, ReplyOn: wasmvmtypes.ReplyNever}, {ID: 2, ReplyOn: wasmvmtypes.ReplyNever}},
 			replyer: &mockReplyer{},
@@ -394,12 +575,26 @@ func TestDispatchSubmessages(t *testing.T) {
 				WithGasMeter(sdk.NewGasMeter(100)).
 				WithEventManager(em).WithLogger(log.TestingLogger())
 			d := NewMessageDispatcher(spec.msgHandler, spec.replyer)
+			d.defaultGasLimit = spec.defaultGasLimit
 
 			// run the test
 			gotData, gotErr := d.DispatchSubmessages(ctx, RandomAccountAddress(t), "any_port", spec.msgs)
+			var gotEvents sdk.Events
+			var gotSubMsgGasUsed []string
+			for _, e := range em.Events() {
+				if e.Type != types.EventTypeSubMsg {
+					gotEvents = append(gotEvents, e)
+					continue
+				}
+				for _, a := range e.Attributes {
+					if a.Key == types.AttributeKeySubMsgGasUsed {
+						gotSubMsgGasUsed = append(gotSubMsgGasUsed, a.Value)
+					}
+				}
+			}
 			if spec.expErr {
 				require.Error(t, gotErr)
-				assert.Empty(t, em.Events())
+				assert.Empty(t, gotEvents)
 				return
 			}
 
@@ -410,16 +605,20 @@ func TestDispatchSubmessages(t *testing.T) {
 			// ensure the commits are what we expect
 			assert.Equal(t, spec.expCommits, mockStore.Committed)
 			if len(spec.expEvents) == 0 {
-				assert.Empty(t, em.Events())
+				assert.Empty(t, gotEvents)
 			} else {
-				assert.Equal(t, spec.expEvents, em.Events())
+				assert.Equal(t, spec.expEvents, gotEvents)
+			}
+			if spec.expSubMsgGasUsed != nil {
+				assert.Equal(t, spec.expSubMsgGasUsed, gotSubMsgGasUsed)
 			}
 		})
 	}
 }
 
 type mockReplyer struct {
-	replyFn func(ctx sdk.Context, contractAddress sdk.AccAddress, reply wasmvmtypes.Reply) ([]byte, error)
+	replyFn              func(ctx sdk.Context, contractAddress sdk.AccAddress, reply wasmvmtypes.Reply) ([]byte, error)
+	requiresCapabilityFn func(ctx sdk.Context, contractAddress sdk.AccAddress, capability string) (bool, error)
 }
 
 func (m mockReplyer) reply(ctx sdk.Context, contractAddress sdk.AccAddress, reply wasmvmtypes.Reply) ([]byte, error) {
@@ -428,3 +627,10 @@ func (m mockReplyer) reply(ctx sdk.Context, contractAddress sdk.AccAddress, repl
 	}
 	return m.replyFn(ctx, contractAddress, reply)
 }
+
+func (m mockReplyer) requiresCapability(ctx sdk.Context, contractAddress sdk.AccAddress, capability string) (bool, error) {
+	if m.requiresCapabilityFn == nil {
+		return false, nil
+	}
+	return m.requiresCapabilityFn(ctx, contractAddress, capability)
+}
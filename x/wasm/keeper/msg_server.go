@@ -38,6 +38,9 @@ func (m msgServer) StoreCode(goCtx context.Context, msg *types.MsgStoreCode) (*t
 	if err != nil {
 		return nil, err
 	}
+	if err := m.keeper.setCodeVerificationInfo(ctx, codeID, msg.Source, msg.Builder, msg.CodeHash); err != nil {
+		return nil, err
+	}
 
 	return &types.MsgStoreCodeResponse{
 		CodeID:   codeID,
@@ -96,7 +99,10 @@ func (m msgServer) InstantiateContract2(goCtx context.Context, msg *types.MsgIns
 
 	policy := m.selectAuthorizationPolicy(msg.Sender)
 
-	addrGenerator := PredicableAddressGenerator(senderAddr, msg.Salt, msg.Msg, msg.FixMsg)
+	addrGenerator, err := m.keeper.predictableAddressGenerator(ctx, senderAddr, msg.Salt, msg.Msg, msg.FixMsg)
+	if err != nil {
+		return nil, err
+	}
 
 	contractAddr, data, err := m.keeper.instantiate(ctx, msg.CodeID, senderAddr, adminAddr, msg.Msg, msg.Label, msg.Funds, addrGenerator, policy)
 	if err != nil {
@@ -134,6 +140,42 @@ func (m msgServer) ExecuteContract(goCtx context.Context, msg *types.MsgExecuteC
 	}, nil
 }
 
+func (m msgServer) ExecuteContracts(goCtx context.Context, msg *types.MsgExecuteContracts) (*types.MsgExecuteContractsResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "sender")
+	}
+
+	// all executions succeed or none is persisted
+	cacheCtx, commit := ctx.CacheContext()
+	responses := make([][]byte, 0, len(msg.Executions))
+	for i, e := range msg.Executions {
+		contractAddr, err := sdk.AccAddressFromBech32(e.Contract)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "execution %d: contract", i)
+		}
+		payload, err := types.ResolveResponseTemplates(cacheCtx, e.Msg, responses)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "execution %d", i)
+		}
+		data, err := m.keeper.execute(cacheCtx, contractAddr, senderAddr, payload, e.Funds)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "execution %d", i)
+		}
+		responses = append(responses, data)
+	}
+	commit()
+
+	return &types.MsgExecuteContractsResponse{
+		Data: responses,
+	}, nil
+}
+
 func (m msgServer) MigrateContract(goCtx context.Context, msg *types.MsgMigrateContract) (*types.MsgMigrateContractResponse, error) {
 	if err := msg.ValidateBasic(); err != nil {
 		return nil, err
@@ -189,6 +231,129 @@ func (m msgServer) UpdateAdmin(goCtx context.Context, msg *types.MsgUpdateAdmin)
 	return &types.MsgUpdateAdminResponse{}, nil
 }
 
+// ProposeAdmin records a pending admin for a contract that has to accept the transfer
+func (m msgServer) ProposeAdmin(goCtx context.Context, msg *types.MsgProposeAdmin) (*types.MsgProposeAdminResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "sender")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "contract")
+	}
+	newAdminAddr, err := sdk.AccAddressFromBech32(msg.NewAdmin)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "new admin")
+	}
+
+	policy := m.selectAuthorizationPolicy(msg.Sender)
+
+	if err := m.keeper.proposeContractAdmin(ctx, contractAddr, senderAddr, newAdminAddr, msg.ExpiryBlocks, policy); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgProposeAdminResponse{}, nil
+}
+
+// AcceptAdmin sets the sender as contract admin when proposed before
+func (m msgServer) AcceptAdmin(goCtx context.Context, msg *types.MsgAcceptAdmin) (*types.MsgAcceptAdminResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "sender")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "contract")
+	}
+
+	if err := m.keeper.acceptContractAdmin(ctx, contractAddr, senderAddr); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAcceptAdminResponse{}, nil
+}
+
+// CancelAdminTransfer removes the pending admin of a contract
+func (m msgServer) CancelAdminTransfer(goCtx context.Context, msg *types.MsgCancelAdminTransfer) (*types.MsgCancelAdminTransferResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "sender")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "contract")
+	}
+
+	policy := m.selectAuthorizationPolicy(msg.Sender)
+
+	if err := m.keeper.cancelAdminTransfer(ctx, contractAddr, senderAddr, policy); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelAdminTransferResponse{}, nil
+}
+
+// UpdateContractMetadata replaces the descriptive metadata of a contract
+func (m msgServer) UpdateContractMetadata(goCtx context.Context, msg *types.MsgUpdateContractMetadata) (*types.MsgUpdateContractMetadataResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "sender")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "contract")
+	}
+
+	policy := m.selectAuthorizationPolicy(msg.Sender)
+
+	if err := m.keeper.setContractMetadata(ctx, contractAddr, senderAddr, msg.Metadata, policy); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateContractMetadataResponse{}, nil
+}
+
+// SetContractSchema registers the JSON schema of the messages of a code
+func (m msgServer) SetContractSchema(goCtx context.Context, msg *types.MsgSetContractSchema) (*types.MsgSetContractSchemaResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "sender")
+	}
+
+	policy := m.selectAuthorizationPolicy(msg.Sender)
+
+	if err := m.keeper.setContractSchema(ctx, msg.CodeID, senderAddr, msg.Schema, policy); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetContractSchemaResponse{}, nil
+}
+
 func (m msgServer) ClearAdmin(goCtx context.Context, msg *types.MsgClearAdmin) (*types.MsgClearAdminResponse, error) {
 	if err := msg.ValidateBasic(); err != nil {
 		return nil, err
@@ -271,6 +436,25 @@ func (m msgServer) PinCodes(goCtx context.Context, req *types.MsgPinCodes) (*typ
 	return &types.MsgPinCodesResponse{}, nil
 }
 
+// MigrateContractsByCode starts the migration of all contracts of a code to a new code
+func (m msgServer) MigrateContractsByCode(goCtx context.Context, req *types.MsgMigrateContractsByCode) (*types.MsgMigrateContractsByCodeResponse, error) {
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	authority := m.keeper.GetAuthority()
+	if authority != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalid, "invalid authority; expected %s, got %s", authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	id, err := m.keeper.startContractsMigration(ctx, req.CodeID, req.NewCodeID, req.Msg, req.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgMigrateContractsByCodeResponse{MigrationID: id}, nil
+}
+
 // UnpinCodes unpins a set of code ids in the wasmvm cache.
 func (m msgServer) UnpinCodes(goCtx context.Context, req *types.MsgUnpinCodes) (*types.MsgUnpinCodesResponse, error) {
 	if err := req.ValidateBasic(); err != nil {
@@ -345,6 +529,9 @@ func (m msgServer) StoreAndInstantiateContract(goCtx context.Context, req *types
 	if err != nil {
 		return nil, err
 	}
+	if err := m.keeper.setCodeVerificationInfo(ctx, codeID, req.Source, req.Builder, req.CodeHash); err != nil {
+		return nil, err
+	}
 
 	contractAddr, data, err := m.keeper.instantiate(ctx, codeID, authorityAddr, adminAddr, req.Msg, req.Label, req.Funds, m.keeper.ClassicAddressGenerator(), policy)
 	if err != nil {
@@ -357,6 +544,60 @@ func (m msgServer) StoreAndInstantiateContract(goCtx context.Context, req *types
 	}, nil
 }
 
+// SetStargateQueryAllowlist sets the additional stargate query paths for a code or contract.
+func (m msgServer) SetStargateQueryAllowlist(goCtx context.Context, req *types.MsgSetStargateQueryAllowlist) (*types.MsgSetStargateQueryAllowlistResponse, error) {
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	authority := m.keeper.GetAuthority()
+	if authority != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalid, "invalid authority; expected %s, got %s", authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if req.CodeID != 0 {
+		if err := m.keeper.SetCodeStargateQueryAllowlist(ctx, req.CodeID, req.Paths); err != nil {
+			return nil, err
+		}
+		return &types.MsgSetStargateQueryAllowlistResponse{}, nil
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(req.Contract)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "contract")
+	}
+	if err := m.keeper.SetContractStargateQueryAllowlist(ctx, contractAddr, req.Paths); err != nil {
+		return nil, err
+	}
+	return &types.MsgSetStargateQueryAllowlistResponse{}, nil
+}
+
+// RemoveStargateQueryAllowlist removes the additional stargate query paths of a code or contract.
+func (m msgServer) RemoveStargateQueryAllowlist(goCtx context.Context, req *types.MsgRemoveStargateQueryAllowlist) (*types.MsgRemoveStargateQueryAllowlistResponse, error) {
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	authority := m.keeper.GetAuthority()
+	if authority != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalid, "invalid authority; expected %s, got %s", authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if req.CodeID != 0 {
+		if err := m.keeper.RemoveCodeStargateQueryAllowlist(ctx, req.CodeID); err != nil {
+			return nil, err
+		}
+		return &types.MsgRemoveStargateQueryAllowlistResponse{}, nil
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(req.Contract)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "contract")
+	}
+	if err := m.keeper.RemoveContractStargateQueryAllowlist(ctx, contractAddr); err != nil {
+		return nil, err
+	}
+	return &types.MsgRemoveStargateQueryAllowlistResponse{}, nil
+}
+
 func (m msgServer) selectAuthorizationPolicy(actor string) AuthorizationPolicy {
 	if actor == m.keeper.GetAuthority() {
 		return GovAuthorizationPolicy{}
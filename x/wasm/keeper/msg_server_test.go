@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestExecuteContracts(t *testing.T) {
+	releaseMsg := types.RawContractMessage(`{"release":{}}`)
+	topUp := sdk.NewCoins(sdk.NewInt64Coin("denom", 50))
+
+	specs := map[string]struct {
+		executions     func(contract string) []types.ContractExecution
+		expErr         bool
+		expResponses   int
+		expBeneficiary sdk.Coins
+	}{
+		"all executed": {
+			executions: func(contract string) []types.ContractExecution {
+				return []types.ContractExecution{
+					{Contract: contract, Msg: releaseMsg},
+					{Contract: contract, Msg: releaseMsg, Funds: topUp},
+				}
+			},
+			expResponses:   2,
+			expBeneficiary: sdk.NewCoins(sdk.NewInt64Coin("denom", 150)),
+		},
+		"later execution fails": {
+			executions: func(contract string) []types.ContractExecution {
+				return []types.ContractExecution{
+					{Contract: contract, Msg: releaseMsg},
+					{Contract: contract, Msg: types.RawContractMessage(`{"unknown":{}}`)},
+				}
+			},
+			expErr: true,
+		},
+		"unknown contract": {
+			executions: func(contract string) []types.ContractExecution {
+				return []types.ContractExecution{
+					{Contract: contract, Msg: releaseMsg},
+					{Contract: RandomBech32AccountAddress(t), Msg: releaseMsg},
+				}
+			},
+			expErr: true,
+		},
+		"unresolvable response template": {
+			executions: func(contract string) []types.ContractExecution {
+				return []types.ContractExecution{
+					{Contract: contract, Msg: releaseMsg},
+					{Contract: contract, Msg: types.RawContractMessage(`{"release":{"data":"{{responses.0.foo}}"}}`)},
+				}
+			},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+			example := InstantiateHackatomExampleContract(t, ctx, keepers)
+			fundAccounts(t, ctx, keepers.AccountKeeper, keepers.BankKeeper, example.VerifierAddr, topUp)
+			msgServer := NewMsgServerImpl(keepers.WasmKeeper)
+			em := sdk.NewEventManager()
+
+			// when
+			gotRsp, gotErr := msgServer.ExecuteContracts(sdk.WrapSDKContext(ctx.WithEventManager(em)), &types.MsgExecuteContracts{
+				Sender:     example.VerifierAddr.String(),
+				Executions: spec.executions(example.Contract.String()),
+			})
+
+			// then
+			beneficiaryBalance := keepers.BankKeeper.GetAllBalances(ctx, example.BeneficiaryAddr)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				// and nothing persisted
+				assert.Empty(t, beneficiaryBalance)
+				assert.Equal(t, example.Deposit, keepers.BankKeeper.GetAllBalances(ctx, example.Contract))
+				assert.Empty(t, em.Events())
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Len(t, gotRsp.Data, spec.expResponses)
+			assert.Equal(t, spec.expBeneficiary, beneficiaryBalance)
+			assert.NotEmpty(t, em.Events())
+		})
+	}
+}
@@ -83,16 +83,20 @@ func WithMessageEncoders(x *MessageEncoders) Option {
 		if !ok {
 			panic(fmt.Sprintf("Unsupported message handler type: %T", k.messenger))
 		}
-		s, ok := q.handlers[0].(SDKMessageHandler)
-		if !ok {
-			panic(fmt.Sprintf("Unexpected message handler type: %T", q.handlers[0]))
-		}
-		e, ok := s.encoders.(MessageEncoders)
-		if !ok {
-			panic(fmt.Sprintf("Unsupported encoder type: %T", s.encoders))
+		for i, h := range q.handlers {
+			s, ok := h.(SDKMessageHandler)
+			if !ok {
+				continue
+			}
+			e, ok := s.encoders.(MessageEncoders)
+			if !ok {
+				panic(fmt.Sprintf("Unsupported encoder type: %T", s.encoders))
+			}
+			s.encoders = e.Merge(x)
+			q.handlers[i] = s
+			return
 		}
-		s.encoders = e.Merge(x)
-		q.handlers[0] = s
+		panic("No SDKMessageHandler in message handler chain")
 	})
 }
 
@@ -150,6 +154,123 @@ func WithMaxQueryStackSize(m uint32) Option {
 	})
 }
 
+// WithMaxIBCCallbackGas sets the max gas a contract can consume in an IBC callback like
+// `OnRecvPacket` or `OnAckPacket`. The relayer's full tx gas is used when not set or 0.
+func WithMaxIBCCallbackGas(gas uint64) Option {
+	return optsFn(func(k *Keeper) {
+		k.maxIBCCallbackGas = gas
+	})
+}
+
+// WithDefaultSubMsgGasLimit sets the max gas for submessages that are dispatched by a contract
+// without a gas limit. A gas limit set by the contract takes precedence. No limit is applied when not set or 0.
+func WithDefaultSubMsgGasLimit(gas uint64) Option {
+	return optsFn(func(k *Keeper) {
+		k.defaultSubMsgGasLimit = gas
+	})
+}
+
+// WithICS29FeeKeeper is an optional constructor parameter to let contracts pay ICS-29 relayer fees for the
+// packets that they send. The given keeper must be the fee middleware that is part of the wasm ibc-stack.
+// This option expects the default message handler set and should not be combined with Option `WithMessageHandler`.
+func WithICS29FeeKeeper(x types.ICS29FeeKeeper) Option {
+	return optsFn(func(k *Keeper) {
+		// the fee handler must come first so that the fee message is not routed by the SDKMessageHandler
+		if q, ok := k.messenger.(*MessageHandlerChain); ok {
+			q.handlers = append([]Messenger{NewIBCPacketFeeHandler(x)}, q.handlers...)
+			return
+		}
+		k.messenger = NewMessageHandlerChain(NewIBCPacketFeeHandler(x), k.messenger)
+	})
+}
+
+// WithIBCQueryHost enables this chain to answer contract queries that are received from counterparty chains over
+// the IBC query port. The given authorizer decides which queries are executed. See `AcceptContractQueriesOnly` for
+// the default. The app must route the `types.IBCQueryPortID` to the `wasm.IBCQueryHandler`.
+func WithIBCQueryHost(x IBCQueryAuthorizer) Option {
+	if x == nil {
+		panic("must not be nil")
+	}
+	return optsFn(func(k *Keeper) {
+		k.ibcQueryAuthorizer = x
+	})
+}
+
+// WithAuthzKeeper enables the query for the authz grants that reference a contract
+func WithAuthzKeeper(x types.AuthzKeeper) Option {
+	if x == nil {
+		panic("must not be nil")
+	}
+	return optsFn(func(k *Keeper) {
+		k.authzKeeper = x
+	})
+}
+
+// WithAuthzPlugins enables the authz messages and queries for contracts. See types.ModuleMsg.
+// Contracts using them require the types.CapabilityAuthz which must be added to the supported capabilities.
+func WithAuthzPlugins(x types.AuthzKeeper) Option {
+	if x == nil {
+		panic("must not be nil")
+	}
+	return optsFn(func(k *Keeper) {
+		WithMessageEncoders(&MessageEncoders{Authz: EncodeAuthzMsg(k.cdc)}).apply(k)
+		WithQueryPlugins(&QueryPlugins{Authz: AuthzQuerier(x)}).apply(k)
+	})
+}
+
+// WithFeegrantPlugins enables the feegrant messages and queries for contracts. See types.ModuleMsg.
+// Contracts using them require the types.CapabilityFeegrant which must be added to the supported capabilities.
+func WithFeegrantPlugins(x types.FeegrantKeeper) Option {
+	if x == nil {
+		panic("must not be nil")
+	}
+	return optsFn(func(k *Keeper) {
+		WithMessageEncoders(&MessageEncoders{Feegrant: EncodeFeegrantMsg}).apply(k)
+		WithQueryPlugins(&QueryPlugins{Feegrant: FeegrantQuerier(x)}).apply(k)
+	})
+}
+
+// WithNFTPlugins enables the nft messages and queries for contracts. See types.ModuleMsg.
+// Contracts using them require the types.CapabilityNFT which must be added to the supported capabilities.
+func WithNFTPlugins(x types.NFTKeeper) Option {
+	if x == nil {
+		panic("must not be nil")
+	}
+	return optsFn(func(k *Keeper) {
+		WithMessageEncoders(&MessageEncoders{NFT: EncodeNFTMsg}).apply(k)
+		WithQueryPlugins(&QueryPlugins{NFT: NFTQuerier(x)}).apply(k)
+	})
+}
+
+// WithGroupPlugins enables the group messages and queries for contracts. See types.ModuleMsg.
+// Contracts using them require the types.CapabilityGroup which must be added to the supported capabilities.
+func WithGroupPlugins(x types.GroupKeeper) Option {
+	if x == nil {
+		panic("must not be nil")
+	}
+	return optsFn(func(k *Keeper) {
+		WithMessageEncoders(&MessageEncoders{Group: EncodeGroupMsg(k.cdc)}).apply(k)
+		WithQueryPlugins(&QueryPlugins{Group: GroupQuerier(x)}).apply(k)
+	})
+}
+
+// WithAddressGeneratorStrategy registers a custom strategy to derive the addresses of contracts that are
+// instantiated with a salt. The strategy is used when selected by name in the module params.
+func WithAddressGeneratorStrategy(name string, x AddressGeneratorStrategy) Option {
+	if x == nil {
+		panic("must not be nil")
+	}
+	if err := (types.AddressGeneratorConfig{Strategy: name}).ValidateBasic(); err != nil || name == "" {
+		panic(fmt.Sprintf("invalid strategy name: %q", name))
+	}
+	return optsFn(func(k *Keeper) {
+		if _, exists := k.addressGeneratorStrategies[name]; exists {
+			panic(fmt.Sprintf("duplicate address generator strategy: %q", name))
+		}
+		k.addressGeneratorStrategies[name] = x
+	})
+}
+
 // WithAcceptedAccountTypesOnContractInstantiation sets the accepted account types. Account types of this list won't be overwritten or cause a failure
 // when they exist for an address on contract instantiation.
 //
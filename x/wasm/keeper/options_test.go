@@ -94,6 +94,16 @@ func TestConstructorOptions(t *testing.T) {
 				assert.IsType(t, uint32(1), k.maxQueryStackSize)
 			},
 		},
+		"default submessage gas limit": {
+			srcOpt: WithDefaultSubMsgGasLimit(1),
+			verify: func(t *testing.T, k Keeper) {
+				assert.Equal(t, uint64(1), k.defaultSubMsgGasLimit)
+				require.IsType(t, &DefaultWasmVMContractResponseHandler{}, k.wasmVMResponseHandler)
+				dispatcher := k.wasmVMResponseHandler.(*DefaultWasmVMContractResponseHandler).md
+				require.IsType(t, &MessageDispatcher{}, dispatcher)
+				assert.Equal(t, uint64(1), dispatcher.(*MessageDispatcher).defaultGasLimit)
+			},
+		},
 		"accepted account types": {
 			srcOpt: WithAcceptedAccountTypesOnContractInstantiation(&authtypes.BaseAccount{}, &vestingtypes.ContinuousVestingAccount{}),
 			verify: func(t *testing.T, k Keeper) {
@@ -104,6 +114,20 @@ func TestConstructorOptions(t *testing.T) {
 				assert.Equal(t, exp, k.acceptedAccountTypes)
 			},
 		},
+		"authz plugins": {
+			srcOpt: WithAuthzPlugins(authzKeeperMock{}),
+			verify: func(t *testing.T, k Keeper) {
+				assert.NotNil(t, k.wasmVMQueryHandler.(QueryPlugins).Authz)
+				assert.NotNil(t, k.messenger.(*MessageHandlerChain).handlers[0].(SDKMessageHandler).encoders.(MessageEncoders).Authz)
+			},
+		},
+		"nft plugins": {
+			srcOpt: WithNFTPlugins(nftKeeperMock{}),
+			verify: func(t *testing.T, k Keeper) {
+				assert.NotNil(t, k.wasmVMQueryHandler.(QueryPlugins).NFT)
+				assert.NotNil(t, k.messenger.(*MessageHandlerChain).handlers[0].(SDKMessageHandler).encoders.(MessageEncoders).NFT)
+			},
+		},
 		"account pruner": {
 			srcOpt: WithAccountPruner(VestingCoinBurner{}),
 			verify: func(t *testing.T, k Keeper) {
@@ -119,6 +143,24 @@ func TestConstructorOptions(t *testing.T) {
 	}
 }
 
+func TestICS29FeeKeeperWithMessageEncoders(t *testing.T) {
+	specs := map[string][]Option{
+		"fee keeper first": {WithICS29FeeKeeper(wasmtesting.MockICS29FeeKeeper{}), WithFeegrantPlugins(feegrantKeeperMock{})},
+		"fee keeper last":  {WithFeegrantPlugins(feegrantKeeperMock{}), WithICS29FeeKeeper(wasmtesting.MockICS29FeeKeeper{})},
+	}
+	for name, opts := range specs {
+		t.Run(name, func(t *testing.T) {
+			k := NewKeeper(nil, nil, authkeeper.AccountKeeper{}, &bankkeeper.BaseKeeper{}, stakingkeeper.Keeper{}, nil, nil, nil, nil, nil, nil, nil, nil, "tempDir", types.DefaultWasmConfig(), AvailableCapabilities, "", opts...)
+			require.IsType(t, &MessageHandlerChain{}, k.messenger)
+			handlers := k.messenger.(*MessageHandlerChain).handlers
+			require.Len(t, handlers, 4)
+			assert.IsType(t, IBCPacketFeeHandler{}, handlers[0])
+			require.IsType(t, SDKMessageHandler{}, handlers[1])
+			assert.NotNil(t, handlers[1].(SDKMessageHandler).encoders.(MessageEncoders).Feegrant)
+		})
+	}
+}
+
 func setAPIDefaults() {
 	costHumanize = DefaultGasCostHumanAddress * DefaultGasMultiplier
 	costCanonical = DefaultGasCostCanonicalAddress * DefaultGasMultiplier
@@ -1,9 +1,7 @@
 package keeper
 
 import (
-	"bytes"
 	"encoding/hex"
-	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
 
@@ -77,13 +75,13 @@ func handleStoreCodeProposal(ctx sdk.Context, k types.ContractOpsKeeper, p types
 	if err != nil {
 		return errorsmod.Wrap(err, "run as address")
 	}
-	codeID, checksum, err := k.Create(ctx, runAsAddr, p.WASMByteCode, p.InstantiatePermission)
+	codeID, _, err := k.Create(ctx, runAsAddr, p.WASMByteCode, p.InstantiatePermission)
 	if err != nil {
 		return err
 	}
 
-	if len(p.CodeHash) != 0 && !bytes.Equal(checksum, p.CodeHash) {
-		return fmt.Errorf("code-hash mismatch: %X, checksum: %X", p.CodeHash, checksum)
+	if err := k.SetCodeVerificationInfo(ctx, codeID, p.Source, p.Builder, p.CodeHash); err != nil {
+		return err
 	}
 
 	// if code should not be pinned return earlier
@@ -170,13 +168,13 @@ func handleStoreAndInstantiateContractProposal(ctx sdk.Context, k types.Contract
 		}
 	}
 
-	codeID, checksum, err := k.Create(ctx, runAsAddr, p.WASMByteCode, p.InstantiatePermission)
+	codeID, _, err := k.Create(ctx, runAsAddr, p.WASMByteCode, p.InstantiatePermission)
 	if err != nil {
 		return err
 	}
 
-	if p.CodeHash != nil && !bytes.Equal(checksum, p.CodeHash) {
-		return errorsmod.Wrap(fmt.Errorf("code-hash mismatch: %X, checksum: %X", p.CodeHash, checksum), "code-hash mismatch")
+	if err := k.SetCodeVerificationInfo(ctx, codeID, p.Source, p.Builder, p.CodeHash); err != nil {
+		return err
 	}
 
 	if !p.UnpinCode {
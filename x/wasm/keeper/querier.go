@@ -246,6 +246,9 @@ func (q GrpcQuerier) Codes(c context.Context, req *types.QueryCodesRequest) (*ty
 				Creator:               c.Creator,
 				DataHash:              c.CodeHash,
 				InstantiatePermission: c.InstantiateConfig,
+				Analysis:              c.Analysis,
+				Source:                c.Source,
+				Builder:               c.Builder,
 			})
 		}
 		return true, nil
@@ -282,6 +285,9 @@ func queryCode(ctx sdk.Context, codeID uint64, keeper types.ViewKeeper) (*types.
 		Creator:               res.Creator,
 		DataHash:              res.CodeHash,
 		InstantiatePermission: res.InstantiateConfig,
+		Analysis:              res.Analysis,
+		Source:                res.Source,
+		Builder:               res.Builder,
 	}
 
 	code, err := keeper.GetByteCode(ctx, codeID)
@@ -350,3 +356,377 @@ func (q GrpcQuerier) ContractsByCreator(c context.Context, req *types.QueryContr
 		Pagination:        pageRes,
 	}, nil
 }
+
+func (q GrpcQuerier) ContractIBCState(c context.Context, req *types.QueryContractIBCStateRequest) (*types.QueryContractIBCStateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	contractInfo := q.keeper.GetContractInfo(ctx, contractAddr)
+	if contractInfo == nil {
+		return nil, types.ErrNoSuchContractFn(contractAddr.String()).
+			Wrapf("address %s", contractAddr.String())
+	}
+	rsp := &types.QueryContractIBCStateResponse{
+		IBCPortID: contractInfo.IBCPortID,
+		Channels:  make([]types.ContractIBCChannel, 0),
+	}
+	if contractInfo.IBCPortID == "" {
+		return rsp, nil
+	}
+	channels, err := q.keeper.GetIBCChannelsByPort(ctx, contractInfo.IBCPortID, req.ChannelID, req.Pagination, req.AcknowledgementsPagination)
+	if err != nil {
+		return nil, err
+	}
+	rsp.Channels = append(rsp.Channels, channels...)
+	return rsp, nil
+}
+
+func (q GrpcQuerier) StargateQueryAllowlist(c context.Context, req *types.QueryStargateQueryAllowlistRequest) (*types.QueryStargateQueryAllowlistResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	switch {
+	case req.CodeID == 0 && req.Contract == "":
+		return nil, status.Error(codes.InvalidArgument, "code id or contract required")
+	case req.CodeID != 0 && req.Contract != "":
+		return nil, status.Error(codes.InvalidArgument, "code id and contract are mutually exclusive")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	var paths []string
+	if req.CodeID != 0 {
+		if q.keeper.GetCodeInfo(ctx, req.CodeID) == nil {
+			return nil, types.ErrNoSuchCodeFn(req.CodeID).Wrapf("code id %d", req.CodeID)
+		}
+		paths = q.keeper.GetCodeStargateQueryAllowlist(ctx, req.CodeID)
+	} else {
+		contractAddr, err := sdk.AccAddressFromBech32(req.Contract)
+		if err != nil {
+			return nil, err
+		}
+		if !q.keeper.HasContractInfo(ctx, contractAddr) {
+			return nil, types.ErrNoSuchContractFn(contractAddr.String()).
+				Wrapf("address %s", contractAddr.String())
+		}
+		paths = q.keeper.GetContractStargateQueryAllowlist(ctx, contractAddr)
+	}
+	if paths == nil {
+		paths = make([]string, 0)
+	}
+	return &types.QueryStargateQueryAllowlistResponse{Paths: paths}, nil
+}
+
+func (q GrpcQuerier) ContractAuthzGrants(c context.Context, req *types.QueryContractAuthzGrantsRequest) (*types.QueryContractAuthzGrantsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	if !q.keeper.HasContractInfo(ctx, contractAddr) {
+		return nil, types.ErrNoSuchContractFn(contractAddr.String()).
+			Wrapf("address %s", contractAddr.String())
+	}
+	grants, pageRes, err := q.keeper.GetContractAuthzGrants(ctx, contractAddr, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryContractAuthzGrantsResponse{Grants: grants, Pagination: pageRes}, nil
+}
+
+func (q GrpcQuerier) ContractMetadata(c context.Context, req *types.QueryContractMetadataRequest) (*types.QueryContractMetadataResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	if !q.keeper.HasContractInfo(ctx, contractAddr) {
+		return nil, types.ErrNoSuchContractFn(contractAddr.String()).
+			Wrapf("address %s", contractAddr.String())
+	}
+	var rsp types.QueryContractMetadataResponse
+	if metadata := q.keeper.GetContractMetadata(ctx, contractAddr); metadata != nil {
+		rsp.Metadata = *metadata
+	}
+	return &rsp, nil
+}
+
+func (q GrpcQuerier) ContractsByTag(c context.Context, req *types.QueryContractsByTagRequest) (*types.QueryContractsByTagResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := (types.MetadataTag{Key: req.Key, Value: req.Value}).ValidateBasic(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	contracts := make([]string, 0)
+
+	prefixStore := prefix.NewStore(ctx.KVStore(q.storeKey), contractsByMetadataTagPrefix(req.Key, req.Value))
+	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(key []byte, _ []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			contracts = append(contracts, parseContractsByMetadataTagKey(key, req.Value).String())
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryContractsByTagResponse{
+		ContractAddresses: contracts,
+		Pagination:        pageRes,
+	}, nil
+}
+
+func (q GrpcQuerier) ContractsByAdmin(c context.Context, req *types.QueryContractsByAdminRequest) (*types.QueryContractsByAdminResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	contracts := make([]string, 0)
+
+	adminAddress, err := sdk.AccAddressFromBech32(req.AdminAddress)
+	if err != nil {
+		return nil, err
+	}
+	prefixStore := prefix.NewStore(ctx.KVStore(q.storeKey), types.GetContractsByAdminPrefix(adminAddress))
+	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(key []byte, _ []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			contracts = append(contracts, sdk.AccAddress(key).String())
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryContractsByAdminResponse{
+		ContractAddresses: contracts,
+		Pagination:        pageRes,
+	}, nil
+}
+
+func (q GrpcQuerier) ContractsByLabelPrefix(c context.Context, req *types.QueryContractsByLabelPrefixRequest) (*types.QueryContractsByLabelPrefixResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if len(req.LabelPrefix) > types.MaxLabelSize {
+		return nil, status.Errorf(codes.InvalidArgument, "label prefix cannot be longer than %d characters", types.MaxLabelSize)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	contracts := make([]string, 0)
+
+	prefixStore := prefix.NewStore(ctx.KVStore(q.storeKey), types.GetContractsByLabelPrefix(req.LabelPrefix))
+	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(key []byte, _ []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			contracts = append(contracts, types.ParseContractByLabelSecondaryIndexKey(key).String())
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryContractsByLabelPrefixResponse{
+		ContractAddresses: contracts,
+		Pagination:        pageRes,
+	}, nil
+}
+
+func (q GrpcQuerier) ContractSchema(c context.Context, req *types.QueryContractSchemaRequest) (*types.QueryContractSchemaResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.CodeId == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalid, "code id")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	if q.keeper.GetCodeInfo(ctx, req.CodeId) == nil {
+		return nil, types.ErrNoSuchCodeFn(req.CodeId).Wrapf("code id %d", req.CodeId)
+	}
+	var rsp types.QueryContractSchemaResponse
+	if schema := q.keeper.GetContractSchema(ctx, req.CodeId); schema != nil {
+		rsp.Schema = *schema
+	}
+	return &rsp, nil
+}
+
+// NextClassicAddress returns the address of the next contract instantiated from the code without salt. The instance
+// sequence is shared by all codes so that the address is only valid until any other contract is instantiated.
+func (q GrpcQuerier) NextClassicAddress(c context.Context, req *types.QueryNextClassicAddressRequest) (*types.QueryNextClassicAddressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.CodeId == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalid, "code id")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	if q.keeper.GetCodeInfo(ctx, req.CodeId) == nil {
+		return nil, types.ErrNoSuchCodeFn(req.CodeId).Wrapf("code id %d", req.CodeId)
+	}
+	instanceID := q.keeper.PeekAutoIncrementID(ctx, types.KeyLastInstanceID)
+	return &types.QueryNextClassicAddressResponse{
+		Address: BuildContractAddressClassic(req.CodeId, instanceID).String(),
+	}, nil
+}
+
+func (q GrpcQuerier) ContractsMigration(c context.Context, req *types.QueryContractsMigrationRequest) (*types.QueryContractsMigrationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.MigrationId == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalid, "migration id")
+	}
+	m := q.keeper.GetContractsMigration(sdk.UnwrapSDKContext(c), req.MigrationId)
+	if m == nil {
+		return nil, types.ErrNotFound.Wrapf("migration id %d", req.MigrationId)
+	}
+	return &types.QueryContractsMigrationResponse{Migration: *m}, nil
+}
+
+func (q GrpcQuerier) ContractsMigrationResults(c context.Context, req *types.QueryContractsMigrationResultsRequest) (*types.QueryContractsMigrationResultsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.MigrationId == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalid, "migration id")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	if q.keeper.GetContractsMigration(ctx, req.MigrationId) == nil {
+		return nil, types.ErrNotFound.Wrapf("migration id %d", req.MigrationId)
+	}
+	results := make([]types.ContractMigrationResult, 0)
+	prefixStore := prefix.NewStore(ctx.KVStore(q.storeKey), types.GetContractMigrationResultPrefix(req.MigrationId))
+	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			var r types.ContractMigrationResult
+			if err := q.cdc.Unmarshal(value, &r); err != nil {
+				return false, err
+			}
+			results = append(results, r)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryContractsMigrationResultsResponse{
+		Results:    results,
+		Pagination: pageRes,
+	}, nil
+}
+
+// migrationSimulator is implemented by keepers that can simulate contract migrations
+type migrationSimulator interface {
+	SimulateMigrate(ctx sdk.Context, contractAddr, sender sdk.AccAddress, newCodeID uint64, msg []byte) (*types.QuerySimulateMigrateResponse, error)
+}
+
+// SimulateMigrate runs the migration of a contract without persisting the state. The gas is limited by the smart
+// query gas limit.
+func (q GrpcQuerier) SimulateMigrate(c context.Context, req *types.QuerySimulateMigrateRequest) (rsp *types.QuerySimulateMigrateResponse, err error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.CodeId == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalid, "code id")
+	}
+	if err := req.Msg.ValidateBasic(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid msg")
+	}
+	simulator, ok := q.keeper.(migrationSimulator)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "migrate simulation not supported")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	var sender sdk.AccAddress
+	if req.Sender != "" {
+		if sender, err = sdk.AccAddressFromBech32(req.Sender); err != nil {
+			return nil, errorsmod.Wrap(err, "sender")
+		}
+	}
+	ctx := sdk.UnwrapSDKContext(c).WithGasMeter(sdk.NewGasMeter(q.queryGasLimit))
+	// recover from out-of-gas panic
+	defer func() {
+		if r := recover(); r != nil {
+			switch rType := r.(type) {
+			case sdk.ErrorOutOfGas:
+				err = errorsmod.Wrapf(sdkerrors.ErrOutOfGas,
+					"out of gas in location: %v; gasWanted: %d, gasUsed: %d",
+					rType.Descriptor, ctx.GasMeter().Limit(), ctx.GasMeter().GasConsumed(),
+				)
+			default:
+				err = sdkerrors.ErrPanic
+			}
+			rsp = nil
+			moduleLogger(ctx).
+				Debug("simulate migrate contract",
+					"error", "recovering panic",
+					"contract-address", req.Address,
+					"stacktrace", string(debug.Stack()))
+		}
+	}()
+	return simulator.SimulateMigrate(ctx, contractAddr, sender, req.CodeId, req.Msg)
+}
+
+func (q GrpcQuerier) PendingAdminTransfer(c context.Context, req *types.QueryPendingAdminTransferRequest) (*types.QueryPendingAdminTransferResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	transfer := q.keeper.GetPendingAdminTransfer(ctx, contractAddr)
+	if transfer == nil || transfer.IsExpired(ctx.BlockHeight()) {
+		return nil, types.ErrNotFound.Wrapf("pending admin transfer for %s", req.Address)
+	}
+	return &types.QueryPendingAdminTransferResponse{Transfer: *transfer}, nil
+}
+
+func (q GrpcQuerier) PendingAdminTransfersByNewAdmin(c context.Context, req *types.QueryPendingAdminTransfersByNewAdminRequest) (*types.QueryPendingAdminTransfersByNewAdminResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	newAdmin, err := sdk.AccAddressFromBech32(req.NewAdmin)
+	if err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	transfers := make([]types.PendingAdminTransfer, 0)
+
+	prefixStore := prefix.NewStore(ctx.KVStore(q.storeKey), types.GetPendingAdminTransfersByNewAdminPrefix(newAdmin))
+	pageRes, err := query.FilteredPaginate(prefixStore, req.Pagination, func(key []byte, _ []byte, accumulate bool) (bool, error) {
+		transfer := q.keeper.GetPendingAdminTransfer(ctx, key)
+		if transfer == nil {
+			return false, types.ErrNotFound.Wrapf("pending admin transfer for %s", sdk.AccAddress(key).String())
+		}
+		if transfer.IsExpired(ctx.BlockHeight()) {
+			return false, nil
+		}
+		if accumulate {
+			transfers = append(transfers, *transfer)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPendingAdminTransfersByNewAdminResponse{
+		Transfers:  transfers,
+		Pagination: pageRes,
+	}, nil
+}
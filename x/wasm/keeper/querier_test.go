@@ -1,11 +1,14 @@
 package keeper
 
 import (
+	"bytes"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
+	"sort"
+	"strings"
 	"testing"
 	"time"
 
@@ -17,6 +20,7 @@ import (
 	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
@@ -608,6 +612,238 @@ func TestQueryContractInfo(t *testing.T) {
 	}
 }
 
+func TestQueryContractIBCState(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	chanKeeper := keepers.IBCKeeper.ChannelKeeper
+
+	ibcContractAddr := RandomAccountAddress(t)
+	portID := PortIDForContract(ibcContractAddr)
+	ibcInfo := types.ContractInfoFixture(func(info *types.ContractInfo) {
+		info.IBCPortID = portID
+	})
+	k.storeContractInfo(ctx, ibcContractAddr, &ibcInfo)
+	nonIBCContractAddr := RandomAccountAddress(t)
+	nonIBCInfo := types.ContractInfoFixture()
+	k.storeContractInfo(ctx, nonIBCContractAddr, &nonIBCInfo)
+
+	chanKeeper.SetChannel(ctx, portID, "channel-0", channeltypes.Channel{
+		State:          channeltypes.OPEN,
+		Ordering:       channeltypes.UNORDERED,
+		Counterparty:   channeltypes.NewCounterparty("counterparty-port", "channel-7"),
+		ConnectionHops: []string{"connection-0"},
+		Version:        "my-version",
+	})
+	chanKeeper.SetNextSequenceSend(ctx, portID, "channel-0", 3)
+	chanKeeper.SetNextSequenceRecv(ctx, portID, "channel-0", 5)
+	chanKeeper.SetNextSequenceAck(ctx, portID, "channel-0", 1)
+	chanKeeper.SetPacketCommitment(ctx, portID, "channel-0", 1, []byte("my-first-commitment"))
+	chanKeeper.SetPacketCommitment(ctx, portID, "channel-0", 2, []byte("my-commitment"))
+	chanKeeper.SetPacketAcknowledgement(ctx, portID, "channel-0", 4, []byte("my-ack"))
+	// channels on other ports must not be returned
+	for i, otherPort := range []string{"other-port", portID + "0"} {
+		chanKeeper.SetChannel(ctx, otherPort, fmt.Sprintf("channel-%d", i+1), channeltypes.Channel{
+			State:          channeltypes.OPEN,
+			Ordering:       channeltypes.UNORDERED,
+			Counterparty:   channeltypes.NewCounterparty("counterparty-port", "channel-8"),
+			ConnectionHops: []string{"connection-0"},
+			Version:        "my-version",
+		})
+	}
+
+	q := Querier(k)
+	specs := map[string]struct {
+		src    *types.QueryContractIBCStateRequest
+		expRsp *types.QueryContractIBCStateResponse
+		expErr bool
+	}{
+		"ibc contract": {
+			src: &types.QueryContractIBCStateRequest{Address: ibcContractAddr.String()},
+			expRsp: &types.QueryContractIBCStateResponse{
+				IBCPortID: portID,
+				Channels: []types.ContractIBCChannel{{
+					ChannelID:             "channel-0",
+					State:                 channeltypes.OPEN.String(),
+					Ordering:              channeltypes.UNORDERED.String(),
+					Version:               "my-version",
+					ConnectionHops:        []string{"connection-0"},
+					CounterpartyPortID:    "counterparty-port",
+					CounterpartyChannelID: "channel-7",
+					NextSequenceSend:      3,
+					NextSequenceRecv:      5,
+					NextSequenceAck:       1,
+					PacketCommitments: []types.PacketState{
+						{Sequence: 1, Hash: []byte("my-first-commitment")},
+						{Sequence: 2, Hash: []byte("my-commitment")},
+					},
+					PacketAcknowledgements:     []types.PacketState{{Sequence: 4, Hash: []byte("my-ack")}},
+					CommitmentsPagination:      &query.PageResponse{Total: 2},
+					AcknowledgementsPagination: &query.PageResponse{Total: 1},
+				}},
+			},
+		},
+		"with commitments pagination": {
+			src: &types.QueryContractIBCStateRequest{Address: ibcContractAddr.String(), Pagination: &query.PageRequest{Limit: 1}},
+			expRsp: &types.QueryContractIBCStateResponse{
+				IBCPortID: portID,
+				Channels: []types.ContractIBCChannel{{
+					ChannelID:                  "channel-0",
+					State:                      channeltypes.OPEN.String(),
+					Ordering:                   channeltypes.UNORDERED.String(),
+					Version:                    "my-version",
+					ConnectionHops:             []string{"connection-0"},
+					CounterpartyPortID:         "counterparty-port",
+					CounterpartyChannelID:      "channel-7",
+					NextSequenceSend:           3,
+					NextSequenceRecv:           5,
+					NextSequenceAck:            1,
+					PacketCommitments:          []types.PacketState{{Sequence: 1, Hash: []byte("my-first-commitment")}},
+					PacketAcknowledgements:     []types.PacketState{{Sequence: 4, Hash: []byte("my-ack")}},
+					CommitmentsPagination:      &query.PageResponse{NextKey: []byte("/2")},
+					AcknowledgementsPagination: &query.PageResponse{Total: 1},
+				}},
+			},
+		},
+		"non ibc contract": {
+			src: &types.QueryContractIBCStateRequest{Address: nonIBCContractAddr.String()},
+			expRsp: &types.QueryContractIBCStateResponse{
+				Channels: []types.ContractIBCChannel{},
+			},
+		},
+		"not found": {
+			src:    &types.QueryContractIBCStateRequest{Address: RandomBech32AccountAddress(t)},
+			expErr: true,
+		},
+		"invalid address": {
+			src:    &types.QueryContractIBCStateRequest{Address: "not a valid address"},
+			expErr: true,
+		},
+		"nil request": {
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotRsp, gotErr := q.ContractIBCState(sdk.WrapSDKContext(ctx), spec.src)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expRsp, gotRsp)
+		})
+	}
+}
+
+func TestQueryContractIBCStatePaging(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	chanKeeper := keepers.IBCKeeper.ChannelKeeper
+
+	contractAddr := RandomAccountAddress(t)
+	portID := PortIDForContract(contractAddr)
+	contractInfo := types.ContractInfoFixture(func(info *types.ContractInfo) {
+		info.IBCPortID = portID
+	})
+	k.storeContractInfo(ctx, contractAddr, &contractInfo)
+	// channel-0 has more packets than channel-1 so that the channels have different pages
+	packets := map[string]struct{ commitments, acks []uint64 }{
+		"channel-0": {commitments: []uint64{1, 2, 3}, acks: []uint64{1, 2}},
+		"channel-1": {commitments: []uint64{7, 8}, acks: []uint64{9}},
+	}
+	for channelID, p := range packets {
+		chanKeeper.SetChannel(ctx, portID, channelID, channeltypes.Channel{
+			State:          channeltypes.OPEN,
+			Ordering:       channeltypes.UNORDERED,
+			Counterparty:   channeltypes.NewCounterparty("counterparty-port", channelID),
+			ConnectionHops: []string{"connection-0"},
+			Version:        "my-version",
+		})
+		for _, seq := range p.commitments {
+			chanKeeper.SetPacketCommitment(ctx, portID, channelID, seq, []byte("my-commitment"))
+		}
+		for _, seq := range p.acks {
+			chanKeeper.SetPacketAcknowledgement(ctx, portID, channelID, seq, []byte("my-ack"))
+		}
+	}
+	q := Querier(k)
+
+	// when all channels are queried
+	gotRsp, err := q.ContractIBCState(sdk.WrapSDKContext(ctx), &types.QueryContractIBCStateRequest{
+		Address:                    contractAddr.String(),
+		Pagination:                 &query.PageRequest{Limit: 1},
+		AcknowledgementsPagination: &query.PageRequest{Limit: 1},
+	})
+	// then each channel has its own first page
+	require.NoError(t, err)
+	require.Len(t, gotRsp.Channels, 2)
+	for _, ch := range gotRsp.Channels {
+		exp := packets[ch.ChannelID]
+		require.Len(t, ch.PacketCommitments, 1)
+		assert.Equal(t, exp.commitments[0], ch.PacketCommitments[0].Sequence)
+		require.Len(t, ch.PacketAcknowledgements, 1)
+		assert.Equal(t, exp.acks[0], ch.PacketAcknowledgements[0].Sequence)
+		assert.NotEmpty(t, ch.CommitmentsPagination.NextKey)
+	}
+
+	// and a page key without channel is rejected
+	_, err = q.ContractIBCState(sdk.WrapSDKContext(ctx), &types.QueryContractIBCStateRequest{
+		Address:    contractAddr.String(),
+		Pagination: &query.PageRequest{Key: gotRsp.Channels[0].CommitmentsPagination.NextKey, Limit: 1},
+	})
+	require.Error(t, err)
+
+	// and an unknown channel is rejected
+	_, err = q.ContractIBCState(sdk.WrapSDKContext(ctx), &types.QueryContractIBCStateRequest{
+		Address:   contractAddr.String(),
+		ChannelID: "channel-2",
+	})
+	require.Error(t, err)
+
+	// when paging through each channel
+	for channelID, exp := range packets {
+		var gotCommitments, gotAcks []uint64
+		commitmentsPage := &query.PageRequest{Limit: 1}
+		acksPage := &query.PageRequest{Limit: 1}
+		for commitmentsPage != nil || acksPage != nil {
+			req := &types.QueryContractIBCStateRequest{
+				Address:                    contractAddr.String(),
+				ChannelID:                  channelID,
+				Pagination:                 commitmentsPage,
+				AcknowledgementsPagination: acksPage,
+			}
+			gotRsp, err := q.ContractIBCState(sdk.WrapSDKContext(ctx), req)
+			require.NoError(t, err)
+			require.Len(t, gotRsp.Channels, 1)
+			ch := gotRsp.Channels[0]
+			require.Equal(t, channelID, ch.ChannelID)
+			if commitmentsPage != nil {
+				for _, p := range ch.PacketCommitments {
+					gotCommitments = append(gotCommitments, p.Sequence)
+				}
+				commitmentsPage = nextPage(ch.CommitmentsPagination)
+			}
+			if acksPage != nil {
+				for _, p := range ch.PacketAcknowledgements {
+					gotAcks = append(gotAcks, p.Sequence)
+				}
+				acksPage = nextPage(ch.AcknowledgementsPagination)
+			}
+		}
+		// then all packets of the channel are returned
+		assert.Equal(t, exp.commitments, gotCommitments, channelID)
+		assert.Equal(t, exp.acks, gotAcks, channelID)
+	}
+}
+
+// nextPage returns the request for the page after the response or nil when there is none
+func nextPage(rsp *query.PageResponse) *query.PageRequest {
+	if rsp == nil || len(rsp.NextKey) == 0 {
+		return nil
+	}
+	return &query.PageRequest{Key: rsp.NextKey, Limit: 1}
+}
+
 func TestQueryPinnedCodes(t *testing.T) {
 	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
 	keeper := keepers.WasmKeeper
@@ -740,6 +976,7 @@ func TestQueryCodeInfo(t *testing.T) {
 					Creator:               codeInfo.Creator,
 					DataHash:              codeInfo.CodeHash,
 					InstantiatePermission: spec.accessConfig,
+					Analysis:              &hackatomCodeAnalysis,
 				},
 				Data: wasmCode,
 			}
@@ -749,6 +986,10 @@ func TestQueryCodeInfo(t *testing.T) {
 	}
 }
 
+var hackatomCodeAnalysis = types.CodeAnalysis{
+	EntryPoints: []string{"instantiate", "execute", "query", "migrate", "sudo"},
+}
+
 func TestQueryCodeInfoList(t *testing.T) {
 	wasmCode, err := os.ReadFile("./testdata/hackatom.wasm")
 	require.NoError(t, err)
@@ -800,6 +1041,7 @@ func TestQueryCodeInfoList(t *testing.T) {
 			Creator:               code.codeInfo.Creator,
 			DataHash:              code.codeInfo.CodeHash,
 			InstantiatePermission: code.codeInfo.InstantiateConfig,
+			Analysis:              &hackatomCodeAnalysis,
 		})
 	}
 	q := Querier(keeper)
@@ -924,3 +1166,157 @@ func fromBase64(s string) []byte {
 	}
 	return r
 }
+
+func TestQueryContractsByAdmin(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	example := StoreHackatomExampleContract(t, ctx, keepers)
+	initMsg := HackatomExampleInitMsg{Verifier: RandomAccountAddress(t), Beneficiary: RandomAccountAddress(t)}.GetBytes(t)
+	myAdmin, otherAdmin := RandomAccountAddress(t), RandomAccountAddress(t)
+
+	var myContractAddrs []sdk.AccAddress
+	for i := 0; i < 3; i++ {
+		contract, _, err := keepers.ContractKeeper.Instantiate(ctx, example.CodeID, example.CreatorAddr, myAdmin, initMsg, "my label", nil)
+		require.NoError(t, err)
+		myContractAddrs = append(myContractAddrs, contract)
+	}
+	// ordered by address bytes
+	sort.Slice(myContractAddrs, func(i, j int) bool { return bytes.Compare(myContractAddrs[i], myContractAddrs[j]) < 0 })
+	myContracts := make([]string, len(myContractAddrs))
+	for i, a := range myContractAddrs {
+		myContracts[i] = a.String()
+	}
+	_, _, err := keepers.ContractKeeper.Instantiate(ctx, example.CodeID, example.CreatorAddr, nil, initMsg, "my label", nil)
+	require.NoError(t, err)
+
+	queryByAdmin := func(t *testing.T, admin sdk.AccAddress, pagination *query.PageRequest) []string {
+		t.Helper()
+		rsp, err := Querier(keepers.WasmKeeper).ContractsByAdmin(sdk.WrapSDKContext(ctx), &types.QueryContractsByAdminRequest{
+			AdminAddress: admin.String(),
+			Pagination:   pagination,
+		})
+		require.NoError(t, err)
+		return rsp.ContractAddresses
+	}
+	assert.Equal(t, myContracts, queryByAdmin(t, myAdmin, nil))
+	assert.Equal(t, myContracts[1:2], queryByAdmin(t, myAdmin, &query.PageRequest{Offset: 1, Limit: 1}))
+	assert.Empty(t, queryByAdmin(t, otherAdmin, nil))
+
+	// when admin updated
+	contract := sdk.MustAccAddressFromBech32(myContracts[0])
+	require.NoError(t, keepers.ContractKeeper.UpdateContractAdmin(ctx, contract, myAdmin, otherAdmin))
+	// then
+	assert.Equal(t, myContracts[1:], queryByAdmin(t, myAdmin, nil))
+	assert.Equal(t, myContracts[:1], queryByAdmin(t, otherAdmin, nil))
+
+	// when admin cleared
+	require.NoError(t, keepers.ContractKeeper.ClearContractAdmin(ctx, contract, otherAdmin))
+	// then
+	assert.Empty(t, queryByAdmin(t, otherAdmin, nil))
+
+	// when admin is not an address
+	_, err = Querier(keepers.WasmKeeper).ContractsByAdmin(sdk.WrapSDKContext(ctx), &types.QueryContractsByAdminRequest{AdminAddress: "invalid"})
+	// then
+	require.Error(t, err)
+}
+
+func TestQueryContractsByLabelPrefix(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	example := StoreHackatomExampleContract(t, ctx, keepers)
+	initMsg := HackatomExampleInitMsg{Verifier: RandomAccountAddress(t), Beneficiary: RandomAccountAddress(t)}.GetBytes(t)
+
+	contracts := make(map[string]string)
+	for _, label := range []string{"dex pool 2", "dex pool 1", "dex", "token", "Dex pool"} {
+		contract, _, err := keepers.ContractKeeper.Instantiate(ctx, example.CodeID, example.CreatorAddr, nil, initMsg, label, nil)
+		require.NoError(t, err)
+		contracts[label] = contract.String()
+	}
+
+	specs := map[string]struct {
+		src     types.QueryContractsByLabelPrefixRequest
+		exp     []string
+		expErr  bool
+		expNext bool
+	}{
+		"prefix": {
+			src: types.QueryContractsByLabelPrefixRequest{LabelPrefix: "dex pool"},
+			exp: []string{contracts["dex pool 1"], contracts["dex pool 2"]},
+		},
+		"full label": {
+			src: types.QueryContractsByLabelPrefixRequest{LabelPrefix: "dex"},
+			exp: []string{contracts["dex"], contracts["dex pool 1"], contracts["dex pool 2"]},
+		},
+		"case sensitive": {
+			src: types.QueryContractsByLabelPrefixRequest{LabelPrefix: "Dex"},
+			exp: []string{contracts["Dex pool"]},
+		},
+		"no match": {
+			src: types.QueryContractsByLabelPrefixRequest{LabelPrefix: "nft"},
+			exp: []string{},
+		},
+		"all": {
+			src: types.QueryContractsByLabelPrefixRequest{},
+			exp: []string{contracts["Dex pool"], contracts["dex"], contracts["dex pool 1"], contracts["dex pool 2"], contracts["token"]},
+		},
+		"with pagination": {
+			src:     types.QueryContractsByLabelPrefixRequest{LabelPrefix: "dex", Pagination: &query.PageRequest{Limit: 2}},
+			exp:     []string{contracts["dex"], contracts["dex pool 1"]},
+			expNext: true,
+		},
+		"prefix too long": {
+			src:    types.QueryContractsByLabelPrefixRequest{LabelPrefix: strings.Repeat("a", types.MaxLabelSize+1)},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			rsp, gotErr := Querier(keepers.WasmKeeper).ContractsByLabelPrefix(sdk.WrapSDKContext(ctx), &spec.src)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, rsp.ContractAddresses)
+			assert.Equal(t, spec.expNext, len(rsp.Pagination.NextKey) != 0)
+		})
+	}
+}
+
+func TestQueryNextClassicAddress(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	q := Querier(keepers.WasmKeeper)
+
+	specs := map[string]struct {
+		src    uint64
+		expErr bool
+	}{
+		"known code": {
+			src: example.CodeID,
+		},
+		"unknown code": {
+			src:    99999,
+			expErr: true,
+		},
+		"empty code id": {
+			src:    0,
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			rsp, gotErr := q.NextClassicAddress(sdk.WrapSDKContext(ctx), &types.QueryNextClassicAddressRequest{CodeId: spec.src})
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+
+			// and when the next contract is instantiated
+			initMsg := HackatomExampleInitMsg{Verifier: RandomAccountAddress(t), Beneficiary: RandomAccountAddress(t)}.GetBytes(t)
+			gotAddr, _, err := keepers.ContractKeeper.Instantiate(ctx, spec.src, example.CreatorAddr, nil, initMsg, "label", nil)
+			// then the address matches
+			require.NoError(t, err)
+			assert.Equal(t, gotAddr.String(), rsp.Address)
+		})
+	}
+}
@@ -83,6 +83,15 @@ type QueryPlugins struct {
 	Staking  func(ctx sdk.Context, request *wasmvmtypes.StakingQuery) ([]byte, error)
 	Stargate func(ctx sdk.Context, request *wasmvmtypes.StargateQuery) ([]byte, error)
 	Wasm     func(ctx sdk.Context, request *wasmvmtypes.WasmQuery) ([]byte, error)
+	// StargateWithCaller is an optional stargate querier that gets the calling contract passed.
+	// It takes precedence over Stargate when set.
+	StargateWithCaller func(ctx sdk.Context, caller sdk.AccAddress, request *wasmvmtypes.StargateQuery) ([]byte, error)
+	// Authz, Feegrant, NFT and Group are opt-in queriers for module queries sent as `custom` variant.
+	// See types.ModuleQuery
+	Authz    func(ctx sdk.Context, request *types.AuthzQuery) ([]byte, error)
+	Feegrant func(ctx sdk.Context, request *types.FeegrantQuery) ([]byte, error)
+	NFT      func(ctx sdk.Context, request *types.NFTQuery) ([]byte, error)
+	Group    func(ctx sdk.Context, request *types.GroupQuery) ([]byte, error)
 }
 
 type contractMetaDataSource interface {
@@ -134,9 +143,24 @@ func (e QueryPlugins) Merge(o *QueryPlugins) QueryPlugins {
 	if o.Stargate != nil {
 		e.Stargate = o.Stargate
 	}
+	if o.StargateWithCaller != nil {
+		e.StargateWithCaller = o.StargateWithCaller
+	}
 	if o.Wasm != nil {
 		e.Wasm = o.Wasm
 	}
+	if o.Authz != nil {
+		e.Authz = o.Authz
+	}
+	if o.Feegrant != nil {
+		e.Feegrant = o.Feegrant
+	}
+	if o.NFT != nil {
+		e.NFT = o.NFT
+	}
+	if o.Group != nil {
+		e.Group = o.Group
+	}
 	return e
 }
 
@@ -147,7 +171,7 @@ func (e QueryPlugins) HandleQuery(ctx sdk.Context, caller sdk.AccAddress, reques
 		return e.Bank(ctx, request.Bank)
 	}
 	if request.Custom != nil {
-		return e.Custom(ctx, request.Custom)
+		return e.handleCustom(ctx, request.Custom)
 	}
 	if request.IBC != nil {
 		return e.IBC(ctx, caller, request.IBC)
@@ -156,6 +180,9 @@ func (e QueryPlugins) HandleQuery(ctx sdk.Context, caller sdk.AccAddress, reques
 		return e.Staking(ctx, request.Staking)
 	}
 	if request.Stargate != nil {
+		if e.StargateWithCaller != nil {
+			return e.StargateWithCaller(ctx, caller, request.Stargate)
+		}
 		return e.Stargate(ctx, request.Stargate)
 	}
 	if request.Wasm != nil {
@@ -301,8 +328,39 @@ type AcceptedStargateQueries map[string]codec.ProtoMarshaler
 // This queries can be set via WithQueryPlugins option in the wasm keeper constructor:
 // WithQueryPlugins(&QueryPlugins{Stargate: AcceptListStargateQuerier(acceptList, queryRouter, codec)})
 func AcceptListStargateQuerier(acceptList AcceptedStargateQueries, queryRouter GRPCQueryRouter, codec codec.Codec) func(ctx sdk.Context, request *wasmvmtypes.StargateQuery) ([]byte, error) {
+	q := ContractAcceptListStargateQuerier(acceptList, nil, nil, queryRouter, codec)
 	return func(ctx sdk.Context, request *wasmvmtypes.StargateQuery) ([]byte, error) {
-		protoResponse, accepted := acceptList[request.Path]
+		return q(ctx, nil, request)
+	}
+}
+
+// stargateQueryAllowlistSource is the source of the additional stargate query paths that governance allowed for a contract
+type stargateQueryAllowlistSource interface {
+	IsStargateQueryAllowed(ctx sdk.Context, contractAddr sdk.AccAddress, path string) bool
+}
+
+// ContractAcceptListStargateQuerier supports a preconfigured set of stargate queries for all contracts. In addition,
+// queries of the grantable set are supported for a calling contract when governance allowed the path for the contract
+// or its code. The caller's allowlist is checked before the global accept list.
+// The grantable queries and allowlist source are optional. All other arguments must be non nil.
+//
+// Warning: Chains need to test and maintain their accept list and grantable queries carefully.
+// There were critical consensus breaking issues in the past with non-deterministic behaviour in the SDK.
+//
+// This queries can be set via WithQueryPlugins option in the wasm keeper constructor:
+// WithQueryPlugins(&QueryPlugins{StargateWithCaller: ContractAcceptListStargateQuerier(acceptList, grantable, keeper, queryRouter, codec)})
+func ContractAcceptListStargateQuerier(
+	acceptList AcceptedStargateQueries,
+	grantable AcceptedStargateQueries,
+	allowlists stargateQueryAllowlistSource,
+	queryRouter GRPCQueryRouter,
+	codec codec.Codec,
+) func(ctx sdk.Context, caller sdk.AccAddress, request *wasmvmtypes.StargateQuery) ([]byte, error) {
+	return func(ctx sdk.Context, caller sdk.AccAddress, request *wasmvmtypes.StargateQuery) ([]byte, error) {
+		protoResponse, accepted := grantable[request.Path]
+		if !accepted || allowlists == nil || caller == nil || !allowlists.IsStargateQueryAllowed(ctx, caller, request.Path) {
+			protoResponse, accepted = acceptList[request.Path]
+		}
 		if !accepted {
 			return nil, wasmvmtypes.UnsupportedRequest{Kind: fmt.Sprintf("'%s' path is not allowed from the contract", request.Path)}
 		}
@@ -10,6 +10,7 @@ import (
 	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/CosmWasm/wasmd/x/wasm/types"
 )
@@ -27,24 +28,31 @@ func (k Keeper) OnOpenChannel(
 	msg wasmvmtypes.IBCChannelOpenMsg,
 ) (string, error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "ibc-open-channel")
-	_, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddr)
+	var version string
+	err := k.withIBCCallbackGasLimit(ctx, func(ctx sdk.Context) error {
+		_, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddr)
+		if err != nil {
+			return err
+		}
+
+		env := types.NewEnv(ctx, contractAddr)
+		querier := k.newQueryHandler(ctx, contractAddr)
+
+		gas := k.runtimeGasForContract(ctx)
+		res, gasUsed, execErr := k.wasmVM.IBCChannelOpen(codeInfo.CodeHash, env, msg, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gas, costJSONDeserialization)
+		k.consumeRuntimeGas(ctx, gasUsed)
+		if execErr != nil {
+			return errorsmod.Wrap(types.ErrExecuteFailed, execErr.Error())
+		}
+		if res != nil {
+			version = res.Version
+		}
+		return nil
+	})
 	if err != nil {
 		return "", err
 	}
-
-	env := types.NewEnv(ctx, contractAddr)
-	querier := k.newQueryHandler(ctx, contractAddr)
-
-	gas := k.runtimeGasForContract(ctx)
-	res, gasUsed, execErr := k.wasmVM.IBCChannelOpen(codeInfo.CodeHash, env, msg, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gas, costJSONDeserialization)
-	k.consumeRuntimeGas(ctx, gasUsed)
-	if execErr != nil {
-		return "", errorsmod.Wrap(types.ErrExecuteFailed, execErr.Error())
-	}
-	if res != nil {
-		return res.Version, nil
-	}
-	return "", nil
+	return version, nil
 }
 
 // OnConnectChannel calls the contract to let it know the IBC channel was established.
@@ -60,22 +68,24 @@ func (k Keeper) OnConnectChannel(
 	msg wasmvmtypes.IBCChannelConnectMsg,
 ) error {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "ibc-connect-channel")
-	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddr)
-	if err != nil {
-		return err
-	}
-
-	env := types.NewEnv(ctx, contractAddr)
-	querier := k.newQueryHandler(ctx, contractAddr)
-
-	gas := k.runtimeGasForContract(ctx)
-	res, gasUsed, execErr := k.wasmVM.IBCChannelConnect(codeInfo.CodeHash, env, msg, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gas, costJSONDeserialization)
-	k.consumeRuntimeGas(ctx, gasUsed)
-	if execErr != nil {
-		return errorsmod.Wrap(types.ErrExecuteFailed, execErr.Error())
-	}
-
-	return k.handleIBCBasicContractResponse(ctx, contractAddr, contractInfo.IBCPortID, res)
+	return k.withIBCCallbackGasLimit(ctx, func(ctx sdk.Context) error {
+		contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddr)
+		if err != nil {
+			return err
+		}
+
+		env := types.NewEnv(ctx, contractAddr)
+		querier := k.newQueryHandler(ctx, contractAddr)
+
+		gas := k.runtimeGasForContract(ctx)
+		res, gasUsed, execErr := k.wasmVM.IBCChannelConnect(codeInfo.CodeHash, env, msg, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gas, costJSONDeserialization)
+		k.consumeRuntimeGas(ctx, gasUsed)
+		if execErr != nil {
+			return errorsmod.Wrap(types.ErrExecuteFailed, execErr.Error())
+		}
+
+		return k.handleIBCBasicContractResponse(ctx, contractAddr, contractInfo.IBCPortID, res)
+	})
 }
 
 // OnCloseChannel calls the contract to let it know the IBC channel is closed.
@@ -91,22 +101,24 @@ func (k Keeper) OnCloseChannel(
 ) error {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "ibc-close-channel")
 
-	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddr)
-	if err != nil {
-		return err
-	}
+	return k.withIBCCallbackGasLimit(ctx, func(ctx sdk.Context) error {
+		contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddr)
+		if err != nil {
+			return err
+		}
 
-	params := types.NewEnv(ctx, contractAddr)
-	querier := k.newQueryHandler(ctx, contractAddr)
+		params := types.NewEnv(ctx, contractAddr)
+		querier := k.newQueryHandler(ctx, contractAddr)
 
-	gas := k.runtimeGasForContract(ctx)
-	res, gasUsed, execErr := k.wasmVM.IBCChannelClose(codeInfo.CodeHash, params, msg, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gas, costJSONDeserialization)
-	k.consumeRuntimeGas(ctx, gasUsed)
-	if execErr != nil {
-		return errorsmod.Wrap(types.ErrExecuteFailed, execErr.Error())
-	}
+		gas := k.runtimeGasForContract(ctx)
+		res, gasUsed, execErr := k.wasmVM.IBCChannelClose(codeInfo.CodeHash, params, msg, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gas, costJSONDeserialization)
+		k.consumeRuntimeGas(ctx, gasUsed)
+		if execErr != nil {
+			return errorsmod.Wrap(types.ErrExecuteFailed, execErr.Error())
+		}
 
-	return k.handleIBCBasicContractResponse(ctx, contractAddr, contractInfo.IBCPortID, res)
+		return k.handleIBCBasicContractResponse(ctx, contractAddr, contractInfo.IBCPortID, res)
+	})
 }
 
 // OnRecvPacket calls the contract to process the incoming IBC packet. The contract fully owns the data processing and
@@ -121,37 +133,47 @@ func (k Keeper) OnRecvPacket(
 	msg wasmvmtypes.IBCPacketReceiveMsg,
 ) (ibcexported.Acknowledgement, error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "ibc-recv-packet")
-	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddr)
+	var ack ibcexported.Acknowledgement
+	// running out of the callback gas results in an error ACK with state reverted
+	err := k.withIBCCallbackGasLimit(ctx, func(ctx sdk.Context) error {
+		contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddr)
+		if err != nil {
+			return err
+		}
+
+		env := types.NewEnv(ctx, contractAddr)
+		querier := k.newQueryHandler(ctx, contractAddr)
+
+		gas := k.runtimeGasForContract(ctx)
+		res, gasUsed, execErr := k.wasmVM.IBCPacketReceive(codeInfo.CodeHash, env, msg, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gas, costJSONDeserialization)
+		k.consumeRuntimeGas(ctx, gasUsed)
+		if execErr != nil {
+			panic(execErr) // let the contract fully abort an IBC packet receive.
+			// Throwing a panic here instead of an error ack will revert
+			// all state downstream and not persist any data in ibc-go.
+			// This can be triggered by throwing a panic in the contract
+		}
+		if res.Err != "" {
+			// return error ACK with non-redacted contract message, state will be reverted
+			ack = channeltypes.Acknowledgement{
+				Response: &channeltypes.Acknowledgement_Error{Error: res.Err},
+			}
+			return nil
+		}
+		// note submessage reply results can overwrite the `Acknowledgement` data
+		data, err := k.handleContractResponse(ctx, contractAddr, contractInfo.IBCPortID, res.Ok.Messages, res.Ok.Attributes, res.Ok.Acknowledgement, res.Ok.Events)
+		if err != nil {
+			// submessage errors result in error ACK with state reverted. Error message is redacted
+			return err
+		}
+		// success ACK, state will be committed
+		ack = ContractConfirmStateAck(data)
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
-
-	env := types.NewEnv(ctx, contractAddr)
-	querier := k.newQueryHandler(ctx, contractAddr)
-
-	gas := k.runtimeGasForContract(ctx)
-	res, gasUsed, execErr := k.wasmVM.IBCPacketReceive(codeInfo.CodeHash, env, msg, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gas, costJSONDeserialization)
-	k.consumeRuntimeGas(ctx, gasUsed)
-	if execErr != nil {
-		panic(execErr) // let the contract fully abort an IBC packet receive.
-		// Throwing a panic here instead of an error ack will revert
-		// all state downstream and not persist any data in ibc-go.
-		// This can be triggered by throwing a panic in the contract
-	}
-	if res.Err != "" {
-		// return error ACK with non-redacted contract message, state will be reverted
-		return channeltypes.Acknowledgement{
-			Response: &channeltypes.Acknowledgement_Error{Error: res.Err},
-		}, nil
-	}
-	// note submessage reply results can overwrite the `Acknowledgement` data
-	data, err := k.handleContractResponse(ctx, contractAddr, contractInfo.IBCPortID, res.Ok.Messages, res.Ok.Attributes, res.Ok.Acknowledgement, res.Ok.Events)
-	if err != nil {
-		// submessage errors result in error ACK with state reverted. Error message is redacted
-		return nil, err
-	}
-	// success ACK, state will be committed
-	return ContractConfirmStateAck(data), nil
+	return ack, nil
 }
 
 var _ ibcexported.Acknowledgement = ContractConfirmStateAck{}
@@ -179,21 +201,23 @@ func (k Keeper) OnAckPacket(
 	msg wasmvmtypes.IBCPacketAckMsg,
 ) error {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "ibc-ack-packet")
-	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddr)
-	if err != nil {
-		return err
-	}
-
-	env := types.NewEnv(ctx, contractAddr)
-	querier := k.newQueryHandler(ctx, contractAddr)
-
-	gas := k.runtimeGasForContract(ctx)
-	res, gasUsed, execErr := k.wasmVM.IBCPacketAck(codeInfo.CodeHash, env, msg, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gas, costJSONDeserialization)
-	k.consumeRuntimeGas(ctx, gasUsed)
-	if execErr != nil {
-		return errorsmod.Wrap(types.ErrExecuteFailed, execErr.Error())
-	}
-	return k.handleIBCBasicContractResponse(ctx, contractAddr, contractInfo.IBCPortID, res)
+	return k.withIBCCallbackGasLimit(ctx, func(ctx sdk.Context) error {
+		contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddr)
+		if err != nil {
+			return err
+		}
+
+		env := types.NewEnv(ctx, contractAddr)
+		querier := k.newQueryHandler(ctx, contractAddr)
+
+		gas := k.runtimeGasForContract(ctx)
+		res, gasUsed, execErr := k.wasmVM.IBCPacketAck(codeInfo.CodeHash, env, msg, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gas, costJSONDeserialization)
+		k.consumeRuntimeGas(ctx, gasUsed)
+		if execErr != nil {
+			return errorsmod.Wrap(types.ErrExecuteFailed, execErr.Error())
+		}
+		return k.handleIBCBasicContractResponse(ctx, contractAddr, contractInfo.IBCPortID, res)
+	})
 }
 
 // OnTimeoutPacket calls the contract to let it know the packet was never received on the destination chain within
@@ -206,22 +230,53 @@ func (k Keeper) OnTimeoutPacket(
 ) error {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "ibc-timeout-packet")
 
-	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddr)
-	if err != nil {
-		return err
-	}
+	return k.withIBCCallbackGasLimit(ctx, func(ctx sdk.Context) error {
+		contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddr)
+		if err != nil {
+			return err
+		}
 
-	env := types.NewEnv(ctx, contractAddr)
-	querier := k.newQueryHandler(ctx, contractAddr)
+		env := types.NewEnv(ctx, contractAddr)
+		querier := k.newQueryHandler(ctx, contractAddr)
 
-	gas := k.runtimeGasForContract(ctx)
-	res, gasUsed, execErr := k.wasmVM.IBCPacketTimeout(codeInfo.CodeHash, env, msg, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gas, costJSONDeserialization)
-	k.consumeRuntimeGas(ctx, gasUsed)
-	if execErr != nil {
-		return errorsmod.Wrap(types.ErrExecuteFailed, execErr.Error())
-	}
+		gas := k.runtimeGasForContract(ctx)
+		res, gasUsed, execErr := k.wasmVM.IBCPacketTimeout(codeInfo.CodeHash, env, msg, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gas, costJSONDeserialization)
+		k.consumeRuntimeGas(ctx, gasUsed)
+		if execErr != nil {
+			return errorsmod.Wrap(types.ErrExecuteFailed, execErr.Error())
+		}
 
-	return k.handleIBCBasicContractResponse(ctx, contractAddr, contractInfo.IBCPortID, res)
+		return k.handleIBCBasicContractResponse(ctx, contractAddr, contractInfo.IBCPortID, res)
+	})
+}
+
+// withIBCCallbackGasLimit executes the given contract callback with a gas meter that is capped by the
+// max ibc callback gas when set. This protects relayers from contracts that consume unbounded gas.
+// Gas spent is charged to the parent context. Running out of gas is returned as an error instead of a panic.
+func (k Keeper) withIBCCallbackGasLimit(ctx sdk.Context, cb func(ctx sdk.Context) error) (err error) {
+	gasLimit := k.maxIBCCallbackGas
+	if gasLimit == 0 || gasLimit >= ctx.GasMeter().Limit()-ctx.GasMeter().GasConsumedToLimit() {
+		return cb(ctx)
+	}
+	limitedMeter := sdk.NewGasMeter(gasLimit)
+	subCtx := ctx.WithGasMeter(limitedMeter)
+
+	// catch out of gas panic and just charge the entire gas limit
+	defer func() {
+		if r := recover(); r != nil {
+			// if it's not an OutOfGas error, raise it again
+			if _, ok := r.(sdk.ErrorOutOfGas); !ok {
+				panic(r)
+			}
+			ctx.GasMeter().ConsumeGas(gasLimit, "IBC callback OutOfGas panic")
+			err = errorsmod.Wrapf(sdkerrors.ErrOutOfGas, "ibc callback hit gas limit: %d", gasLimit)
+		}
+	}()
+	err = cb(subCtx)
+
+	// make sure we charge the parent what was spent
+	ctx.GasMeter().ConsumeGas(limitedMeter.GasConsumed(), "From limited IBC callback")
+	return err
 }
 
 func (k Keeper) handleIBCBasicContractResponse(ctx sdk.Context, addr sdk.AccAddress, id string, res *wasmvmtypes.IBCBasicResponse) error {
@@ -6,9 +6,11 @@ import (
 	"math"
 	"testing"
 
+	errorsmod "cosmossdk.io/errors"
 	wasmvm "github.com/CosmWasm/wasmvm"
 	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -80,7 +82,7 @@ func TestOnOpenChannel(t *testing.T) {
 			}
 			require.NoError(t, err)
 			// verify gas consumed
-			const storageCosts = sdk.Gas(2903)
+			const storageCosts = sdk.Gas(2915)
 			assert.Equal(t, spec.expGas, ctx.GasMeter().GasConsumed()-before-storageCosts)
 		})
 	}
@@ -124,6 +126,7 @@ func TestOnConnectChannel(t *testing.T) {
 			contractResp: &wasmvmtypes.IBCBasicResponse{
 				Messages: []wasmvmtypes.SubMsg{{ReplyOn: wasmvmtypes.ReplyNever, Msg: wasmvmtypes.CosmosMsg{Bank: &wasmvmtypes.BankMsg{}}}, {ReplyOn: wasmvmtypes.ReplyNever, Msg: wasmvmtypes.CosmosMsg{Custom: json.RawMessage(`{"foo":"bar"}`)}}},
 			},
+			expEventTypes: []string{types.EventTypeSubMsg, types.EventTypeSubMsg},
 		},
 		"emit contract events on success": {
 			contractAddr:   example.Contract,
@@ -186,7 +189,7 @@ func TestOnConnectChannel(t *testing.T) {
 			}
 			require.NoError(t, err)
 			// verify gas consumed
-			const storageCosts = sdk.Gas(2903)
+			const storageCosts = sdk.Gas(2915)
 			assert.Equal(t, spec.expContractGas, ctx.GasMeter().GasConsumed()-before-storageCosts)
 			// verify msgs dispatched
 			require.Len(t, *capturedMsgs, len(spec.contractResp.Messages))
@@ -236,6 +239,7 @@ func TestOnCloseChannel(t *testing.T) {
 			contractResp: &wasmvmtypes.IBCBasicResponse{
 				Messages: []wasmvmtypes.SubMsg{{ReplyOn: wasmvmtypes.ReplyNever, Msg: wasmvmtypes.CosmosMsg{Bank: &wasmvmtypes.BankMsg{}}}, {ReplyOn: wasmvmtypes.ReplyNever, Msg: wasmvmtypes.CosmosMsg{Custom: json.RawMessage(`{"foo":"bar"}`)}}},
 			},
+			expEventTypes: []string{types.EventTypeSubMsg, types.EventTypeSubMsg},
 		},
 		"emit contract events on success": {
 			contractAddr:   example.Contract,
@@ -296,7 +300,7 @@ func TestOnCloseChannel(t *testing.T) {
 			}
 			require.NoError(t, err)
 			// verify gas consumed
-			const storageCosts = sdk.Gas(2903)
+			const storageCosts = sdk.Gas(2915)
 			assert.Equal(t, spec.expContractGas, ctx.GasMeter().GasConsumed()-before-storageCosts)
 			// verify msgs dispatched
 			require.Len(t, *capturedMsgs, len(spec.contractResp.Messages))
@@ -315,7 +319,7 @@ func TestOnRecvPacket(t *testing.T) {
 	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities, WithMessageHandler(messenger))
 	example := SeedNewContractInstance(t, parentCtx, keepers, &m)
 	const myContractGas = 40
-	const storageCosts = sdk.Gas(2903)
+	const storageCosts = sdk.Gas(2915)
 
 	specs := map[string]struct {
 		contractAddr       sdk.AccAddress
@@ -367,7 +371,8 @@ func TestOnRecvPacket(t *testing.T) {
 					Messages:        []wasmvmtypes.SubMsg{{ReplyOn: wasmvmtypes.ReplyNever, Msg: wasmvmtypes.CosmosMsg{Bank: &wasmvmtypes.BankMsg{}}}, {ReplyOn: wasmvmtypes.ReplyNever, Msg: wasmvmtypes.CosmosMsg{Custom: json.RawMessage(`{"foo":"bar"}`)}}},
 				},
 			},
-			expAck: []byte("myAck"),
+			expAck:        []byte("myAck"),
+			expEventTypes: []string{types.EventTypeSubMsg, types.EventTypeSubMsg},
 		},
 		"emit contract attributes on success": {
 			contractAddr:   example.Contract,
@@ -427,7 +432,7 @@ func TestOnRecvPacket(t *testing.T) {
 				return &wasmvmtypes.Response{Data: []byte("myBetterAck")}, 0, nil
 			},
 			expAck:        []byte("myBetterAck"),
-			expEventTypes: []string{types.EventTypeReply},
+			expEventTypes: []string{types.EventTypeSubMsg, types.EventTypeReply},
 		},
 		"unknown contract address": {
 			contractAddr: RandomAccountAddress(t),
@@ -480,7 +485,7 @@ func TestOnRecvPacket(t *testing.T) {
 			require.Equal(t, spec.expAck, gotAck.Acknowledgement())
 
 			// verify gas consumed
-			const storageCosts = sdk.Gas(2903)
+			const storageCosts = sdk.Gas(2915)
 			assert.Equal(t, spec.expContractGas, ctx.GasMeter().GasConsumed()-before-storageCosts)
 
 			// verify msgs dispatched on success/ err response
@@ -536,6 +541,7 @@ func TestOnAckPacket(t *testing.T) {
 			contractResp: &wasmvmtypes.IBCBasicResponse{
 				Messages: []wasmvmtypes.SubMsg{{ReplyOn: wasmvmtypes.ReplyNever, Msg: wasmvmtypes.CosmosMsg{Bank: &wasmvmtypes.BankMsg{}}}, {ReplyOn: wasmvmtypes.ReplyNever, Msg: wasmvmtypes.CosmosMsg{Custom: json.RawMessage(`{"foo":"bar"}`)}}},
 			},
+			expEventTypes: []string{types.EventTypeSubMsg, types.EventTypeSubMsg},
 		},
 		"emit contract events on success": {
 			contractAddr:   example.Contract,
@@ -591,7 +597,7 @@ func TestOnAckPacket(t *testing.T) {
 			}
 			require.NoError(t, err)
 			// verify gas consumed
-			const storageCosts = sdk.Gas(2903)
+			const storageCosts = sdk.Gas(2915)
 			assert.Equal(t, spec.expContractGas, ctx.GasMeter().GasConsumed()-before-storageCosts)
 			// verify msgs dispatched
 			require.Len(t, *capturedMsgs, len(spec.contractResp.Messages))
@@ -641,6 +647,7 @@ func TestOnTimeoutPacket(t *testing.T) {
 			contractResp: &wasmvmtypes.IBCBasicResponse{
 				Messages: []wasmvmtypes.SubMsg{{ReplyOn: wasmvmtypes.ReplyNever, Msg: wasmvmtypes.CosmosMsg{Bank: &wasmvmtypes.BankMsg{}}}, {ReplyOn: wasmvmtypes.ReplyNever, Msg: wasmvmtypes.CosmosMsg{Custom: json.RawMessage(`{"foo":"bar"}`)}}},
 			},
+			expEventTypes: []string{types.EventTypeSubMsg, types.EventTypeSubMsg},
 		},
 		"emit contract attributes on success": {
 			contractAddr:   example.Contract,
@@ -711,7 +718,7 @@ func TestOnTimeoutPacket(t *testing.T) {
 			}
 			require.NoError(t, err)
 			// verify gas consumed
-			const storageCosts = sdk.Gas(2903)
+			const storageCosts = sdk.Gas(2915)
 			assert.Equal(t, spec.expContractGas, ctx.GasMeter().GasConsumed()-before-storageCosts)
 			// verify msgs dispatched
 			require.Len(t, *capturedMsgs, len(spec.contractResp.Messages))
@@ -730,3 +737,67 @@ func stripTypes(events sdk.Events) []string {
 	}
 	return r
 }
+
+func TestIBCCallbackGasLimit(t *testing.T) {
+	var m wasmtesting.MockWasmer
+	wasmtesting.MakeIBCInstantiable(&m)
+	messenger := &wasmtesting.MockMessageHandler{}
+	const maxCallbackGas = 10_000
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities, WithMessageHandler(messenger), WithMaxIBCCallbackGas(maxCallbackGas))
+	example := SeedNewContractInstance(t, parentCtx, keepers, &m)
+
+	specs := map[string]struct {
+		contractGas    sdk.Gas
+		expErr         *errorsmod.Error
+		expMaxGasSpent sdk.Gas
+	}{
+		"within limit": {
+			contractGas:    40,
+			expMaxGasSpent: maxCallbackGas,
+		},
+		"exceeds limit": {
+			contractGas:    maxCallbackGas + 1,
+			expErr:         sdkerrors.ErrOutOfGas,
+			expMaxGasSpent: maxCallbackGas,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			m.IBCPacketAckFn = func(codeID wasmvm.Checksum, env wasmvmtypes.Env, msg wasmvmtypes.IBCPacketAckMsg, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.IBCBasicResponse, uint64, error) {
+				assert.LessOrEqual(t, gasLimit, uint64(maxCallbackGas*DefaultGasMultiplier))
+				return &wasmvmtypes.IBCBasicResponse{}, spec.contractGas * DefaultGasMultiplier, nil
+			}
+			m.IBCPacketReceiveFn = func(codeID wasmvm.Checksum, env wasmvmtypes.Env, msg wasmvmtypes.IBCPacketReceiveMsg, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.IBCReceiveResult, uint64, error) {
+				return &wasmvmtypes.IBCReceiveResult{Ok: &wasmvmtypes.IBCReceiveResponse{Acknowledgement: []byte("myAck")}}, spec.contractGas * DefaultGasMultiplier, nil
+			}
+
+			t.Run("ack", func(t *testing.T) {
+				ctx, _ := parentCtx.CacheContext()
+				before := ctx.GasMeter().GasConsumed()
+				// when
+				gotErr := keepers.WasmKeeper.OnAckPacket(ctx, example.Contract, wasmvmtypes.IBCPacketAckMsg{})
+				// then
+				if spec.expErr != nil {
+					assert.ErrorIs(t, gotErr, spec.expErr)
+				} else {
+					require.NoError(t, gotErr)
+				}
+				assert.LessOrEqual(t, ctx.GasMeter().GasConsumed()-before, spec.expMaxGasSpent)
+			})
+			t.Run("recv", func(t *testing.T) {
+				ctx, _ := parentCtx.CacheContext()
+				before := ctx.GasMeter().GasConsumed()
+				// when
+				gotAck, gotErr := keepers.WasmKeeper.OnRecvPacket(ctx, example.Contract, wasmvmtypes.IBCPacketReceiveMsg{})
+				// then
+				if spec.expErr != nil {
+					assert.ErrorIs(t, gotErr, spec.expErr)
+				} else {
+					require.NoError(t, gotErr)
+					assert.Equal(t, ContractConfirmStateAck("myAck"), gotAck)
+				}
+				assert.LessOrEqual(t, ctx.GasMeter().GasConsumed()-before, spec.expMaxGasSpent)
+			})
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// SetCodeStargateQueryAllowlist stores the stargate query paths that all contracts of the given code are allowed to call.
+// An existing entry is replaced.
+func (k Keeper) SetCodeStargateQueryAllowlist(ctx sdk.Context, codeID uint64, paths []string) error {
+	if !k.containsCodeInfo(ctx, codeID) {
+		return types.ErrNoSuchCodeFn(codeID).Wrapf("code id %d", codeID)
+	}
+	if err := types.ValidateStargateQueryPaths(paths); err != nil {
+		return err
+	}
+	k.storeStargateQueryAllowlist(ctx, types.GetCodeStargateQueryAllowlistKey(codeID), paths)
+	return nil
+}
+
+// SetContractStargateQueryAllowlist stores the stargate query paths that the given contract is allowed to call.
+// An existing entry is replaced.
+func (k Keeper) SetContractStargateQueryAllowlist(ctx sdk.Context, contractAddr sdk.AccAddress, paths []string) error {
+	if !k.HasContractInfo(ctx, contractAddr) {
+		return types.ErrNoSuchContractFn(contractAddr.String()).Wrapf("address %s", contractAddr.String())
+	}
+	if err := types.ValidateStargateQueryPaths(paths); err != nil {
+		return err
+	}
+	k.storeStargateQueryAllowlist(ctx, types.GetContractStargateQueryAllowlistKey(contractAddr), paths)
+	return nil
+}
+
+// RemoveCodeStargateQueryAllowlist deletes the stargate query allowlist of the given code
+func (k Keeper) RemoveCodeStargateQueryAllowlist(ctx sdk.Context, codeID uint64) error {
+	return k.removeStargateQueryAllowlist(ctx, types.GetCodeStargateQueryAllowlistKey(codeID))
+}
+
+// RemoveContractStargateQueryAllowlist deletes the stargate query allowlist of the given contract
+func (k Keeper) RemoveContractStargateQueryAllowlist(ctx sdk.Context, contractAddr sdk.AccAddress) error {
+	return k.removeStargateQueryAllowlist(ctx, types.GetContractStargateQueryAllowlistKey(contractAddr))
+}
+
+// GetCodeStargateQueryAllowlist returns the stargate query paths that all contracts of the given code are allowed to call
+func (k Keeper) GetCodeStargateQueryAllowlist(ctx sdk.Context, codeID uint64) []string {
+	return k.loadStargateQueryAllowlist(ctx, types.GetCodeStargateQueryAllowlistKey(codeID))
+}
+
+// GetContractStargateQueryAllowlist returns the stargate query paths that the given contract is allowed to call
+func (k Keeper) GetContractStargateQueryAllowlist(ctx sdk.Context, contractAddr sdk.AccAddress) []string {
+	return k.loadStargateQueryAllowlist(ctx, types.GetContractStargateQueryAllowlistKey(contractAddr))
+}
+
+// IsStargateQueryAllowed returns true when the path is in the allowlist of the contract or of the contract's code.
+// The contract entry is checked first.
+func (k Keeper) IsStargateQueryAllowed(ctx sdk.Context, contractAddr sdk.AccAddress, path string) bool {
+	if containsPath(k.GetContractStargateQueryAllowlist(ctx, contractAddr), path) {
+		return true
+	}
+	contractInfo := k.GetContractInfo(ctx, contractAddr)
+	if contractInfo == nil {
+		return false
+	}
+	return containsPath(k.GetCodeStargateQueryAllowlist(ctx, contractInfo.CodeID), path)
+}
+
+func (k Keeper) storeStargateQueryAllowlist(ctx sdk.Context, key []byte, paths []string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(key, k.cdc.MustMarshal(&types.StargateQueryAllowlist{Paths: paths}))
+}
+
+func (k Keeper) loadStargateQueryAllowlist(ctx sdk.Context, key []byte) []string {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(key)
+	if bz == nil {
+		return nil
+	}
+	var allowlist types.StargateQueryAllowlist
+	k.cdc.MustUnmarshal(bz, &allowlist)
+	return allowlist.Paths
+}
+
+func (k Keeper) removeStargateQueryAllowlist(ctx sdk.Context, key []byte) error {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(key) {
+		return errorsmod.Wrap(types.ErrNotFound, "stargate query allowlist")
+	}
+	store.Delete(key)
+	return nil
+}
+
+func containsPath(paths []string, path string) bool {
+	for _, p := range paths {
+		if p == path {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,167 @@
+package keeper
+
+import (
+	"testing"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestStargateQueryAllowlistMsgs(t *testing.T) {
+	const (
+		myPath    = "/cosmos.bank.v1beta1.Query/Balance"
+		otherPath = "/cosmos.bank.v1beta1.Query/AllBalances"
+	)
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	msgServer := NewMsgServerImpl(k)
+	authority := k.GetAuthority()
+
+	// when code allowlist set
+	_, err := msgServer.SetStargateQueryAllowlist(sdk.WrapSDKContext(ctx), &types.MsgSetStargateQueryAllowlist{
+		Authority: authority,
+		CodeID:    example.CodeID,
+		Paths:     []string{myPath},
+	})
+	require.NoError(t, err)
+	// then
+	assert.Equal(t, []string{myPath}, k.GetCodeStargateQueryAllowlist(ctx, example.CodeID))
+	assert.True(t, k.IsStargateQueryAllowed(ctx, example.Contract, myPath))
+	assert.False(t, k.IsStargateQueryAllowed(ctx, example.Contract, otherPath))
+	assert.False(t, k.IsStargateQueryAllowed(ctx, RandomAccountAddress(t), myPath))
+
+	// when contract allowlist set
+	_, err = msgServer.SetStargateQueryAllowlist(sdk.WrapSDKContext(ctx), &types.MsgSetStargateQueryAllowlist{
+		Authority: authority,
+		Contract:  example.Contract.String(),
+		Paths:     []string{otherPath},
+	})
+	require.NoError(t, err)
+	// then both entries apply
+	assert.Equal(t, []string{otherPath}, k.GetContractStargateQueryAllowlist(ctx, example.Contract))
+	assert.True(t, k.IsStargateQueryAllowed(ctx, example.Contract, myPath))
+	assert.True(t, k.IsStargateQueryAllowed(ctx, example.Contract, otherPath))
+
+	// when code allowlist removed
+	_, err = msgServer.RemoveStargateQueryAllowlist(sdk.WrapSDKContext(ctx), &types.MsgRemoveStargateQueryAllowlist{
+		Authority: authority,
+		CodeID:    example.CodeID,
+	})
+	require.NoError(t, err)
+	// then
+	assert.Empty(t, k.GetCodeStargateQueryAllowlist(ctx, example.CodeID))
+	assert.False(t, k.IsStargateQueryAllowed(ctx, example.Contract, myPath))
+	assert.True(t, k.IsStargateQueryAllowed(ctx, example.Contract, otherPath))
+
+	// and removing again fails
+	_, err = msgServer.RemoveStargateQueryAllowlist(sdk.WrapSDKContext(ctx), &types.MsgRemoveStargateQueryAllowlist{
+		Authority: authority,
+		CodeID:    example.CodeID,
+	})
+	assert.ErrorIs(t, err, types.ErrNotFound)
+
+	// and non gov authority rejected
+	_, err = msgServer.SetStargateQueryAllowlist(sdk.WrapSDKContext(ctx), &types.MsgSetStargateQueryAllowlist{
+		Authority: example.CreatorAddr.String(),
+		CodeID:    example.CodeID,
+		Paths:     []string{myPath},
+	})
+	assert.ErrorIs(t, err, types.ErrInvalid)
+
+	// and unknown code rejected
+	_, err = msgServer.SetStargateQueryAllowlist(sdk.WrapSDKContext(ctx), &types.MsgSetStargateQueryAllowlist{
+		Authority: authority,
+		CodeID:    99999,
+		Paths:     []string{myPath},
+	})
+	assert.ErrorIs(t, err, types.ErrNoSuchCodeFn(99999))
+
+	// and unknown contract rejected
+	_, err = msgServer.SetStargateQueryAllowlist(sdk.WrapSDKContext(ctx), &types.MsgSetStargateQueryAllowlist{
+		Authority: authority,
+		Contract:  RandomBech32AccountAddress(t),
+		Paths:     []string{myPath},
+	})
+	assert.ErrorIs(t, err, types.ErrNoSuchContractFn(""))
+}
+
+func TestContractAcceptListStargateQuerier(t *testing.T) {
+	const (
+		globalPath    = "/cosmos.bank.v1beta1.Query/AllBalances"
+		grantablePath = "/cosmos.bank.v1beta1.Query/Balance"
+	)
+	myCaller, otherCaller := RandomAccountAddress(t), RandomAccountAddress(t)
+	allowlists := stargateQueryAllowlistSourceFn(func(_ sdk.Context, contractAddr sdk.AccAddress, path string) bool {
+		return contractAddr.Equals(myCaller) && path == grantablePath
+	})
+	router := grpcQueryRouterFn(func(path string) baseapp.GRPCQueryHandler {
+		return func(_ sdk.Context, _ abci.RequestQuery) (abci.ResponseQuery, error) {
+			bz, err := (&banktypes.QueryBalanceResponse{}).Marshal()
+			return abci.ResponseQuery{Value: bz}, err
+		}
+	})
+	acceptList := AcceptedStargateQueries{globalPath: &banktypes.QueryAllBalancesResponse{}}
+	grantable := AcceptedStargateQueries{grantablePath: &banktypes.QueryBalanceResponse{}}
+	q := ContractAcceptListStargateQuerier(acceptList, grantable, allowlists, router, MakeEncodingConfig(t).Marshaler)
+
+	specs := map[string]struct {
+		caller sdk.AccAddress
+		path   string
+		expErr bool
+	}{
+		"global path": {
+			caller: otherCaller,
+			path:   globalPath,
+		},
+		"granted path": {
+			caller: myCaller,
+			path:   grantablePath,
+		},
+		"grantable path not granted to caller": {
+			caller: otherCaller,
+			path:   grantablePath,
+			expErr: true,
+		},
+		"no caller": {
+			path:   grantablePath,
+			expErr: true,
+		},
+		"unknown path": {
+			caller: myCaller,
+			path:   "/cosmos.bank.v1beta1.Query/TotalSupply",
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx := sdk.Context{}
+			_, gotErr := q(ctx, spec.caller, &wasmvmtypes.StargateQuery{Path: spec.path})
+			if spec.expErr {
+				require.Error(t, gotErr)
+				assert.IsType(t, wasmvmtypes.UnsupportedRequest{}, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+}
+
+type stargateQueryAllowlistSourceFn func(ctx sdk.Context, contractAddr sdk.AccAddress, path string) bool
+
+func (f stargateQueryAllowlistSourceFn) IsStargateQueryAllowed(ctx sdk.Context, contractAddr sdk.AccAddress, path string) bool {
+	return f(ctx, contractAddr, path)
+}
+
+type grpcQueryRouterFn func(path string) baseapp.GRPCQueryHandler
+
+func (f grpcQueryRouterFn) Route(path string) baseapp.GRPCQueryHandler {
+	return f(path)
+}
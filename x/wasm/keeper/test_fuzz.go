@@ -10,7 +10,7 @@ import (
 	"github.com/CosmWasm/wasmd/x/wasm/types"
 )
 
-var ModelFuzzers = []interface{}{FuzzAddr, FuzzAddrString, FuzzAbsoluteTxPosition, FuzzContractInfo, FuzzStateModel, FuzzAccessType, FuzzAccessConfig, FuzzContractCodeHistory}
+var ModelFuzzers = []interface{}{FuzzAddr, FuzzAddrString, FuzzAbsoluteTxPosition, FuzzContractInfo, FuzzStateModel, FuzzAccessType, FuzzAccessConfig, FuzzContractCodeHistory, FuzzAddressGeneratorConfig}
 
 func FuzzAddr(m *sdk.AccAddress, c fuzz.Continue) {
 	*m = make([]byte, 20)
@@ -76,3 +76,12 @@ func FuzzAccessConfig(m *types.AccessConfig, c fuzz.Continue) {
 	FuzzAddr(&add, c)
 	*m = m.Permission.With(add)
 }
+
+func FuzzAddressGeneratorConfig(m *types.AddressGeneratorConfig, c fuzz.Continue) {
+	if c.RandBool() {
+		*m = types.AddressGeneratorConfig{Strategy: types.AddressGeneratorPredictable}
+		return
+	}
+	m.Strategy = types.AddressGeneratorDomainSeparated
+	FuzzAddrString(&m.Domain, c)
+}
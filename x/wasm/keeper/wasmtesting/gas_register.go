@@ -15,6 +15,8 @@ type MockGasRegister struct {
 	ToWasmVMGasFn             func(source sdk.Gas) uint64
 	FromWasmVMGasFn           func(source uint64) sdk.Gas
 	UncompressCostsFn         func(byteLength int) sdk.Gas
+	SchemaValidationCostsFn   func(steps int) sdk.Gas
+	SchemaCompileCostsFn      func(schemaLen int) sdk.Gas
 }
 
 func (m MockGasRegister) NewContractInstanceCosts(pinned bool, msgLen int) sdk.Gas {
@@ -59,6 +61,20 @@ func (m MockGasRegister) EventCosts(evts []wasmvmtypes.EventAttribute, _ wasmvmt
 	return m.EventCostsFn(evts)
 }
 
+func (m MockGasRegister) SchemaValidationCosts(steps int) sdk.Gas {
+	if m.SchemaValidationCostsFn == nil {
+		panic("not expected to be called")
+	}
+	return m.SchemaValidationCostsFn(steps)
+}
+
+func (m MockGasRegister) SchemaCompileCosts(schemaLen int) sdk.Gas {
+	if m.SchemaCompileCostsFn == nil {
+		panic("not expected to be called")
+	}
+	return m.SchemaCompileCostsFn(schemaLen)
+}
+
 func (m MockGasRegister) ToWasmVMGas(source sdk.Gas) uint64 {
 	if m.ToWasmVMGasFn == nil {
 		panic("not expected to be called")
@@ -1,8 +1,11 @@
 package wasmtesting
 
 import (
+	"context"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	ibcfeetypes "github.com/cosmos/ibc-go/v7/modules/apps/29-fee/types"
 	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 
@@ -10,12 +13,17 @@ import (
 )
 
 type MockChannelKeeper struct {
-	GetChannelFn          func(ctx sdk.Context, srcPort, srcChan string) (channel channeltypes.Channel, found bool)
-	GetNextSequenceSendFn func(ctx sdk.Context, portID, channelID string) (uint64, bool)
-	ChanCloseInitFn       func(ctx sdk.Context, portID, channelID string, chanCap *capabilitytypes.Capability) error
-	GetAllChannelsFn      func(ctx sdk.Context) []channeltypes.IdentifiedChannel
-	IterateChannelsFn     func(ctx sdk.Context, cb func(channeltypes.IdentifiedChannel) bool)
-	SetChannelFn          func(ctx sdk.Context, portID, channelID string, channel channeltypes.Channel)
+	GetChannelFn                   func(ctx sdk.Context, srcPort, srcChan string) (channel channeltypes.Channel, found bool)
+	GetNextSequenceSendFn          func(ctx sdk.Context, portID, channelID string) (uint64, bool)
+	GetNextSequenceRecvFn          func(ctx sdk.Context, portID, channelID string) (uint64, bool)
+	GetNextSequenceAckFn           func(ctx sdk.Context, portID, channelID string) (uint64, bool)
+	PacketCommitmentsFn            func(c context.Context, req *channeltypes.QueryPacketCommitmentsRequest) (*channeltypes.QueryPacketCommitmentsResponse, error)
+	PacketAcknowledgementsFn       func(c context.Context, req *channeltypes.QueryPacketAcknowledgementsRequest) (*channeltypes.QueryPacketAcknowledgementsResponse, error)
+	ChanCloseInitFn                func(ctx sdk.Context, portID, channelID string, chanCap *capabilitytypes.Capability) error
+	GetAllChannelsFn               func(ctx sdk.Context) []channeltypes.IdentifiedChannel
+	GetAllChannelsWithPortPrefixFn func(ctx sdk.Context, portPrefix string) []channeltypes.IdentifiedChannel
+	IterateChannelsFn              func(ctx sdk.Context, cb func(channeltypes.IdentifiedChannel) bool)
+	SetChannelFn                   func(ctx sdk.Context, portID, channelID string, channel channeltypes.Channel)
 }
 
 func (m *MockChannelKeeper) GetChannel(ctx sdk.Context, srcPort, srcChan string) (channel channeltypes.Channel, found bool) {
@@ -32,6 +40,13 @@ func (m *MockChannelKeeper) GetAllChannels(ctx sdk.Context) []channeltypes.Ident
 	return m.GetAllChannelsFn(ctx)
 }
 
+func (m *MockChannelKeeper) GetAllChannelsWithPortPrefix(ctx sdk.Context, portPrefix string) []channeltypes.IdentifiedChannel {
+	if m.GetAllChannelsWithPortPrefixFn == nil {
+		panic("not supposed to be called!")
+	}
+	return m.GetAllChannelsWithPortPrefixFn(ctx, portPrefix)
+}
+
 func (m *MockChannelKeeper) GetNextSequenceSend(ctx sdk.Context, portID, channelID string) (uint64, bool) {
 	if m.GetNextSequenceSendFn == nil {
 		panic("not supposed to be called!")
@@ -39,6 +54,34 @@ func (m *MockChannelKeeper) GetNextSequenceSend(ctx sdk.Context, portID, channel
 	return m.GetNextSequenceSendFn(ctx, portID, channelID)
 }
 
+func (m *MockChannelKeeper) GetNextSequenceRecv(ctx sdk.Context, portID, channelID string) (uint64, bool) {
+	if m.GetNextSequenceRecvFn == nil {
+		panic("not supposed to be called!")
+	}
+	return m.GetNextSequenceRecvFn(ctx, portID, channelID)
+}
+
+func (m *MockChannelKeeper) GetNextSequenceAck(ctx sdk.Context, portID, channelID string) (uint64, bool) {
+	if m.GetNextSequenceAckFn == nil {
+		panic("not supposed to be called!")
+	}
+	return m.GetNextSequenceAckFn(ctx, portID, channelID)
+}
+
+func (m *MockChannelKeeper) PacketCommitments(c context.Context, req *channeltypes.QueryPacketCommitmentsRequest) (*channeltypes.QueryPacketCommitmentsResponse, error) {
+	if m.PacketCommitmentsFn == nil {
+		panic("not supposed to be called!")
+	}
+	return m.PacketCommitmentsFn(c, req)
+}
+
+func (m *MockChannelKeeper) PacketAcknowledgements(c context.Context, req *channeltypes.QueryPacketAcknowledgementsRequest) (*channeltypes.QueryPacketAcknowledgementsResponse, error) {
+	if m.PacketAcknowledgementsFn == nil {
+		panic("not supposed to be called!")
+	}
+	return m.PacketAcknowledgementsFn(c, req)
+}
+
 func (m *MockChannelKeeper) ChanCloseInit(ctx sdk.Context, portID, channelID string, chanCap *capabilitytypes.Capability) error {
 	if m.ChanCloseInitFn == nil {
 		panic("not supposed to be called!")
@@ -121,3 +164,16 @@ func (m MockIBCTransferKeeper) GetPort(ctx sdk.Context) string {
 	}
 	return m.GetPortFn(ctx)
 }
+
+var _ types.ICS29FeeKeeper = &MockICS29FeeKeeper{}
+
+type MockICS29FeeKeeper struct {
+	PayPacketFeeFn func(goCtx context.Context, msg *ibcfeetypes.MsgPayPacketFee) (*ibcfeetypes.MsgPayPacketFeeResponse, error)
+}
+
+func (m MockICS29FeeKeeper) PayPacketFee(goCtx context.Context, msg *ibcfeetypes.MsgPayPacketFee) (*ibcfeetypes.MsgPayPacketFeeResponse, error) {
+	if m.PayPacketFeeFn == nil {
+		panic("not expected to be called")
+	}
+	return m.PayPacketFeeFn(goCtx, msg)
+}
@@ -0,0 +1,55 @@
+package v3
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// CodeAnalysisFn returns the static analysis report of the code
+type CodeAnalysisFn func(codeInfo types.CodeInfo) (*types.CodeAnalysis, error)
+
+// StoreCodeInfoFn persists the code info
+type StoreCodeInfoFn func(ctx sdk.Context, codeID uint64, codeInfo types.CodeInfo)
+
+// Keeper abstract keeper
+type wasmKeeper interface {
+	IterateCodeInfos(ctx sdk.Context, cb func(uint64, types.CodeInfo) bool)
+}
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper          wasmKeeper
+	codeAnalysisFn  CodeAnalysisFn
+	storeCodeInfoFn StoreCodeInfoFn
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(k wasmKeeper, analysisFn CodeAnalysisFn, storeFn StoreCodeInfoFn) Migrator {
+	return Migrator{keeper: k, codeAnalysisFn: analysisFn, storeCodeInfoFn: storeFn}
+}
+
+// Migrate3to4 migrates from version 3 to 4. The analysis report is persisted in the code info of all stored codes.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	var (
+		codeIDs   []uint64
+		codeInfos []types.CodeInfo
+	)
+	m.keeper.IterateCodeInfos(ctx, func(codeID uint64, codeInfo types.CodeInfo) bool {
+		if codeInfo.Analysis == nil {
+			codeIDs = append(codeIDs, codeID)
+			codeInfos = append(codeInfos, codeInfo)
+		}
+		return false
+	})
+	for i, codeInfo := range codeInfos {
+		analysis, err := m.codeAnalysisFn(codeInfo)
+		if err != nil {
+			return errorsmod.Wrapf(err, "code id: %d", codeIDs[i])
+		}
+		codeInfo.Analysis = analysis
+		m.storeCodeInfoFn(ctx, codeIDs[i], codeInfo)
+	}
+	return nil
+}
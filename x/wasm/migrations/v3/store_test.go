@@ -0,0 +1,47 @@
+package v3_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper"
+	"github.com/CosmWasm/wasmd/x/wasm/keeper/testdata"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestMigrate3To4(t *testing.T) {
+	const AvailableCapabilities = "iterator,staking,stargate,cosmwasm_1_1"
+	ctx, keepers := keeper.CreateTestInput(t, false, AvailableCapabilities)
+	wasmKeeper := keepers.WasmKeeper
+
+	creator := keepers.Faucet.NewFundedRandomAccount(ctx, sdk.NewInt64Coin("denom", 100000))
+	hackatomCodeID, _, err := keepers.ContractKeeper.Create(ctx, creator, testdata.HackatomContractWasm(), nil)
+	require.NoError(t, err)
+	ibcReflectCodeID, _, err := keepers.ContractKeeper.Create(ctx, creator, testdata.IBCReflectContractWasm(), nil)
+	require.NoError(t, err)
+
+	// remove analysis as stored before the migration
+	expAnalysis := make(map[uint64]types.CodeAnalysis)
+	for _, codeID := range []uint64{hackatomCodeID, ibcReflectCodeID} {
+		codeInfo := wasmKeeper.GetCodeInfo(ctx, codeID)
+		require.NotNil(t, codeInfo.Analysis)
+		expAnalysis[codeID] = *codeInfo.Analysis
+		codeInfo.Analysis = nil
+		ctx.KVStore(keepers.WasmStoreKey).Set(types.GetCodeKey(codeID), keepers.EncodingConfig.Marshaler.MustMarshal(codeInfo))
+		require.Nil(t, wasmKeeper.GetCodeInfo(ctx, codeID).Analysis)
+	}
+
+	// when
+	err = keeper.NewMigrator(*wasmKeeper, nil).Migrate3to4(ctx)
+	require.NoError(t, err)
+
+	// then
+	for codeID, exp := range expAnalysis {
+		codeInfo := wasmKeeper.GetCodeInfo(ctx, codeID)
+		require.NotNil(t, codeInfo.Analysis)
+		assert.Equal(t, exp, *codeInfo.Analysis)
+	}
+}
@@ -0,0 +1,42 @@
+package v4
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// AddToAdminIndexFn creates a secondary index entry for the admin of the contract
+type AddToAdminIndexFn func(ctx sdk.Context, adminAddress, contractAddress sdk.AccAddress)
+
+// AddToLabelIndexFn creates a secondary index entry for the label of the contract
+type AddToLabelIndexFn func(ctx sdk.Context, label string, contractAddress sdk.AccAddress)
+
+// Keeper abstract keeper
+type wasmKeeper interface {
+	IterateContractInfo(ctx sdk.Context, cb func(sdk.AccAddress, types.ContractInfo) bool)
+}
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper            wasmKeeper
+	addToAdminIndexFn AddToAdminIndexFn
+	addToLabelIndexFn AddToLabelIndexFn
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(k wasmKeeper, adminFn AddToAdminIndexFn, labelFn AddToLabelIndexFn) Migrator {
+	return Migrator{keeper: k, addToAdminIndexFn: adminFn, addToLabelIndexFn: labelFn}
+}
+
+// Migrate4to5 migrates from version 4 to 5. The admin and label indexes are backfilled for all contracts.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	m.keeper.IterateContractInfo(ctx, func(contractAddr sdk.AccAddress, contractInfo types.ContractInfo) bool {
+		if admin := contractInfo.AdminAddr(); admin != nil {
+			m.addToAdminIndexFn(ctx, admin, contractAddr)
+		}
+		m.addToLabelIndexFn(ctx, contractInfo.Label, contractAddr)
+		return false
+	})
+	return nil
+}
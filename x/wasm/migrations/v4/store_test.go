@@ -0,0 +1,62 @@
+package v4_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper"
+	"github.com/CosmWasm/wasmd/x/wasm/keeper/testdata"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestMigrate4To5(t *testing.T) {
+	const AvailableCapabilities = "iterator,staking,stargate,cosmwasm_1_1"
+	ctx, keepers := keeper.CreateTestInput(t, false, AvailableCapabilities)
+	wasmKeeper := keepers.WasmKeeper
+
+	creator := keepers.Faucet.NewFundedRandomAccount(ctx, sdk.NewInt64Coin("denom", 100000))
+	admin := keeper.RandomAccountAddress(t)
+	codeID, _, err := keepers.ContractKeeper.Create(ctx, creator, testdata.ReflectContractWasm(), nil)
+	require.NoError(t, err)
+	withAdmin, _, err := keepers.ContractKeeper.Instantiate(ctx, codeID, creator, admin, []byte("{}"), "my contract", nil)
+	require.NoError(t, err)
+	withoutAdmin, _, err := keepers.ContractKeeper.Instantiate(ctx, codeID, creator, nil, []byte("{}"), "other contract", nil)
+	require.NoError(t, err)
+
+	// remove index entries as stored before the migration
+	store := ctx.KVStore(keepers.WasmStoreKey)
+	for _, prefix := range [][]byte{types.ContractsByAdminPrefix, types.ContractsByLabelPrefix} {
+		iter := sdk.KVStorePrefixIterator(store, prefix)
+		var keys [][]byte
+		for ; iter.Valid(); iter.Next() {
+			keys = append(keys, iter.Key())
+		}
+		require.NoError(t, iter.Close())
+		require.NotEmpty(t, keys)
+		for _, k := range keys {
+			store.Delete(k)
+		}
+	}
+
+	// when
+	err = keeper.NewMigrator(*wasmKeeper, nil).Migrate4to5(ctx)
+	require.NoError(t, err)
+
+	// then
+	var gotByAdmin []sdk.AccAddress
+	wasmKeeper.IterateContractsByAdmin(ctx, admin, func(address sdk.AccAddress) bool {
+		gotByAdmin = append(gotByAdmin, address)
+		return false
+	})
+	assert.Equal(t, []sdk.AccAddress{withAdmin}, gotByAdmin)
+
+	var gotByLabel []sdk.AccAddress
+	wasmKeeper.IterateContractsByLabelPrefix(ctx, "", func(address sdk.AccAddress) bool {
+		gotByLabel = append(gotByLabel, address)
+		return false
+	})
+	assert.Equal(t, []sdk.AccAddress{withAdmin, withoutAdmin}, gotByLabel)
+}
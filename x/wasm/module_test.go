@@ -308,8 +308,8 @@ func TestHandleExecute(t *testing.T) {
 	// from https://github.com/CosmWasm/cosmwasm/blob/master/contracts/hackatom/src/contract.rs#L167
 	assertExecuteResponse(t, res.Data, []byte{0xf0, 0x0b, 0xaa})
 
-	// this should be standard message event, plus x/wasm init event, plus 2 bank send event, plus a special event from the contract
-	require.Equal(t, 9, len(res.Events), prettyEvents(res.Events))
+	// this should be standard message event, plus x/wasm init event, plus 2 bank send event, plus a special event from the contract, plus the submessage event
+	require.Equal(t, 10, len(res.Events), prettyEvents(res.Events))
 
 	assert.Equal(t, "coin_spent", res.Events[0].Type)
 	assert.Equal(t, "coin_received", res.Events[1].Type)
@@ -338,7 +338,11 @@ func TestHandleExecute(t *testing.T) {
 	assertAttribute(t, "recipient", bob.String(), res.Events[8].Attributes[0])
 	assertAttribute(t, "sender", contractBech32Addr, res.Events[8].Attributes[1])
 	assertAttribute(t, "amount", "105000denom", res.Events[8].Attributes[2])
-	// finally, standard x/wasm tag
+	// and the gas used by the submessage
+	assert.Equal(t, "submsg", res.Events[9].Type)
+	assertAttribute(t, "_contract_address", contractBech32Addr, res.Events[9].Attributes[0])
+	assertAttribute(t, "submsg_id", "0", res.Events[9].Attributes[1])
+	assert.Equal(t, "submsg_gas_used", res.Events[9].Attributes[2].Key)
 
 	// ensure bob now exists and got both payments released
 	bobAcct = data.acctKeeper.GetAccount(data.ctx, bob)
@@ -9,6 +9,7 @@ type contextKey int
 const (
 	// private type creates an interface key for Context that cannot be accessed by any other package
 	contextKeyTXCount contextKey = iota
+	contextKeyContractInfoSource
 )
 
 // WithTXCounter stores a transaction counter value in the context
@@ -22,3 +23,20 @@ func TXCounter(ctx sdk.Context) (uint32, bool) {
 	val, ok := ctx.Value(contextKeyTXCount).(uint32)
 	return val, ok
 }
+
+// ContractInfoSource provides the contract info to resolve authz grants for code ids or a creator
+type ContractInfoSource interface {
+	GetContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) *ContractInfo
+}
+
+// WithContractInfoSource stores the contract info source in the context
+func WithContractInfoSource(ctx sdk.Context, source ContractInfoSource) sdk.Context {
+	return ctx.WithValue(contextKeyContractInfoSource, source)
+}
+
+// ContractInfoSourceFromContext returns the contract info source and found bool from the context.
+// The result will be (nil, false) when the ante handler has not set a source.
+func ContractInfoSourceFromContext(ctx sdk.Context) (ContractInfoSource, bool) {
+	val, ok := ctx.Value(contextKeyContractInfoSource).(ContractInfoSource)
+	return val, ok
+}
@@ -1,7 +1,16 @@
 package types
 
 import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/json"
+	"io"
+	"reflect"
+	"regexp"
+	"regexp/syntax"
 	"strings"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -11,13 +20,29 @@ import (
 	"github.com/cosmos/gogoproto/proto"
 )
 
-const gasDeserializationCostPerByte = uint64(1)
+const (
+	gasDeserializationCostPerByte = uint64(1)
+	// gasRegexCompileCostPerByte is charged for every byte of a regular expression that is compiled on accept
+	gasRegexCompileCostPerByte = uint64(10)
+)
 
 var (
 	_ authztypes.Authorization         = &ContractExecutionAuthorization{}
 	_ authztypes.Authorization         = &ContractMigrationAuthorization{}
+	_ authztypes.Authorization         = &ContractInstantiationAuthorization{}
+	_ authztypes.Authorization         = &ContractInstantiation2Authorization{}
+	_ authztypes.Authorization         = &StoreCodeAuthorization{}
+	_ authztypes.Authorization         = &ContractUpdateAdminAuthorization{}
+	_ authztypes.Authorization         = &ContractClearAdminAuthorization{}
+	_ authztypes.Authorization         = &ExecuteContractsAuthorization{}
 	_ cdctypes.UnpackInterfacesMessage = &ContractExecutionAuthorization{}
 	_ cdctypes.UnpackInterfacesMessage = &ContractMigrationAuthorization{}
+	_ cdctypes.UnpackInterfacesMessage = &ContractInstantiationAuthorization{}
+	_ cdctypes.UnpackInterfacesMessage = &ContractInstantiation2Authorization{}
+	_ cdctypes.UnpackInterfacesMessage = &StoreCodeAuthorization{}
+	_ cdctypes.UnpackInterfacesMessage = &ContractUpdateAdminAuthorization{}
+	_ cdctypes.UnpackInterfacesMessage = &ContractClearAdminAuthorization{}
+	_ cdctypes.UnpackInterfacesMessage = &ExecuteContractsAuthorization{}
 )
 
 // AuthzableWasmMsg is abstract wasm tx message that is supported in authz
@@ -116,6 +141,385 @@ func validateGrants(g []ContractGrant) error {
 	return nil
 }
 
+// NewContractInstantiationAuthorization constructor
+func NewContractInstantiationAuthorization(grants ...InstantiateGrant) *ContractInstantiationAuthorization {
+	return &ContractInstantiationAuthorization{
+		Grants: grants,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a ContractInstantiationAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgInstantiateContract{})
+}
+
+// Accept implements Authorization.Accept.
+func (a *ContractInstantiationAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authztypes.AcceptResponse, error) {
+	inst, ok := msg.(*MsgInstantiateContract)
+	if !ok {
+		return authztypes.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+	if err := inst.ValidateBasic(); err != nil {
+		return authztypes.AcceptResponse{}, err
+	}
+	return acceptInstantiateGrants(ctx, a.Grants, inst.Sender, inst.Admin, inst.CodeID, inst.Label, inst.Msg, inst.Funds, func(g []InstantiateGrant) authztypes.Authorization {
+		return NewContractInstantiationAuthorization(g...)
+	})
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a ContractInstantiationAuthorization) ValidateBasic() error {
+	return validateInstantiateGrants(a.Grants)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a ContractInstantiationAuthorization) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	for _, g := range a.Grants {
+		if err := g.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewContractInstantiation2Authorization constructor
+func NewContractInstantiation2Authorization(grants ...InstantiateGrant) *ContractInstantiation2Authorization {
+	return &ContractInstantiation2Authorization{
+		Grants: grants,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a ContractInstantiation2Authorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgInstantiateContract2{})
+}
+
+// Accept implements Authorization.Accept.
+func (a *ContractInstantiation2Authorization) Accept(ctx sdk.Context, msg sdk.Msg) (authztypes.AcceptResponse, error) {
+	inst, ok := msg.(*MsgInstantiateContract2)
+	if !ok {
+		return authztypes.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+	if err := inst.ValidateBasic(); err != nil {
+		return authztypes.AcceptResponse{}, err
+	}
+	return acceptInstantiateGrants(ctx, a.Grants, inst.Sender, inst.Admin, inst.CodeID, inst.Label, inst.Msg, inst.Funds, func(g []InstantiateGrant) authztypes.Authorization {
+		return NewContractInstantiation2Authorization(g...)
+	})
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a ContractInstantiation2Authorization) ValidateBasic() error {
+	return validateInstantiateGrants(a.Grants)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a ContractInstantiation2Authorization) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	for _, g := range a.Grants {
+		if err := g.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func acceptInstantiateGrants(
+	ctx sdk.Context,
+	grants []InstantiateGrant,
+	granter, admin string,
+	codeID uint64,
+	label string,
+	msg RawContractMessage,
+	funds sdk.Coins,
+	newAuthz func([]InstantiateGrant) authztypes.Authorization,
+) (authztypes.AcceptResponse, error) {
+	matches := func(g InstantiateGrant) (bool, error) {
+		return g.CodeID == codeID && g.IsLabelAllowed(label) && g.IsAdminAllowed(admin, granter), nil
+	}
+	return acceptGrants(ctx, grants, authzableMsg{msg: msg, funds: funds}, matches, newAuthz)
+}
+
+func validateInstantiateGrants(g []InstantiateGrant) error {
+	if len(g) == 0 {
+		return ErrEmpty.Wrap("grants")
+	}
+	for i, v := range g {
+		if err := v.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "position %d", i)
+		}
+	}
+	return nil
+}
+
+// NewStoreCodeAuthorization constructor
+func NewStoreCodeAuthorization(grants ...CodeGrant) *StoreCodeAuthorization {
+	return &StoreCodeAuthorization{
+		Grants: grants,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a StoreCodeAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgStoreCode{})
+}
+
+// Accept implements Authorization.Accept.
+func (a *StoreCodeAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authztypes.AcceptResponse, error) {
+	storeMsg, ok := msg.(*MsgStoreCode)
+	if !ok {
+		return authztypes.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+	if err := storeMsg.ValidateBasic(); err != nil {
+		return authztypes.AcceptResponse{}, err
+	}
+	checksum, err := wasmCodeChecksum(storeMsg.WASMByteCode)
+	if err != nil {
+		return authztypes.AcceptResponse{}, errorsmod.Wrap(err, "checksum")
+	}
+	matches := func(g CodeGrant) (bool, error) {
+		return bytes.Equal(g.CodeHash, checksum) && g.IsInstantiatePermissionAllowed(storeMsg.InstantiatePermission), nil
+	}
+	return acceptGrants(ctx, a.Grants, authzableMsg{msg: RawContractMessage("{}")}, matches, func(g []CodeGrant) authztypes.Authorization {
+		return NewStoreCodeAuthorization(g...)
+	})
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a StoreCodeAuthorization) ValidateBasic() error {
+	if len(a.Grants) == 0 {
+		return ErrEmpty.Wrap("grants")
+	}
+	for i, v := range a.Grants {
+		if err := v.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "position %d", i)
+		}
+	}
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a StoreCodeAuthorization) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	for _, g := range a.Grants {
+		if err := g.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// wasmCodeChecksum returns the sha256 checksum of the raw or gzip compressed wasm code
+// as it is stored by the keeper.
+func wasmCodeChecksum(wasmCode []byte) ([]byte, error) {
+	if bytes.HasPrefix(wasmCode, gzipIdent) {
+		zr, err := gzip.NewReader(bytes.NewReader(wasmCode))
+		if err != nil {
+			return nil, err
+		}
+		defer zr.Close()
+		wasmCode, err = io.ReadAll(io.LimitReader(zr, int64(MaxWasmSize)+1))
+		if err != nil {
+			return nil, err
+		}
+		if len(wasmCode) > MaxWasmSize {
+			return nil, ErrLimit.Wrapf("max %d bytes", MaxWasmSize)
+		}
+	}
+	checksum := sha256.Sum256(wasmCode)
+	return checksum[:], nil
+}
+
+// gzipIdent is the magic prefix of gzip compressed data
+var gzipIdent = []byte("\x1F\x8B\x08")
+
+// NewContractUpdateAdminAuthorization constructor
+func NewContractUpdateAdminAuthorization(grants ...ContractGrant) *ContractUpdateAdminAuthorization {
+	return &ContractUpdateAdminAuthorization{
+		Grants: grants,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a ContractUpdateAdminAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgUpdateAdmin{})
+}
+
+// NewAuthz factory method to create an Authorization with updated grants
+func (a ContractUpdateAdminAuthorization) NewAuthz(g []ContractGrant) authztypes.Authorization {
+	return NewContractUpdateAdminAuthorization(g...)
+}
+
+// Accept implements Authorization.Accept.
+// The filter is applied to the JSON document {"new_admin":"<address>"}.
+func (a *ContractUpdateAdminAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authztypes.AcceptResponse, error) {
+	updateMsg, ok := msg.(*MsgUpdateAdmin)
+	if !ok {
+		return authztypes.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+	if err := updateMsg.ValidateBasic(); err != nil {
+		return authztypes.AcceptResponse{}, err
+	}
+	doc, err := json.Marshal(map[string]string{"new_admin": updateMsg.NewAdmin})
+	if err != nil {
+		return authztypes.AcceptResponse{}, err
+	}
+	return acceptGrants(ctx, a.Grants, authzableMsg{contract: updateMsg.Contract, msg: doc}, matchContract(ctx, updateMsg.Contract), a.NewAuthz)
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a ContractUpdateAdminAuthorization) ValidateBasic() error {
+	return validateGrants(a.Grants)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a ContractUpdateAdminAuthorization) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	for _, g := range a.Grants {
+		if err := g.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewContractClearAdminAuthorization constructor
+func NewContractClearAdminAuthorization(grants ...ContractGrant) *ContractClearAdminAuthorization {
+	return &ContractClearAdminAuthorization{
+		Grants: grants,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a ContractClearAdminAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgClearAdmin{})
+}
+
+// NewAuthz factory method to create an Authorization with updated grants
+func (a ContractClearAdminAuthorization) NewAuthz(g []ContractGrant) authztypes.Authorization {
+	return NewContractClearAdminAuthorization(g...)
+}
+
+// Accept implements Authorization.Accept.
+// The filter is applied to the empty JSON document {}.
+func (a *ContractClearAdminAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authztypes.AcceptResponse, error) {
+	clearMsg, ok := msg.(*MsgClearAdmin)
+	if !ok {
+		return authztypes.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+	if err := clearMsg.ValidateBasic(); err != nil {
+		return authztypes.AcceptResponse{}, err
+	}
+	return acceptGrants(ctx, a.Grants, authzableMsg{contract: clearMsg.Contract, msg: RawContractMessage("{}")}, matchContract(ctx, clearMsg.Contract), a.NewAuthz)
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a ContractClearAdminAuthorization) ValidateBasic() error {
+	return validateGrants(a.Grants)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a ContractClearAdminAuthorization) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	for _, g := range a.Grants {
+		if err := g.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewExecuteContractsAuthorization constructor
+func NewExecuteContractsAuthorization(grants ...ContractGrant) *ExecuteContractsAuthorization {
+	return &ExecuteContractsAuthorization{
+		Grants: grants,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a ExecuteContractsAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgExecuteContracts{})
+}
+
+// NewAuthz factory method to create an Authorization with updated grants
+func (a ExecuteContractsAuthorization) NewAuthz(g []ContractGrant) authztypes.Authorization {
+	return NewExecuteContractsAuthorization(g...)
+}
+
+// Accept implements Authorization.Accept. Every execution must be accepted by a grant. The limits
+// are applied in execution order so that a single message can not exceed them.
+// The response templates are resolved after the authorization so that a filter can not check the
+// final message. Executions with templates are accepted by grants with the allow all filter only.
+func (a *ExecuteContractsAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authztypes.AcceptResponse, error) {
+	execs, ok := msg.(*MsgExecuteContracts)
+	if !ok {
+		return authztypes.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+	if err := execs.ValidateBasic(); err != nil {
+		return authztypes.AcceptResponse{}, err
+	}
+	grants := a.Grants
+	var updated bool
+	for i, e := range execs.Executions {
+		current := make([]ContractGrant, len(grants))
+		copy(current, grants)
+		var newGrants []ContractGrant
+		exec := authzableMsg{contract: e.Contract, msg: e.Msg, funds: e.Funds}
+		matches := matchContract(ctx, e.Contract)
+		ctx.GasMeter().ConsumeGas(gasDeserializationCostPerByte*uint64(len(e.Msg)), "contract authorization")
+		templated, err := HasResponseTemplates(e.Msg)
+		if err != nil {
+			return authztypes.AcceptResponse{}, errorsmod.Wrapf(err, "execution %d", i)
+		}
+		if templated {
+			matches = matchAllowAllFilter(matches)
+		}
+		result, err := acceptGrants(ctx, current, exec, matches, func(g []ContractGrant) authztypes.Authorization {
+			newGrants = g
+			return a.NewAuthz(g)
+		})
+		switch {
+		case err != nil:
+			return authztypes.AcceptResponse{}, errorsmod.Wrapf(err, "execution %d", i)
+		case !result.Accept:
+			return authztypes.AcceptResponse{Accept: false}, nil
+		case result.Delete:
+			grants, updated = nil, true
+		case result.Updated != nil:
+			grants, updated = newGrants, true
+		}
+	}
+	switch {
+	case !updated:
+		return authztypes.AcceptResponse{Accept: true}, nil
+	case len(grants) == 0:
+		return authztypes.AcceptResponse{Accept: true, Delete: true}, nil
+	default:
+		return authztypes.AcceptResponse{Accept: true, Updated: a.NewAuthz(grants)}, nil
+	}
+}
+
+// matchAllowAllFilter restricts the matcher to grants that accept any message
+func matchAllowAllFilter(matches func(ContractGrant) (bool, error)) func(ContractGrant) (bool, error) {
+	return func(g ContractGrant) (bool, error) {
+		if _, ok := g.GetFilter().(*AllowAllMessagesFilter); !ok {
+			return false, nil
+		}
+		return matches(g)
+	}
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a ExecuteContractsAuthorization) ValidateBasic() error {
+	return validateGrants(a.Grants)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a ExecuteContractsAuthorization) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	for _, g := range a.Grants {
+		if err := g.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // ContractAuthzFactory factory to create an updated Authorization object
 type ContractAuthzFactory interface {
 	NewAuthz([]ContractGrant) authztypes.Authorization
@@ -135,14 +539,96 @@ func AcceptGrantedMessage[T AuthzableWasmMsg](ctx sdk.Context, grants []Contract
 		return authztypes.AcceptResponse{}, err
 	}
 
+	return acceptGrants(ctx, grants, exec, matchContract(ctx, exec.GetContract()), factory.NewAuthz)
+}
+
+// matchContract returns a grant matcher for the given contract address. Grants for code ids or a creator
+// are resolved with the contract info from the ContractInfoSource in the context. The contract info is
+// loaded once on demand.
+func matchContract(ctx sdk.Context, contract string) func(ContractGrant) (bool, error) {
+	var (
+		info     *ContractInfo
+		resolved bool
+		err      error
+	)
+	return func(g ContractGrant) (bool, error) {
+		if g.Contract != "" {
+			return g.Contract == contract, nil
+		}
+		if !resolved {
+			info, err = resolveContractInfo(ctx, contract)
+			resolved = true
+		}
+		return info != nil && g.matchesContractInfo(*info), err
+	}
+}
+
+// resolveContractInfo returns the contract info from the ContractInfoSource in the context or nil when
+// the contract does not exist. An error is returned when the context has no source, for example when the
+// message was not sent in a tx but dispatched by another module or governance.
+func resolveContractInfo(ctx sdk.Context, contract string) (*ContractInfo, error) {
+	source, ok := ContractInfoSourceFromContext(ctx)
+	if !ok {
+		return nil, sdkerrors.ErrNotSupported.Wrap("grants for code ids or a creator require the contract info source in the context")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(contract)
+	if err != nil {
+		return nil, nil
+	}
+	return source.GetContractInfo(ctx, contractAddr), nil
+}
+
+// authzGrant is a grant with a limit and a filter that is applied via acceptGrants
+type authzGrant[G any] interface {
+	GetFilter() ContractAuthzFilterX
+	// limitFor returns the limit that applies to the contract. The contract is empty
+	// for operations without a contract address.
+	limitFor(contract string) ContractAuthzLimitX
+	// applyLimitResult returns the updated grant for the accepted limit result or remove
+	// when the grant lapsed. The grant is nil and remove false when there is no change.
+	applyLimitResult(contract string, result ContractAuthzLimitAcceptResult) (updated *G, remove bool, err error)
+}
+
+// applyGrantLimitResult is the default implementation for authzGrant.applyLimitResult where the
+// grant has a single limit.
+func applyGrantLimitResult[G interface {
+	WithNewLimits(ContractAuthzLimitX) (*G, error)
+}](g G, result ContractAuthzLimitAcceptResult,
+) (*G, bool, error) {
+	switch {
+	case result.DeleteLimit:
+		return nil, true, nil
+	case result.UpdateLimit != nil:
+		obj, err := g.WithNewLimits(result.UpdateLimit)
+		return obj, false, err
+	default:
+		return nil, false, nil
+	}
+}
+
+// acceptGrants applies the first matching grant with an accepting limit and filter
+// and provides an upgraded authorization instance when the grant state has changed.
+func acceptGrants[G authzGrant[G]](
+	ctx sdk.Context,
+	grants []G,
+	exec AuthzableWasmMsg,
+	matches func(G) (bool, error),
+	newAuthz func([]G) authztypes.Authorization,
+) (authztypes.AcceptResponse, error) {
+	// an error of the matcher is returned only when no other grant accepts the message
+	var matchErr error
 	// iterate though all grants
 	for i, g := range grants {
-		if g.Contract != exec.GetContract() {
+		switch ok, err := matches(g); {
+		case err != nil:
+			matchErr = err
+			continue
+		case !ok:
 			continue
 		}
 
 		// first check limits
-		result, err := g.GetLimit().Accept(ctx, exec)
+		result, err := g.limitFor(exec.GetContract()).Accept(ctx, exec)
 		switch {
 		case err != nil:
 			return authztypes.AcceptResponse{}, errorsmod.Wrap(err, "limit")
@@ -164,34 +650,55 @@ func AcceptGrantedMessage[T AuthzableWasmMsg](ctx sdk.Context, grants []Contract
 		}
 
 		// finally do limit state updates in result
-		switch {
-		case result.DeleteLimit:
-			updatedGrants := append(grants[0:i], grants[i+1:]...) //nolint:gocritic
-			if len(updatedGrants) == 0 {                          // remove when empty
-				return authztypes.AcceptResponse{Accept: true, Delete: true}, nil
-			}
-			newAuthz := factory.NewAuthz(updatedGrants)
-			if err := newAuthz.ValidateBasic(); err != nil { // sanity check
-				return authztypes.AcceptResponse{}, ErrInvalid.Wrapf("new grant state: %s", err)
-			}
-			return authztypes.AcceptResponse{Accept: true, Updated: newAuthz}, nil
-		case result.UpdateLimit != nil:
-			obj, err := g.WithNewLimits(result.UpdateLimit)
-			if err != nil {
-				return authztypes.AcceptResponse{}, err
-			}
-			newAuthz := factory.NewAuthz(append(append(grants[0:i], *obj), grants[i+1:]...))
-			if err := newAuthz.ValidateBasic(); err != nil { // sanity check
-				return authztypes.AcceptResponse{}, ErrInvalid.Wrapf("new grant state: %s", err)
+		obj, remove, err := g.applyLimitResult(exec.GetContract(), *result)
+		if err != nil {
+			return authztypes.AcceptResponse{}, err
+		}
+		// other grants with a lapsed limit are removed, too
+		updatedGrants := make([]G, 0, len(grants))
+		for j, o := range grants {
+			switch {
+			case j == i && remove:
+			case j == i && obj != nil:
+				updatedGrants = append(updatedGrants, *obj)
+			case j != i && isLapsedGrant(ctx, o):
+			default:
+				updatedGrants = append(updatedGrants, o)
 			}
-			return authztypes.AcceptResponse{Accept: true, Updated: newAuthz}, nil
-		default: // accepted without a limit state update
+		}
+		switch {
+		case len(updatedGrants) == 0: // remove when empty
+			return authztypes.AcceptResponse{Accept: true, Delete: true}, nil
+		case obj == nil && len(updatedGrants) == len(grants): // accepted without a state update
 			return authztypes.AcceptResponse{Accept: true}, nil
 		}
+		newAuthz := newAuthz(updatedGrants)
+		if err := newAuthz.ValidateBasic(); err != nil { // sanity check
+			return authztypes.AcceptResponse{}, ErrInvalid.Wrapf("new grant state: %s", err)
+		}
+		return authztypes.AcceptResponse{Accept: true, Updated: newAuthz}, nil
+	}
+	if matchErr != nil {
+		return authztypes.AcceptResponse{}, errorsmod.Wrap(matchErr, "match")
 	}
 	return authztypes.AcceptResponse{Accept: false}, nil
 }
 
+// lapsedLimit is implemented by limits that lapse with the block time so that they never accept a call again
+type lapsedLimit interface {
+	IsLapsed(blockTime time.Time) bool
+}
+
+// isLapsedGrant returns true when the limit of the grant lapsed at the block time
+func isLapsedGrant[G authzGrant[G]](ctx sdk.Context, g G) bool {
+	return isLapsedLimit(g.limitFor(""), ctx.BlockTime())
+}
+
+func isLapsedLimit(limit ContractAuthzLimitX, blockTime time.Time) bool {
+	l, ok := limit.(lapsedLimit)
+	return ok && l.IsLapsed(blockTime)
+}
+
 // ContractAuthzLimitX  define execution limits that are enforced and updated when the grant
 // is applied. When the limit lapsed the grant is removed.
 type ContractAuthzLimitX interface {
@@ -222,40 +729,468 @@ var _ cdctypes.UnpackInterfacesMessage = &ContractGrant{}
 
 // NewContractGrant constructor
 func NewContractGrant(contract sdk.AccAddress, limit ContractAuthzLimitX, filter ContractAuthzFilterX) (*ContractGrant, error) {
+	return newContractGrant(ContractGrant{Contract: contract.String()}, limit, filter)
+}
+
+// NewContractGrantForCodeIDs constructor for a grant that matches any contract instantiated from one of the codes.
+// The limit is tracked per matched contract, the total limit for all matched contracts together.
+func NewContractGrantForCodeIDs(codeIDs []uint64, limit, totalLimit ContractAuthzLimitX, filter ContractAuthzFilterX) (*ContractGrant, error) {
+	anyTotalLimit, err := newAnyLimit(totalLimit)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "total")
+	}
+	return newContractGrant(ContractGrant{CodeIDs: codeIDs, TotalLimit: anyTotalLimit}, limit, filter)
+}
+
+// NewContractGrantForCreator constructor for a grant that matches any contract created by the given address.
+// The limit is tracked per matched contract, the total limit for all matched contracts together.
+func NewContractGrantForCreator(creator sdk.AccAddress, limit, totalLimit ContractAuthzLimitX, filter ContractAuthzFilterX) (*ContractGrant, error) {
+	anyTotalLimit, err := newAnyLimit(totalLimit)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "total")
+	}
+	return newContractGrant(ContractGrant{Creator: creator.String(), TotalLimit: anyTotalLimit}, limit, filter)
+}
+
+func newContractGrant(g ContractGrant, limit ContractAuthzLimitX, filter ContractAuthzFilterX) (*ContractGrant, error) {
 	pFilter, ok := filter.(proto.Message)
 	if !ok {
 		return nil, sdkerrors.ErrInvalidType.Wrap("filter is not a proto type")
 	}
-	anyFilter, err := cdctypes.NewAnyWithValue(pFilter)
-	if err != nil {
-		return nil, errorsmod.Wrap(err, "filter")
+	anyFilter, err := cdctypes.NewAnyWithValue(pFilter)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "filter")
+	}
+	g.Filter = anyFilter
+	return g.WithNewLimits(limit)
+}
+
+// WithNewLimits factory method to create a new grant with given limit
+func (g ContractGrant) WithNewLimits(limit ContractAuthzLimitX) (*ContractGrant, error) {
+	anyLimit, err := newAnyLimit(limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ContractGrant{
+		Contract:       g.Contract,
+		Limit:          anyLimit,
+		Filter:         g.Filter,
+		CodeIDs:        g.CodeIDs,
+		Creator:        g.Creator,
+		ContractLimits: g.ContractLimits,
+		TotalLimit:     g.TotalLimit,
+	}, nil
+}
+
+// matchesContractInfo returns true when the contract matches the code ids or creator of the grant
+func (g ContractGrant) matchesContractInfo(info ContractInfo) bool {
+	if g.Creator != "" {
+		return g.Creator == info.Creator
+	}
+	for _, codeID := range g.CodeIDs {
+		if codeID == info.CodeID {
+			return true
+		}
+	}
+	return false
+}
+
+func (g ContractGrant) contractLimitPos(contract string) int {
+	for i, l := range g.ContractLimits {
+		if l.Contract == contract {
+			return i
+		}
+	}
+	return -1
+}
+
+// limitFor returns the grant limit for a single contract grant. For grants matching code ids or a creator, the
+// limit of the matched contract is combined with the total limit.
+func (g ContractGrant) limitFor(contract string) ContractAuthzLimitX {
+	if g.Contract != "" {
+		return g.GetLimit()
+	}
+	limit := g.GetLimit()
+	if pos := g.contractLimitPos(contract); pos >= 0 {
+		limit = g.ContractLimits[pos].GetLimit()
+	}
+	return contractGrantLimits{contract: limit, total: g.GetTotalLimit()}
+}
+
+// applyLimitResult updates the grant limit for a single contract grant. For grants matching code ids or a creator,
+// the total limit and the limit state of the matched contract are updated instead. A lapsed contract limit is
+// removed and the grant is removed when the total limit lapsed.
+func (g ContractGrant) applyLimitResult(contract string, result ContractAuthzLimitAcceptResult) (*ContractGrant, bool, error) {
+	if g.Contract != "" {
+		return applyGrantLimitResult(g, result)
+	}
+	switch {
+	case result.DeleteLimit:
+		return nil, true, nil
+	case result.UpdateLimit == nil:
+		return nil, false, nil
+	}
+	next, ok := result.UpdateLimit.(contractGrantLimits)
+	if !ok {
+		return nil, false, sdkerrors.ErrInvalidType.Wrapf("unexpected limit update %T", result.UpdateLimit)
+	}
+	obj := g
+	if next.totalChanged {
+		anyLimit, err := newAnyLimit(next.total)
+		if err != nil {
+			return nil, false, errorsmod.Wrap(err, "total")
+		}
+		obj.TotalLimit = anyLimit
+	}
+	if !next.contractChanged {
+		return &obj, false, nil
+	}
+	var contractLimits []ContractLimit
+	for _, l := range g.ContractLimits {
+		if l.Contract != contract {
+			contractLimits = append(contractLimits, l)
+		}
+	}
+	if next.contract != nil {
+		if len(contractLimits) >= MaxContractLimitsPerGrant {
+			return nil, false, ErrLimit.Wrapf("max %d contract limits per grant", MaxContractLimitsPerGrant)
+		}
+		anyLimit, err := newAnyLimit(next.contract)
+		if err != nil {
+			return nil, false, err
+		}
+		contractLimits = append(contractLimits, ContractLimit{Contract: contract, Limit: anyLimit})
+	}
+	obj.ContractLimits = contractLimits
+	return &obj, false, nil
+}
+
+var _ ContractAuthzLimitX = contractGrantLimits{}
+
+// contractGrantLimits is the limit of a contract matched by code ids or a creator combined with the total limit of
+// the grant. Both have to accept. The accept result contains the new states as contractGrantLimits.
+type contractGrantLimits struct {
+	// contract limit state, nil when lapsed
+	contract        ContractAuthzLimitX
+	contractChanged bool
+	total           ContractAuthzLimitX
+	totalChanged    bool
+}
+
+// Accept implements ContractAuthzLimitX.Accept
+func (l contractGrantLimits) Accept(ctx sdk.Context, msg AuthzableWasmMsg) (*ContractAuthzLimitAcceptResult, error) {
+	contractResult, err := l.contract.Accept(ctx, msg)
+	switch {
+	case err != nil:
+		return nil, errorsmod.Wrap(err, "contract")
+	case contractResult == nil: // sanity check
+		return nil, sdkerrors.ErrInvalidType.Wrap("limit result must not be nil")
+	case !contractResult.Accepted:
+		return &ContractAuthzLimitAcceptResult{Accepted: false}, nil
+	}
+	totalResult, err := l.total.Accept(ctx, msg)
+	switch {
+	case err != nil:
+		return nil, errorsmod.Wrap(err, "total")
+	case totalResult == nil: // sanity check
+		return nil, sdkerrors.ErrInvalidType.Wrap("limit result must not be nil")
+	case !totalResult.Accepted:
+		return &ContractAuthzLimitAcceptResult{Accepted: false}, nil
+	case totalResult.DeleteLimit:
+		return &ContractAuthzLimitAcceptResult{Accepted: true, DeleteLimit: true}, nil
+	}
+	next := contractGrantLimits{contract: l.contract, total: l.total}
+	switch {
+	case contractResult.DeleteLimit:
+		next.contract, next.contractChanged = nil, true
+	case contractResult.UpdateLimit != nil:
+		next.contract, next.contractChanged = contractResult.UpdateLimit, true
+	}
+	if totalResult.UpdateLimit != nil {
+		next.total, next.totalChanged = totalResult.UpdateLimit, true
+	}
+	if !next.contractChanged && !next.totalChanged {
+		return &ContractAuthzLimitAcceptResult{Accepted: true}, nil
+	}
+	return &ContractAuthzLimitAcceptResult{Accepted: true, UpdateLimit: next}, nil
+}
+
+// IsLapsed returns true when the contract or the total limit lapsed at the block time
+func (l contractGrantLimits) IsLapsed(blockTime time.Time) bool {
+	return isLapsedLimit(l.contract, blockTime) || isLapsedLimit(l.total, blockTime)
+}
+
+// ValidateBasic implements ContractAuthzLimitX.ValidateBasic
+func (l contractGrantLimits) ValidateBasic() error {
+	if err := l.total.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "total")
+	}
+	if l.contract == nil {
+		return nil
+	}
+	return errorsmod.Wrap(l.contract.ValidateBasic(), "contract")
+}
+
+// How a ContractGrant references a contract
+const (
+	ContractGrantMatchedByContract = "contract"
+	ContractGrantMatchedByCodeID   = "code_id"
+	ContractGrantMatchedByCreator  = "creator"
+)
+
+// MatchedBy returns how the grant references the contract or empty when it does not. The contract info
+// is used for grants with code ids or a creator and can be nil.
+func (g ContractGrant) MatchedBy(contract string, info *ContractInfo) string {
+	switch {
+	case g.Contract != "":
+		if g.Contract == contract {
+			return ContractGrantMatchedByContract
+		}
+	case info == nil || !g.matchesContractInfo(*info):
+	case g.Creator != "":
+		return ContractGrantMatchedByCreator
+	default:
+		return ContractGrantMatchedByCodeID
+	}
+	return ""
+}
+
+// LimitStateFor returns the current limit state for the contract
+func (g ContractGrant) LimitStateFor(contract string) *cdctypes.Any {
+	if pos := g.contractLimitPos(contract); g.Contract == "" && pos >= 0 {
+		return g.ContractLimits[pos].Limit
+	}
+	return g.Limit
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (g ContractGrant) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	var f ContractAuthzFilterX
+	if err := unpacker.UnpackAny(g.Filter, &f); err != nil {
+		return errorsmod.Wrap(err, "filter")
+	}
+	var l ContractAuthzLimitX
+	if err := unpacker.UnpackAny(g.Limit, &l); err != nil {
+		return errorsmod.Wrap(err, "limit")
+	}
+	for _, cl := range g.ContractLimits {
+		if err := cl.UnpackInterfaces(unpacker); err != nil {
+			return errorsmod.Wrap(err, "contract limits")
+		}
+	}
+	if g.TotalLimit != nil {
+		if err := unpacker.UnpackAny(g.TotalLimit, &l); err != nil {
+			return errorsmod.Wrap(err, "total limit")
+		}
+	}
+	return nil
+}
+
+// GetLimit returns the cached value from the ContractGrant.Limit if present.
+func (g ContractGrant) GetLimit() ContractAuthzLimitX {
+	return cachedLimit(g.Limit)
+}
+
+// GetTotalLimit returns the cached value from the ContractGrant.TotalLimit if present.
+func (g ContractGrant) GetTotalLimit() ContractAuthzLimitX {
+	return cachedLimit(g.TotalLimit)
+}
+
+// GetFilter returns the cached value from the ContractGrant.Filter if present.
+func (g ContractGrant) GetFilter() ContractAuthzFilterX {
+	return cachedFilter(g.Filter)
+}
+
+func newAnyLimit(limit ContractAuthzLimitX) (*cdctypes.Any, error) {
+	pLimit, ok := limit.(proto.Message)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidType.Wrap("limit is not a proto type")
+	}
+	anyLimit, err := cdctypes.NewAnyWithValue(pLimit)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "limit")
+	}
+	return anyLimit, nil
+}
+
+func cachedLimit(limit *cdctypes.Any) ContractAuthzLimitX {
+	if limit == nil {
+		return &UndefinedLimit{}
+	}
+	a, ok := limit.GetCachedValue().(ContractAuthzLimitX)
+	if !ok {
+		return &UndefinedLimit{}
+	}
+	return a
+}
+
+func cachedFilter(filter *cdctypes.Any) ContractAuthzFilterX {
+	if filter == nil {
+		return &UndefinedFilter{}
+	}
+	a, ok := filter.GetCachedValue().(ContractAuthzFilterX)
+	if !ok {
+		return &UndefinedFilter{}
+	}
+	return a
+}
+
+// ValidateBasic validates the grant
+func (g ContractGrant) ValidateBasic() error {
+	switch {
+	case g.Contract != "" && (len(g.CodeIDs) != 0 || g.Creator != ""), len(g.CodeIDs) != 0 && g.Creator != "":
+		return ErrInvalid.Wrap("only one of contract, code ids or creator must be set")
+	case len(g.CodeIDs) != 0:
+		for _, codeID := range g.CodeIDs {
+			if codeID == 0 {
+				return ErrEmpty.Wrap("code id")
+			}
+		}
+	case g.Creator != "":
+		if _, err := sdk.AccAddressFromBech32(g.Creator); err != nil {
+			return errorsmod.Wrap(err, "creator")
+		}
+	default:
+		if _, err := sdk.AccAddressFromBech32(g.Contract); err != nil {
+			return errorsmod.Wrap(err, "contract")
+		}
+		if len(g.ContractLimits) != 0 {
+			return ErrInvalid.Wrap("contract limits require code ids or creator")
+		}
+		if g.TotalLimit != nil {
+			return ErrInvalid.Wrap("total limit requires code ids or creator")
+		}
+	}
+	if g.Contract == "" {
+		if err := g.GetTotalLimit().ValidateBasic(); err != nil {
+			return errorsmod.Wrap(err, "total limit")
+		}
+	}
+	if len(g.ContractLimits) > MaxContractLimitsPerGrant {
+		return ErrLimit.Wrapf("max %d contract limits", MaxContractLimitsPerGrant)
+	}
+	uniqueContracts := make(map[string]struct{}, len(g.ContractLimits))
+	for i, l := range g.ContractLimits {
+		if err := l.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "contract limits %d", i)
+		}
+		if _, exists := uniqueContracts[l.Contract]; exists {
+			return ErrDuplicate.Wrapf("contract limits %d", i)
+		}
+		uniqueContracts[l.Contract] = struct{}{}
+	}
+	// execution limits
+	if err := g.GetLimit().ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "limit")
+	}
+	// filter
+	if err := g.GetFilter().ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "filter")
+	}
+	return nil
+}
+
+var _ cdctypes.UnpackInterfacesMessage = &ContractLimit{}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (l ContractLimit) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	var x ContractAuthzLimitX
+	if err := unpacker.UnpackAny(l.Limit, &x); err != nil {
+		return errorsmod.Wrap(err, "limit")
+	}
+	return nil
+}
+
+// GetLimit returns the cached value from the ContractLimit.Limit if present.
+func (l ContractLimit) GetLimit() ContractAuthzLimitX {
+	return cachedLimit(l.Limit)
+}
+
+// ValidateBasic validates the contract limit state
+func (l ContractLimit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(l.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	return errorsmod.Wrap(l.GetLimit().ValidateBasic(), "limit")
+}
+
+var _ cdctypes.UnpackInterfacesMessage = &InstantiateGrant{}
+
+// NewInstantiateGrant constructor. Without admins, the contract can only be instantiated without admin or with the
+// granter as admin.
+func NewInstantiateGrant(codeID uint64, labels, admins []string, limit ContractAuthzLimitX, filter ContractAuthzFilterX) (*InstantiateGrant, error) {
+	pFilter, ok := filter.(proto.Message)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidType.Wrap("filter is not a proto type")
+	}
+	anyFilter, err := cdctypes.NewAnyWithValue(pFilter)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "filter")
+	}
+	return InstantiateGrant{
+		CodeID: codeID,
+		Labels: labels,
+		Filter: anyFilter,
+		Admins: admins,
+	}.WithNewLimits(limit)
+}
+
+// WithNewLimits factory method to create a new grant with given limit
+func (g InstantiateGrant) WithNewLimits(limit ContractAuthzLimitX) (*InstantiateGrant, error) {
+	anyLimit, err := newAnyLimit(limit)
+	if err != nil {
+		return nil, err
+	}
+	return &InstantiateGrant{
+		CodeID: g.CodeID,
+		Labels: g.Labels,
+		Limit:  anyLimit,
+		Filter: g.Filter,
+		Admins: g.Admins,
+	}, nil
+}
+
+func (g InstantiateGrant) limitFor(string) ContractAuthzLimitX {
+	return g.GetLimit()
+}
+
+func (g InstantiateGrant) applyLimitResult(_ string, result ContractAuthzLimitAcceptResult) (*InstantiateGrant, bool, error) {
+	return applyGrantLimitResult(g, result)
+}
+
+// IsLabelAllowed returns true when the label is one of the granted labels or no labels are set
+func (g InstantiateGrant) IsLabelAllowed(label string) bool {
+	if len(g.Labels) == 0 {
+		return true
+	}
+	for _, l := range g.Labels {
+		if l == label {
+			return true
+		}
 	}
-	return ContractGrant{
-		Contract: contract.String(),
-		Filter:   anyFilter,
-	}.WithNewLimits(limit)
+	return false
 }
 
-// WithNewLimits factory method to create a new grant with given limit
-func (g ContractGrant) WithNewLimits(limit ContractAuthzLimitX) (*ContractGrant, error) {
-	pLimit, ok := limit.(proto.Message)
-	if !ok {
-		return nil, sdkerrors.ErrInvalidType.Wrap("limit is not a proto type")
+// IsAdminAllowed returns true when no admin is set or the admin is one of the granted admins. Without granted admins,
+// only the granter is allowed as admin.
+func (g InstantiateGrant) IsAdminAllowed(admin, granter string) bool {
+	if admin == "" {
+		return true
 	}
-	anyLimit, err := cdctypes.NewAnyWithValue(pLimit)
-	if err != nil {
-		return nil, errorsmod.Wrap(err, "limit")
+	if len(g.Admins) == 0 {
+		return admin == granter
 	}
-
-	return &ContractGrant{
-		Contract: g.Contract,
-		Limit:    anyLimit,
-		Filter:   g.Filter,
-	}, nil
+	for _, a := range g.Admins {
+		if a == admin {
+			return true
+		}
+	}
+	return false
 }
 
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
-func (g ContractGrant) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+func (g InstantiateGrant) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
 	var f ContractAuthzFilterX
 	if err := unpacker.UnpackAny(g.Filter, &f); err != nil {
 		return errorsmod.Wrap(err, "filter")
@@ -267,34 +1202,30 @@ func (g ContractGrant) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
 	return nil
 }
 
-// GetLimit returns the cached value from the ContractGrant.Limit if present.
-func (g ContractGrant) GetLimit() ContractAuthzLimitX {
-	if g.Limit == nil {
-		return &UndefinedLimit{}
-	}
-	a, ok := g.Limit.GetCachedValue().(ContractAuthzLimitX)
-	if !ok {
-		return &UndefinedLimit{}
-	}
-	return a
+// GetLimit returns the cached value from the InstantiateGrant.Limit if present.
+func (g InstantiateGrant) GetLimit() ContractAuthzLimitX {
+	return cachedLimit(g.Limit)
 }
 
-// GetFilter returns the cached value from the ContractGrant.Filter if present.
-func (g ContractGrant) GetFilter() ContractAuthzFilterX {
-	if g.Filter == nil {
-		return &UndefinedFilter{}
-	}
-	a, ok := g.Filter.GetCachedValue().(ContractAuthzFilterX)
-	if !ok {
-		return &UndefinedFilter{}
-	}
-	return a
+// GetFilter returns the cached value from the InstantiateGrant.Filter if present.
+func (g InstantiateGrant) GetFilter() ContractAuthzFilterX {
+	return cachedFilter(g.Filter)
 }
 
 // ValidateBasic validates the grant
-func (g ContractGrant) ValidateBasic() error {
-	if _, err := sdk.AccAddressFromBech32(g.Contract); err != nil {
-		return errorsmod.Wrap(err, "contract")
+func (g InstantiateGrant) ValidateBasic() error {
+	if g.CodeID == 0 {
+		return ErrEmpty.Wrap("code id")
+	}
+	for _, l := range g.Labels {
+		if err := ValidateLabel(l); err != nil {
+			return errorsmod.Wrap(err, "labels")
+		}
+	}
+	for _, a := range g.Admins {
+		if _, err := sdk.AccAddressFromBech32(a); err != nil {
+			return errorsmod.Wrap(err, "admins")
+		}
 	}
 	// execution limits
 	if err := g.GetLimit().ValidateBasic(); err != nil {
@@ -307,6 +1238,96 @@ func (g ContractGrant) ValidateBasic() error {
 	return nil
 }
 
+var _ cdctypes.UnpackInterfacesMessage = &CodeGrant{}
+
+// NewCodeGrant constructor. The instantiate permission is optional.
+func NewCodeGrant(codeHash []byte, instantiatePermission *AccessConfig, limit ContractAuthzLimitX) (*CodeGrant, error) {
+	return CodeGrant{
+		CodeHash:              codeHash,
+		InstantiatePermission: instantiatePermission,
+	}.WithNewLimits(limit)
+}
+
+// WithNewLimits factory method to create a new grant with given limit
+func (g CodeGrant) WithNewLimits(limit ContractAuthzLimitX) (*CodeGrant, error) {
+	anyLimit, err := newAnyLimit(limit)
+	if err != nil {
+		return nil, err
+	}
+	return &CodeGrant{
+		CodeHash:              g.CodeHash,
+		InstantiatePermission: g.InstantiatePermission,
+		Limit:                 anyLimit,
+	}, nil
+}
+
+func (g CodeGrant) limitFor(string) ContractAuthzLimitX {
+	return g.GetLimit()
+}
+
+func (g CodeGrant) applyLimitResult(_ string, result ContractAuthzLimitAcceptResult) (*CodeGrant, bool, error) {
+	return applyGrantLimitResult(g, result)
+}
+
+// IsInstantiatePermissionAllowed returns true when no permission is granted or the given
+// permission is a subset of the granted one. The permission must be set explicitly in the
+// second case as the chain default can not be resolved here.
+func (g CodeGrant) IsInstantiatePermissionAllowed(permission *AccessConfig) bool {
+	if g.InstantiatePermission == nil {
+		return true
+	}
+	return permission != nil && permission.IsSubset(*g.InstantiatePermission)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (g CodeGrant) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	var l ContractAuthzLimitX
+	if err := unpacker.UnpackAny(g.Limit, &l); err != nil {
+		return errorsmod.Wrap(err, "limit")
+	}
+	return nil
+}
+
+// GetLimit returns the cached value from the CodeGrant.Limit if present.
+func (g CodeGrant) GetLimit() ContractAuthzLimitX {
+	return cachedLimit(g.Limit)
+}
+
+// GetFilter returns an allow all filter as there is no contract message on upload. The filter is
+// applied to the empty JSON document {}.
+func (g CodeGrant) GetFilter() ContractAuthzFilterX {
+	return NewAllowAllMessagesFilter()
+}
+
+// ValidateBasic validates the grant
+func (g CodeGrant) ValidateBasic() error {
+	if len(g.CodeHash) != sha256.Size {
+		return ErrInvalid.Wrapf("code hash: expected %d bytes", sha256.Size)
+	}
+	if g.InstantiatePermission != nil {
+		if err := g.InstantiatePermission.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(err, "instantiate permission")
+		}
+	}
+	if err := g.GetLimit().ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "limit")
+	}
+	return nil
+}
+
+// authzableMsg adapts the wasm messages that are not AuthzableWasmMsg itself for the limits and filters.
+// The message is validated by the caller.
+type authzableMsg struct {
+	contract string
+	msg      RawContractMessage
+	funds    sdk.Coins
+}
+
+func (m authzableMsg) GetFunds() sdk.Coins        { return m.funds }
+func (m authzableMsg) GetMsg() RawContractMessage { return m.msg }
+func (m authzableMsg) GetContract() string        { return m.contract }
+func (m authzableMsg) ValidateBasic() error       { return nil }
+
 // UndefinedFilter null object that is always rejected in execution
 type UndefinedFilter struct{}
 
@@ -409,11 +1430,232 @@ func (f AcceptedMessagesFilter) ValidateBasic() error {
 	return nil
 }
 
+const (
+	// MaxJSONPathRegexLength is the maximal length of a regular expression in a JSONPathCondition
+	MaxJSONPathRegexLength = 256
+	// MaxJSONPathRegexProgramSize is the maximal number of instructions of a compiled regular expression in a
+	// JSONPathCondition. This limits the compile costs of short expressions with large repetitions.
+	MaxJSONPathRegexProgramSize = 2048
+)
+
+// NewJSONPathFilter constructor
+func NewJSONPathFilter(conditions ...JSONPathCondition) *JSONPathFilter {
+	return &JSONPathFilter{Conditions: conditions}
+}
+
+// Accept only payload messages where the values at the JSON paths meet all conditions.
+func (f *JSONPathFilter) Accept(ctx sdk.Context, msg RawContractMessage) (bool, error) {
+	gasForDeserialization := gasDeserializationCostPerByte * uint64(len(msg))
+	ctx.GasMeter().ConsumeGas(gasForDeserialization, "contract authorization")
+
+	if err := msg.ValidateBasic(); err != nil {
+		return false, sdkerrors.ErrUnauthorized.Wrapf("not an allowed msg: %s", err.Error())
+	}
+	document, err := decodeJSON(msg)
+	if err != nil {
+		return false, sdkerrors.ErrUnauthorized.Wrapf("not an allowed msg: %s", err.Error())
+	}
+	for _, c := range f.Conditions {
+		// the matchers are decoded or compiled on every call
+		ctx.GasMeter().ConsumeGas(gasDeserializationCostPerByte*uint64(len(c.Equals))+gasRegexCompileCostPerByte*uint64(len(c.Regex)), "contract authorization")
+		ok, err := c.Matches(document)
+		if err != nil || !ok {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// ValidateBasic validates the filter
+func (f JSONPathFilter) ValidateBasic() error {
+	if len(f.Conditions) == 0 {
+		return ErrEmpty.Wrap("conditions")
+	}
+	for i, c := range f.Conditions {
+		if err := c.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "condition %d", i)
+		}
+	}
+	return nil
+}
+
+// NewJSONPathEqualsCondition constructor for a condition that requires the value to be equal to the JSON encoded value
+func NewJSONPathEqualsCondition(path string, jsonValue string) JSONPathCondition {
+	return JSONPathCondition{Path: path, Equals: jsonValue}
+}
+
+// NewJSONPathRangeCondition constructor for a condition that requires a numeric value within the inclusive range.
+// Bounds are decimal strings, empty for no bound.
+func NewJSONPathRangeCondition(path string, min, max string) JSONPathCondition {
+	return JSONPathCondition{Path: path, Range: &NumericRange{Min: min, Max: max}}
+}
+
+// NewJSONPathAnyOfCondition constructor for a condition that requires the string value to be one of the given values
+func NewJSONPathAnyOfCondition(path string, values ...string) JSONPathCondition {
+	return JSONPathCondition{Path: path, AnyOf: values}
+}
+
+// NewJSONPathRegexCondition constructor for a condition that requires the string value to fully match the regex
+func NewJSONPathRegexCondition(path string, regex string) JSONPathCondition {
+	return JSONPathCondition{Path: path, Regex: regex}
+}
+
+// Matches returns true when the value at the path of the decoded document meets the condition.
+// A missing path or a value of an unexpected type does not match.
+func (c JSONPathCondition) Matches(document interface{}) (bool, error) {
+	value, ok := lookupJSONPath(document, c.Path)
+	if !ok {
+		return false, nil
+	}
+	switch {
+	case c.Equals != "":
+		exp, err := decodeJSON([]byte(c.Equals))
+		if err != nil {
+			return false, ErrInvalid.Wrap("equals")
+		}
+		return reflect.DeepEqual(exp, value), nil
+	case c.Range != nil:
+		var raw string
+		switch v := value.(type) {
+		case json.Number:
+			raw = v.String()
+		case string:
+			raw = v
+		default:
+			return false, nil
+		}
+		n, err := sdk.NewDecFromStr(raw)
+		if err != nil {
+			return false, nil
+		}
+		return c.Range.Contains(n), nil
+	case len(c.AnyOf) != 0:
+		s, ok := value.(string)
+		if !ok {
+			return false, nil
+		}
+		for _, v := range c.AnyOf {
+			if v == s {
+				return true, nil
+			}
+		}
+		return false, nil
+	case c.Regex != "":
+		s, ok := value.(string)
+		if !ok {
+			return false, nil
+		}
+		r, err := regexp.Compile(`^(?:` + c.Regex + `)$`)
+		if err != nil {
+			return false, ErrInvalid.Wrap("regex")
+		}
+		return r.MatchString(s), nil
+	default:
+		return false, ErrEmpty.Wrap("matcher")
+	}
+}
+
+// ValidateBasic validates the condition
+func (c JSONPathCondition) ValidateBasic() error {
+	if c.Path == "" {
+		return ErrEmpty.Wrap("path")
+	}
+	for _, segment := range strings.Split(c.Path, ".") {
+		if segment == "" {
+			return ErrInvalid.Wrapf("path %q contains an empty segment", c.Path)
+		}
+	}
+	var matchers int
+	if c.Equals != "" {
+		matchers++
+		if !json.Valid([]byte(c.Equals)) {
+			return ErrInvalid.Wrap("equals must be valid JSON")
+		}
+	}
+	if c.Range != nil {
+		matchers++
+		if err := c.Range.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(err, "range")
+		}
+	}
+	if len(c.AnyOf) != 0 {
+		matchers++
+		idx := make(map[string]struct{}, len(c.AnyOf))
+		for _, v := range c.AnyOf {
+			if v == "" {
+				return ErrEmpty.Wrap("any of value")
+			}
+			if _, exists := idx[v]; exists {
+				return ErrDuplicate.Wrapf("any of value %q", v)
+			}
+			idx[v] = struct{}{}
+		}
+	}
+	if c.Regex != "" {
+		matchers++
+		if len(c.Regex) > MaxJSONPathRegexLength {
+			return ErrLimit.Wrapf("regex length %d exceeds %d", len(c.Regex), MaxJSONPathRegexLength)
+		}
+		re, err := syntax.Parse(c.Regex, syntax.Perl)
+		if err != nil {
+			return ErrInvalid.Wrapf("regex: %s", err)
+		}
+		prog, err := syntax.Compile(re.Simplify())
+		if err != nil {
+			return ErrInvalid.Wrapf("regex: %s", err)
+		}
+		if len(prog.Inst) > MaxJSONPathRegexProgramSize {
+			return ErrLimit.Wrapf("regex program size %d exceeds %d", len(prog.Inst), MaxJSONPathRegexProgramSize)
+		}
+	}
+	if matchers != 1 {
+		return ErrInvalid.Wrap("exactly one matcher must be set")
+	}
+	return nil
+}
+
+// Contains returns true when the number is within the inclusive bounds
+func (r NumericRange) Contains(n sdk.Dec) bool {
+	if r.Min != "" && n.LT(sdk.MustNewDecFromStr(r.Min)) {
+		return false
+	}
+	if r.Max != "" && n.GT(sdk.MustNewDecFromStr(r.Max)) {
+		return false
+	}
+	return true
+}
+
+// ValidateBasic validates the range
+func (r NumericRange) ValidateBasic() error {
+	if r.Min == "" && r.Max == "" {
+		return ErrEmpty.Wrap("min or max")
+	}
+	var min, max sdk.Dec
+	var err error
+	if r.Min != "" {
+		if min, err = sdk.NewDecFromStr(r.Min); err != nil {
+			return ErrInvalid.Wrapf("min: %s", err)
+		}
+	}
+	if r.Max != "" {
+		if max, err = sdk.NewDecFromStr(r.Max); err != nil {
+			return ErrInvalid.Wrapf("max: %s", err)
+		}
+	}
+	if r.Min != "" && r.Max != "" && min.GT(max) {
+		return ErrInvalid.Wrap("min must not be greater than max")
+	}
+	return nil
+}
+
 var (
 	_ ContractAuthzLimitX = &UndefinedLimit{}
 	_ ContractAuthzLimitX = &MaxCallsLimit{}
 	_ ContractAuthzLimitX = &MaxFundsLimit{}
 	_ ContractAuthzLimitX = &CombinedLimit{}
+	_ ContractAuthzLimitX = &RollingWindowLimit{}
+	_ ContractAuthzLimitX = &CooldownLimit{}
+	_ ContractAuthzLimitX = &BlockTimeWindowsLimit{}
 )
 
 // UndefinedLimit null object that is always rejected in execution
@@ -531,3 +1773,243 @@ func (l CombinedLimit) ValidateBasic() error {
 	}
 	return nil
 }
+
+// NewRollingWindowLimit constructor
+// A panic will occur if the coin set is not valid.
+func NewRollingWindowLimit(window time.Duration, maxCalls uint64, maxAmounts ...sdk.Coin) *RollingWindowLimit {
+	return &RollingWindowLimit{Window: window, MaxCalls: maxCalls, MaxAmounts: sdk.NewCoins(maxAmounts...)}
+}
+
+// Accept while the calls and the token transfers within the window that ends at the block time are below the
+// max values. The usage is tracked per sub-window of RollingWindowLimitBuckets-th of the window duration.
+// Sub-windows that no longer overlap the window are removed.
+func (l RollingWindowLimit) Accept(ctx sdk.Context, msg AuthzableWasmMsg) (*ContractAuthzLimitAcceptResult, error) {
+	now := ctx.BlockTime()
+	usage, callsUsed, amountsUsed := l.usageAt(now)
+	if l.MaxCalls != 0 && callsUsed >= l.MaxCalls {
+		return &ContractAuthzLimitAcceptResult{Accepted: false}, nil
+	}
+	if !amountsUsed.Add(msg.GetFunds()...).IsAllLTE(l.MaxAmounts) {
+		return &ContractAuthzLimitAcceptResult{Accepted: false}, nil
+	}
+	current := now.Truncate(l.subWindow())
+	if n := len(usage); n != 0 && usage[n-1].Start.Equal(current) {
+		usage[n-1].Calls++
+		usage[n-1].Amounts = usage[n-1].Amounts.Add(msg.GetFunds()...)
+	} else {
+		usage = append(usage, WindowUsage{Start: current, Calls: 1, Amounts: sdk.NewCoins(msg.GetFunds()...)})
+	}
+	return &ContractAuthzLimitAcceptResult{Accepted: true, UpdateLimit: &RollingWindowLimit{
+		Window:     l.Window,
+		MaxCalls:   l.MaxCalls,
+		MaxAmounts: l.MaxAmounts,
+		Usage:      usage,
+	}}, nil
+}
+
+// usageAt returns the sub-windows that overlap the window ending at the given time with their total calls and tokens
+func (l RollingWindowLimit) usageAt(t time.Time) ([]WindowUsage, uint64, sdk.Coins) {
+	windowStart, subWindow := t.Add(-l.Window), l.subWindow()
+	var usage []WindowUsage
+	var calls uint64
+	amounts := sdk.NewCoins()
+	for _, u := range l.Usage {
+		if !u.Start.Add(subWindow).After(windowStart) {
+			continue
+		}
+		usage = append(usage, u)
+		calls += u.Calls
+		amounts = amounts.Add(u.Amounts...)
+	}
+	return usage, calls, amounts
+}
+
+// subWindow returns the duration of a sub-window
+func (l RollingWindowLimit) subWindow() time.Duration {
+	return l.Window / time.Duration(RollingWindowLimitBuckets)
+}
+
+// ValidateBasic validates the limit
+func (l RollingWindowLimit) ValidateBasic() error {
+	if l.Window <= 0 {
+		return ErrInvalid.Wrap("window must be positive")
+	}
+	if l.subWindow() < time.Second {
+		return ErrInvalid.Wrapf("window must be at least %d seconds", RollingWindowLimitBuckets)
+	}
+	if l.MaxCalls == 0 && l.MaxAmounts.Empty() {
+		return ErrEmpty.Wrap("max calls or max amounts")
+	}
+	if err := l.MaxAmounts.Validate(); err != nil {
+		return errorsmod.Wrap(err, "max amounts")
+	}
+	if len(l.Usage) > RollingWindowLimitBuckets+1 {
+		return ErrLimit.Wrapf("max %d usage entries", RollingWindowLimitBuckets+1)
+	}
+	var callsUsed uint64
+	amountsUsed := sdk.NewCoins()
+	for i, u := range l.Usage {
+		if i != 0 && !u.Start.After(l.Usage[i-1].Start) {
+			return ErrInvalid.Wrapf("usage %d must start after the previous usage", i)
+		}
+		if err := u.Amounts.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "usage %d amounts", i)
+		}
+		callsUsed += u.Calls
+		amountsUsed = amountsUsed.Add(u.Amounts...)
+	}
+	if l.MaxCalls != 0 && callsUsed > l.MaxCalls {
+		return ErrInvalid.Wrap("calls used exceed max calls")
+	}
+	if !amountsUsed.IsAllLTE(l.MaxAmounts) {
+		return ErrInvalid.Wrap("amounts used exceed max amounts")
+	}
+	return nil
+}
+
+// NewCooldownLimit constructor
+func NewCooldownLimit(cooldown time.Duration) *CooldownLimit {
+	return &CooldownLimit{Cooldown: cooldown}
+}
+
+// Accept when the cooldown has passed since the last call. No token transfers to the contract allowed.
+func (l CooldownLimit) Accept(ctx sdk.Context, msg AuthzableWasmMsg) (*ContractAuthzLimitAcceptResult, error) {
+	if !msg.GetFunds().Empty() {
+		return &ContractAuthzLimitAcceptResult{Accepted: false}, nil
+	}
+	now := ctx.BlockTime()
+	if !l.LastCall.IsZero() && now.Before(l.LastCall.Add(l.Cooldown)) {
+		return &ContractAuthzLimitAcceptResult{Accepted: false}, nil
+	}
+	return &ContractAuthzLimitAcceptResult{Accepted: true, UpdateLimit: &CooldownLimit{Cooldown: l.Cooldown, LastCall: now}}, nil
+}
+
+// ValidateBasic validates the limit
+func (l CooldownLimit) ValidateBasic() error {
+	if l.Cooldown <= 0 {
+		return ErrInvalid.Wrap("cooldown must be positive")
+	}
+	return nil
+}
+
+// NewBlockTimeWindowsLimit constructor
+func NewBlockTimeWindowsLimit(windows ...TimeWindow) *BlockTimeWindowsLimit {
+	return &BlockTimeWindowsLimit{Windows: windows}
+}
+
+// Accept only when the block time is within one of the windows. No token transfers to the contract allowed.
+// Windows that have passed are removed from the persisted state. The limit is lapsed when all windows have passed.
+func (l BlockTimeWindowsLimit) Accept(ctx sdk.Context, msg AuthzableWasmMsg) (*ContractAuthzLimitAcceptResult, error) {
+	if !msg.GetFunds().Empty() {
+		return &ContractAuthzLimitAcceptResult{Accepted: false}, nil
+	}
+	now := ctx.BlockTime()
+	for i, w := range l.Windows {
+		if !w.Contains(now) {
+			continue
+		}
+		if i == 0 {
+			return &ContractAuthzLimitAcceptResult{Accepted: true}, nil
+		}
+		return &ContractAuthzLimitAcceptResult{Accepted: true, UpdateLimit: NewBlockTimeWindowsLimit(l.Windows[i:]...)}, nil
+	}
+	return &ContractAuthzLimitAcceptResult{Accepted: false}, nil
+}
+
+// IsLapsed returns true when all windows have passed at the block time
+func (l BlockTimeWindowsLimit) IsLapsed(blockTime time.Time) bool {
+	return len(l.Windows) == 0 || !blockTime.Before(l.Windows[len(l.Windows)-1].End)
+}
+
+// ValidateBasic validates the limit
+func (l BlockTimeWindowsLimit) ValidateBasic() error {
+	if len(l.Windows) == 0 {
+		return ErrEmpty.Wrap("windows")
+	}
+	for i, w := range l.Windows {
+		if err := w.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "window %d", i)
+		}
+		if i != 0 && w.Start.Before(l.Windows[i-1].End) {
+			return ErrInvalid.Wrapf("window %d must start after the previous window ended", i)
+		}
+	}
+	return nil
+}
+
+// Contains returns true when the given time is within the window
+func (w TimeWindow) Contains(t time.Time) bool {
+	return !t.Before(w.Start) && t.Before(w.End)
+}
+
+// ValidateBasic validates the window
+func (w TimeWindow) ValidateBasic() error {
+	if w.Start.IsZero() {
+		return ErrEmpty.Wrap("start")
+	}
+	if !w.Start.Before(w.End) {
+		return ErrInvalid.Wrap("end must be after start")
+	}
+	return nil
+}
+
+// ContractAuthzLimitRemaining is implemented by limits that can report their remaining usage
+type ContractAuthzLimitRemaining interface {
+	// RemainingUsage returns the usage left at the given block time
+	RemainingUsage(blockTime time.Time) ContractAuthzRemaining
+}
+
+var (
+	_ ContractAuthzLimitRemaining = &MaxCallsLimit{}
+	_ ContractAuthzLimitRemaining = &MaxFundsLimit{}
+	_ ContractAuthzLimitRemaining = &CombinedLimit{}
+	_ ContractAuthzLimitRemaining = &RollingWindowLimit{}
+	_ ContractAuthzLimitRemaining = &CooldownLimit{}
+	_ ContractAuthzLimitRemaining = &BlockTimeWindowsLimit{}
+)
+
+// RemainingUsage returns the calls left. No funds transferable.
+func (l MaxCallsLimit) RemainingUsage(_ time.Time) ContractAuthzRemaining {
+	return ContractAuthzRemaining{CallsLimited: true, Calls: l.Remaining, FundsLimited: true, Funds: sdk.NewCoins()}
+}
+
+// RemainingUsage returns the funds left
+func (l MaxFundsLimit) RemainingUsage(_ time.Time) ContractAuthzRemaining {
+	return ContractAuthzRemaining{FundsLimited: true, Funds: l.Amounts}
+}
+
+// RemainingUsage returns the calls and funds left
+func (l CombinedLimit) RemainingUsage(_ time.Time) ContractAuthzRemaining {
+	return ContractAuthzRemaining{CallsLimited: true, Calls: l.CallsRemaining, FundsLimited: true, Funds: l.Amounts}
+}
+
+// RemainingUsage returns the calls and funds left in the window that ends at the block time
+func (l RollingWindowLimit) RemainingUsage(blockTime time.Time) ContractAuthzRemaining {
+	_, callsUsed, amountsUsed := l.usageAt(blockTime)
+	result := ContractAuthzRemaining{FundsLimited: true, Funds: sdk.NewCoins()}
+	if l.MaxCalls != 0 {
+		result.CallsLimited = true
+		if callsUsed < l.MaxCalls {
+			result.Calls = l.MaxCalls - callsUsed
+		}
+	}
+	if funds, isNegative := l.MaxAmounts.SafeSub(amountsUsed...); !isNegative {
+		result.Funds = funds
+	}
+	return result
+}
+
+// RemainingUsage returns unlimited calls. No funds transferable.
+func (l CooldownLimit) RemainingUsage(_ time.Time) ContractAuthzRemaining {
+	return ContractAuthzRemaining{FundsLimited: true, Funds: sdk.NewCoins()}
+}
+
+// RemainingUsage returns unlimited calls while there are current or future windows. No funds transferable.
+func (l BlockTimeWindowsLimit) RemainingUsage(blockTime time.Time) ContractAuthzRemaining {
+	for _, w := range l.Windows {
+		if blockTime.Before(w.End) {
+			return ContractAuthzRemaining{FundsLimited: true, Funds: sdk.NewCoins()}
+		}
+	}
+	return ContractAuthzRemaining{CallsLimited: true, FundsLimited: true, Funds: sdk.NewCoins()}
+}
@@ -8,6 +8,7 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
@@ -16,6 +17,9 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -23,6 +27,7 @@ var (
 	_ = proto.Marshal
 	_ = fmt.Errorf
 	_ = math.Inf
+	_ = time.Kitchen
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -121,34 +126,31 @@ func (m *ContractMigrationAuthorization) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ContractMigrationAuthorization proto.InternalMessageInfo
 
-// ContractGrant a granted permission for a single contract
-// Since: wasmd 0.30
-type ContractGrant struct {
-	// Contract is the bech32 address of the smart contract
-	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
-	// Limit defines execution limits that are enforced and updated when the grant
-	// is applied. When the limit lapsed the grant is removed.
-	Limit *types.Any `protobuf:"bytes,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	// Filter define more fine-grained control on the message payload passed
-	// to the contract in the operation. When no filter applies on execution, the
-	// operation is prohibited.
-	Filter *types.Any `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+// ExecuteContractsAuthorization defines authorization for executing multiple
+// contracts with MsgExecuteContracts. Each execution must be accepted by the
+// grants. Filters are applied to the messages before response templates are
+// resolved, so executions with response templates are accepted only by grants
+// with the AllowAllMessagesFilter.
+// Since: wasmd 0.41
+type ExecuteContractsAuthorization struct {
+	// Grants for contract executions
+	Grants []ContractGrant `protobuf:"bytes,1,rep,name=grants,proto3" json:"grants"`
 }
 
-func (m *ContractGrant) Reset()         { *m = ContractGrant{} }
-func (m *ContractGrant) String() string { return proto.CompactTextString(m) }
-func (*ContractGrant) ProtoMessage()    {}
-func (*ContractGrant) Descriptor() ([]byte, []int) {
+func (m *ExecuteContractsAuthorization) Reset()         { *m = ExecuteContractsAuthorization{} }
+func (m *ExecuteContractsAuthorization) String() string { return proto.CompactTextString(m) }
+func (*ExecuteContractsAuthorization) ProtoMessage()    {}
+func (*ExecuteContractsAuthorization) Descriptor() ([]byte, []int) {
 	return fileDescriptor_36ff3a20cf32b258, []int{2}
 }
 
-func (m *ContractGrant) XXX_Unmarshal(b []byte) error {
+func (m *ExecuteContractsAuthorization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
 
-func (m *ContractGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ExecuteContractsAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ContractGrant.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ExecuteContractsAuthorization.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -159,41 +161,42 @@ func (m *ContractGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 	}
 }
 
-func (m *ContractGrant) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContractGrant.Merge(m, src)
+func (m *ExecuteContractsAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecuteContractsAuthorization.Merge(m, src)
 }
 
-func (m *ContractGrant) XXX_Size() int {
+func (m *ExecuteContractsAuthorization) XXX_Size() int {
 	return m.Size()
 }
 
-func (m *ContractGrant) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContractGrant.DiscardUnknown(m)
+func (m *ExecuteContractsAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecuteContractsAuthorization.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ContractGrant proto.InternalMessageInfo
+var xxx_messageInfo_ExecuteContractsAuthorization proto.InternalMessageInfo
 
-// MaxCallsLimit limited number of calls to the contract. No funds transferable.
-// Since: wasmd 0.30
-type MaxCallsLimit struct {
-	// Remaining number that is decremented on each execution
-	Remaining uint64 `protobuf:"varint,1,opt,name=remaining,proto3" json:"remaining,omitempty"`
+// ContractInstantiationAuthorization defines authorization for wasm
+// instantiate.
+// Since: wasmd 0.41
+type ContractInstantiationAuthorization struct {
+	// Grants for contract instantiations
+	Grants []InstantiateGrant `protobuf:"bytes,1,rep,name=grants,proto3" json:"grants"`
 }
 
-func (m *MaxCallsLimit) Reset()         { *m = MaxCallsLimit{} }
-func (m *MaxCallsLimit) String() string { return proto.CompactTextString(m) }
-func (*MaxCallsLimit) ProtoMessage()    {}
-func (*MaxCallsLimit) Descriptor() ([]byte, []int) {
+func (m *ContractInstantiationAuthorization) Reset()         { *m = ContractInstantiationAuthorization{} }
+func (m *ContractInstantiationAuthorization) String() string { return proto.CompactTextString(m) }
+func (*ContractInstantiationAuthorization) ProtoMessage()    {}
+func (*ContractInstantiationAuthorization) Descriptor() ([]byte, []int) {
 	return fileDescriptor_36ff3a20cf32b258, []int{3}
 }
 
-func (m *MaxCallsLimit) XXX_Unmarshal(b []byte) error {
+func (m *ContractInstantiationAuthorization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
 
-func (m *MaxCallsLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ContractInstantiationAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MaxCallsLimit.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ContractInstantiationAuthorization.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -204,41 +207,42 @@ func (m *MaxCallsLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 	}
 }
 
-func (m *MaxCallsLimit) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MaxCallsLimit.Merge(m, src)
+func (m *ContractInstantiationAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractInstantiationAuthorization.Merge(m, src)
 }
 
-func (m *MaxCallsLimit) XXX_Size() int {
+func (m *ContractInstantiationAuthorization) XXX_Size() int {
 	return m.Size()
 }
 
-func (m *MaxCallsLimit) XXX_DiscardUnknown() {
-	xxx_messageInfo_MaxCallsLimit.DiscardUnknown(m)
+func (m *ContractInstantiationAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractInstantiationAuthorization.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MaxCallsLimit proto.InternalMessageInfo
+var xxx_messageInfo_ContractInstantiationAuthorization proto.InternalMessageInfo
 
-// MaxFundsLimit defines the maximal amounts that can be sent to the contract.
-// Since: wasmd 0.30
-type MaxFundsLimit struct {
-	// Amounts is the maximal amount of tokens transferable to the contract.
-	Amounts github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amounts,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amounts"`
+// ContractInstantiation2Authorization defines authorization for wasm
+// instantiate with a predictable address.
+// Since: wasmd 0.41
+type ContractInstantiation2Authorization struct {
+	// Grants for contract instantiations
+	Grants []InstantiateGrant `protobuf:"bytes,1,rep,name=grants,proto3" json:"grants"`
 }
 
-func (m *MaxFundsLimit) Reset()         { *m = MaxFundsLimit{} }
-func (m *MaxFundsLimit) String() string { return proto.CompactTextString(m) }
-func (*MaxFundsLimit) ProtoMessage()    {}
-func (*MaxFundsLimit) Descriptor() ([]byte, []int) {
+func (m *ContractInstantiation2Authorization) Reset()         { *m = ContractInstantiation2Authorization{} }
+func (m *ContractInstantiation2Authorization) String() string { return proto.CompactTextString(m) }
+func (*ContractInstantiation2Authorization) ProtoMessage()    {}
+func (*ContractInstantiation2Authorization) Descriptor() ([]byte, []int) {
 	return fileDescriptor_36ff3a20cf32b258, []int{4}
 }
 
-func (m *MaxFundsLimit) XXX_Unmarshal(b []byte) error {
+func (m *ContractInstantiation2Authorization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
 
-func (m *MaxFundsLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ContractInstantiation2Authorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MaxFundsLimit.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ContractInstantiation2Authorization.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -249,44 +253,41 @@ func (m *MaxFundsLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 	}
 }
 
-func (m *MaxFundsLimit) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MaxFundsLimit.Merge(m, src)
+func (m *ContractInstantiation2Authorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractInstantiation2Authorization.Merge(m, src)
 }
 
-func (m *MaxFundsLimit) XXX_Size() int {
+func (m *ContractInstantiation2Authorization) XXX_Size() int {
 	return m.Size()
 }
 
-func (m *MaxFundsLimit) XXX_DiscardUnknown() {
-	xxx_messageInfo_MaxFundsLimit.DiscardUnknown(m)
+func (m *ContractInstantiation2Authorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractInstantiation2Authorization.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MaxFundsLimit proto.InternalMessageInfo
+var xxx_messageInfo_ContractInstantiation2Authorization proto.InternalMessageInfo
 
-// CombinedLimit defines the maximal amounts that can be sent to a contract and
-// the maximal number of calls executable. Both need to remain >0 to be valid.
-// Since: wasmd 0.30
-type CombinedLimit struct {
-	// Remaining number that is decremented on each execution
-	CallsRemaining uint64 `protobuf:"varint,1,opt,name=calls_remaining,json=callsRemaining,proto3" json:"calls_remaining,omitempty"`
-	// Amounts is the maximal amount of tokens transferable to the contract.
-	Amounts github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amounts,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amounts"`
+// StoreCodeAuthorization defines authorization for wasm code upload.
+// Since: wasmd 0.41
+type StoreCodeAuthorization struct {
+	// Grants for code upload
+	Grants []CodeGrant `protobuf:"bytes,1,rep,name=grants,proto3" json:"grants"`
 }
 
-func (m *CombinedLimit) Reset()         { *m = CombinedLimit{} }
-func (m *CombinedLimit) String() string { return proto.CompactTextString(m) }
-func (*CombinedLimit) ProtoMessage()    {}
-func (*CombinedLimit) Descriptor() ([]byte, []int) {
+func (m *StoreCodeAuthorization) Reset()         { *m = StoreCodeAuthorization{} }
+func (m *StoreCodeAuthorization) String() string { return proto.CompactTextString(m) }
+func (*StoreCodeAuthorization) ProtoMessage()    {}
+func (*StoreCodeAuthorization) Descriptor() ([]byte, []int) {
 	return fileDescriptor_36ff3a20cf32b258, []int{5}
 }
 
-func (m *CombinedLimit) XXX_Unmarshal(b []byte) error {
+func (m *StoreCodeAuthorization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
 
-func (m *CombinedLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *StoreCodeAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CombinedLimit.Marshal(b, m, deterministic)
+		return xxx_messageInfo_StoreCodeAuthorization.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -297,39 +298,43 @@ func (m *CombinedLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 	}
 }
 
-func (m *CombinedLimit) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CombinedLimit.Merge(m, src)
+func (m *StoreCodeAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreCodeAuthorization.Merge(m, src)
 }
 
-func (m *CombinedLimit) XXX_Size() int {
+func (m *StoreCodeAuthorization) XXX_Size() int {
 	return m.Size()
 }
 
-func (m *CombinedLimit) XXX_DiscardUnknown() {
-	xxx_messageInfo_CombinedLimit.DiscardUnknown(m)
+func (m *StoreCodeAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreCodeAuthorization.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CombinedLimit proto.InternalMessageInfo
+var xxx_messageInfo_StoreCodeAuthorization proto.InternalMessageInfo
 
-// AllowAllMessagesFilter is a wildcard to allow any type of contract payload
-// message.
-// Since: wasmd 0.30
-type AllowAllMessagesFilter struct{}
+// ContractUpdateAdminAuthorization defines authorization for setting a new
+// contract admin. The filter is applied to the JSON document
+// {"new_admin":"<address>"}.
+// Since: wasmd 0.41
+type ContractUpdateAdminAuthorization struct {
+	// Grants for contract admin updates
+	Grants []ContractGrant `protobuf:"bytes,1,rep,name=grants,proto3" json:"grants"`
+}
 
-func (m *AllowAllMessagesFilter) Reset()         { *m = AllowAllMessagesFilter{} }
-func (m *AllowAllMessagesFilter) String() string { return proto.CompactTextString(m) }
-func (*AllowAllMessagesFilter) ProtoMessage()    {}
-func (*AllowAllMessagesFilter) Descriptor() ([]byte, []int) {
+func (m *ContractUpdateAdminAuthorization) Reset()         { *m = ContractUpdateAdminAuthorization{} }
+func (m *ContractUpdateAdminAuthorization) String() string { return proto.CompactTextString(m) }
+func (*ContractUpdateAdminAuthorization) ProtoMessage()    {}
+func (*ContractUpdateAdminAuthorization) Descriptor() ([]byte, []int) {
 	return fileDescriptor_36ff3a20cf32b258, []int{6}
 }
 
-func (m *AllowAllMessagesFilter) XXX_Unmarshal(b []byte) error {
+func (m *ContractUpdateAdminAuthorization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
 
-func (m *AllowAllMessagesFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ContractUpdateAdminAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AllowAllMessagesFilter.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ContractUpdateAdminAuthorization.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -340,42 +345,42 @@ func (m *AllowAllMessagesFilter) XXX_Marshal(b []byte, deterministic bool) ([]by
 	}
 }
 
-func (m *AllowAllMessagesFilter) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AllowAllMessagesFilter.Merge(m, src)
+func (m *ContractUpdateAdminAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractUpdateAdminAuthorization.Merge(m, src)
 }
 
-func (m *AllowAllMessagesFilter) XXX_Size() int {
+func (m *ContractUpdateAdminAuthorization) XXX_Size() int {
 	return m.Size()
 }
 
-func (m *AllowAllMessagesFilter) XXX_DiscardUnknown() {
-	xxx_messageInfo_AllowAllMessagesFilter.DiscardUnknown(m)
+func (m *ContractUpdateAdminAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractUpdateAdminAuthorization.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AllowAllMessagesFilter proto.InternalMessageInfo
+var xxx_messageInfo_ContractUpdateAdminAuthorization proto.InternalMessageInfo
 
-// AcceptedMessageKeysFilter accept only the specific contract message keys in
-// the json object to be executed.
-// Since: wasmd 0.30
-type AcceptedMessageKeysFilter struct {
-	// Messages is the list of unique keys
-	Keys []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+// ContractClearAdminAuthorization defines authorization for clearing the
+// contract admin. The filter is applied to the empty JSON document {}.
+// Since: wasmd 0.41
+type ContractClearAdminAuthorization struct {
+	// Grants for clearing contract admins
+	Grants []ContractGrant `protobuf:"bytes,1,rep,name=grants,proto3" json:"grants"`
 }
 
-func (m *AcceptedMessageKeysFilter) Reset()         { *m = AcceptedMessageKeysFilter{} }
-func (m *AcceptedMessageKeysFilter) String() string { return proto.CompactTextString(m) }
-func (*AcceptedMessageKeysFilter) ProtoMessage()    {}
-func (*AcceptedMessageKeysFilter) Descriptor() ([]byte, []int) {
+func (m *ContractClearAdminAuthorization) Reset()         { *m = ContractClearAdminAuthorization{} }
+func (m *ContractClearAdminAuthorization) String() string { return proto.CompactTextString(m) }
+func (*ContractClearAdminAuthorization) ProtoMessage()    {}
+func (*ContractClearAdminAuthorization) Descriptor() ([]byte, []int) {
 	return fileDescriptor_36ff3a20cf32b258, []int{7}
 }
 
-func (m *AcceptedMessageKeysFilter) XXX_Unmarshal(b []byte) error {
+func (m *ContractClearAdminAuthorization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
 
-func (m *AcceptedMessageKeysFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ContractClearAdminAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AcceptedMessageKeysFilter.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ContractClearAdminAuthorization.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -386,42 +391,73 @@ func (m *AcceptedMessageKeysFilter) XXX_Marshal(b []byte, deterministic bool) ([
 	}
 }
 
-func (m *AcceptedMessageKeysFilter) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AcceptedMessageKeysFilter.Merge(m, src)
+func (m *ContractClearAdminAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractClearAdminAuthorization.Merge(m, src)
 }
 
-func (m *AcceptedMessageKeysFilter) XXX_Size() int {
+func (m *ContractClearAdminAuthorization) XXX_Size() int {
 	return m.Size()
 }
 
-func (m *AcceptedMessageKeysFilter) XXX_DiscardUnknown() {
-	xxx_messageInfo_AcceptedMessageKeysFilter.DiscardUnknown(m)
+func (m *ContractClearAdminAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractClearAdminAuthorization.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AcceptedMessageKeysFilter proto.InternalMessageInfo
+var xxx_messageInfo_ContractClearAdminAuthorization proto.InternalMessageInfo
 
-// AcceptedMessagesFilter accept only the specific raw contract messages to be
-// executed.
+// ContractGrant a granted permission for a single contract or for all
+// contracts matching code ids or a creator. Exactly one of contract, code_ids
+// or creator must be set.
+// Grants for code ids or a creator resolve the contract with the wasm
+// ContractInfoSourceDecorator of the ante handler. Messages that do not pass
+// the ante handler, like those executed by gov or an ICA host, fail with an
+// error for these grants.
 // Since: wasmd 0.30
-type AcceptedMessagesFilter struct {
-	// Messages is the list of raw contract messages
-	Messages []RawContractMessage `protobuf:"bytes,1,rep,name=messages,proto3,casttype=RawContractMessage" json:"messages,omitempty"`
+type ContractGrant struct {
+	// Contract is the bech32 address of the smart contract
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// Limit defines execution limits that are enforced and updated when the grant
+	// is applied. When the limit lapsed the grant is removed.
+	// For grants matching code ids or a creator, this is the initial limit for
+	// each matched contract. See total_limit for the limit of all contracts.
+	Limit *types.Any `protobuf:"bytes,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Filter define more fine-grained control on the message payload passed
+	// to the contract in the operation. When no filter applies on execution, the
+	// operation is prohibited.
+	Filter *types.Any `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// CodeIDs matches any contract instantiated from one of the codes.
+	// Since: wasmd 0.41
+	CodeIDs []uint64 `protobuf:"varint,4,rep,packed,name=code_ids,json=codeIds,proto3" json:"code_ids,omitempty"`
+	// Creator matches any contract created by this bech32 address.
+	// Since: wasmd 0.41
+	Creator string `protobuf:"bytes,5,opt,name=creator,proto3" json:"creator,omitempty"`
+	// ContractLimits are the limit states of the contracts matched by code ids
+	// or creator. They are tracked per contract. A lapsed contract limit is
+	// removed so that the contract starts with the initial limit again, bound by
+	// the total limit.
+	// Since: wasmd 0.41
+	ContractLimits []ContractLimit `protobuf:"bytes,6,rep,name=contract_limits,json=contractLimits,proto3" json:"contract_limits"`
+	// TotalLimit is the limit for all contracts matched by code ids or creator
+	// together. It is required for these grants and enforced together with the
+	// limit of the matched contract. When the limit lapsed the grant is removed.
+	// Since: wasmd 0.41
+	TotalLimit *types.Any `protobuf:"bytes,7,opt,name=total_limit,json=totalLimit,proto3" json:"total_limit,omitempty"`
 }
 
-func (m *AcceptedMessagesFilter) Reset()         { *m = AcceptedMessagesFilter{} }
-func (m *AcceptedMessagesFilter) String() string { return proto.CompactTextString(m) }
-func (*AcceptedMessagesFilter) ProtoMessage()    {}
-func (*AcceptedMessagesFilter) Descriptor() ([]byte, []int) {
+func (m *ContractGrant) Reset()         { *m = ContractGrant{} }
+func (m *ContractGrant) String() string { return proto.CompactTextString(m) }
+func (*ContractGrant) ProtoMessage()    {}
+func (*ContractGrant) Descriptor() ([]byte, []int) {
 	return fileDescriptor_36ff3a20cf32b258, []int{8}
 }
 
-func (m *AcceptedMessagesFilter) XXX_Unmarshal(b []byte) error {
+func (m *ContractGrant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
 
-func (m *AcceptedMessagesFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ContractGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AcceptedMessagesFilter.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ContractGrant.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -432,558 +468,4658 @@ func (m *AcceptedMessagesFilter) XXX_Marshal(b []byte, deterministic bool) ([]by
 	}
 }
 
-func (m *AcceptedMessagesFilter) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AcceptedMessagesFilter.Merge(m, src)
+func (m *ContractGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractGrant.Merge(m, src)
 }
 
-func (m *AcceptedMessagesFilter) XXX_Size() int {
+func (m *ContractGrant) XXX_Size() int {
 	return m.Size()
 }
 
-func (m *AcceptedMessagesFilter) XXX_DiscardUnknown() {
-	xxx_messageInfo_AcceptedMessagesFilter.DiscardUnknown(m)
+func (m *ContractGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractGrant.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AcceptedMessagesFilter proto.InternalMessageInfo
+var xxx_messageInfo_ContractGrant proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*ContractExecutionAuthorization)(nil), "cosmwasm.wasm.v1.ContractExecutionAuthorization")
-	proto.RegisterType((*ContractMigrationAuthorization)(nil), "cosmwasm.wasm.v1.ContractMigrationAuthorization")
-	proto.RegisterType((*ContractGrant)(nil), "cosmwasm.wasm.v1.ContractGrant")
-	proto.RegisterType((*MaxCallsLimit)(nil), "cosmwasm.wasm.v1.MaxCallsLimit")
-	proto.RegisterType((*MaxFundsLimit)(nil), "cosmwasm.wasm.v1.MaxFundsLimit")
-	proto.RegisterType((*CombinedLimit)(nil), "cosmwasm.wasm.v1.CombinedLimit")
-	proto.RegisterType((*AllowAllMessagesFilter)(nil), "cosmwasm.wasm.v1.AllowAllMessagesFilter")
-	proto.RegisterType((*AcceptedMessageKeysFilter)(nil), "cosmwasm.wasm.v1.AcceptedMessageKeysFilter")
-	proto.RegisterType((*AcceptedMessagesFilter)(nil), "cosmwasm.wasm.v1.AcceptedMessagesFilter")
+// ContractLimit is the limit state of a single contract matched by a
+// ContractGrant with code ids or creator.
+// Since: wasmd 0.41
+type ContractLimit struct {
+	// Contract is the bech32 address of the smart contract
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// Limit is the current limit state for the contract
+	Limit *types.Any `protobuf:"bytes,2,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func init() { proto.RegisterFile("cosmwasm/wasm/v1/authz.proto", fileDescriptor_36ff3a20cf32b258) }
+func (m *ContractLimit) Reset()         { *m = ContractLimit{} }
+func (m *ContractLimit) String() string { return proto.CompactTextString(m) }
+func (*ContractLimit) ProtoMessage()    {}
+func (*ContractLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36ff3a20cf32b258, []int{9}
+}
 
-var fileDescriptor_36ff3a20cf32b258 = []byte{
-	// 665 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0x4d, 0x4f, 0xd4, 0x40,
-	0x18, 0xde, 0x01, 0x44, 0x76, 0x10, 0x3f, 0x1a, 0x42, 0x16, 0x24, 0x5d, 0x52, 0xbf, 0x56, 0x92,
-	0x6d, 0xb3, 0x18, 0x2f, 0x7b, 0x31, 0xbb, 0xab, 0x18, 0x23, 0x78, 0x68, 0x4c, 0x20, 0x5e, 0xc8,
-	0xb4, 0x3b, 0x94, 0x91, 0x76, 0x86, 0x74, 0xa6, 0xc0, 0x92, 0xf8, 0x07, 0x3c, 0x79, 0xf3, 0x2f,
-	0x18, 0x4f, 0x1c, 0xf6, 0xe8, 0x0f, 0x20, 0x9c, 0x38, 0x1a, 0x0f, 0xa8, 0x10, 0xc3, 0x7f, 0xf0,
-	0x64, 0x3a, 0x33, 0x5d, 0x58, 0x02, 0x1b, 0xe4, 0xc4, 0x65, 0xda, 0xf7, 0x7d, 0x67, 0x9e, 0xe7,
-	0x79, 0x3f, 0xda, 0x81, 0x93, 0x3e, 0xe3, 0xd1, 0x06, 0xe2, 0x91, 0x23, 0x97, 0xf5, 0x8a, 0x83,
-	0x12, 0xb1, 0xb2, 0x65, 0xaf, 0xc5, 0x4c, 0x30, 0xe3, 0x76, 0x16, 0xb5, 0xe5, 0xb2, 0x5e, 0x99,
-	0x18, 0x0d, 0x58, 0xc0, 0x64, 0xd0, 0x49, 0xdf, 0xd4, 0xbe, 0x89, 0xf1, 0x74, 0x1f, 0xe3, 0x4b,
-	0x2a, 0xa0, 0x0c, 0x1d, 0x32, 0x95, 0xe5, 0x78, 0x88, 0x63, 0x67, 0xbd, 0xe2, 0x61, 0x81, 0x2a,
-	0x8e, 0xcf, 0x08, 0xcd, 0x8e, 0x06, 0x8c, 0x05, 0x21, 0x76, 0xa4, 0xe5, 0x25, 0xcb, 0x0e, 0xa2,
-	0x2d, 0x1d, 0xba, 0x83, 0x22, 0x42, 0x99, 0x23, 0x57, 0xe5, 0xb2, 0xda, 0x00, 0x9a, 0x0d, 0x46,
-	0x45, 0x8c, 0x7c, 0xf1, 0x62, 0x13, 0xfb, 0x89, 0x20, 0x8c, 0xd6, 0x12, 0xb1, 0xc2, 0x62, 0xb2,
-	0x85, 0x52, 0xc3, 0xa8, 0xc3, 0xc1, 0x20, 0x46, 0x54, 0xf0, 0x02, 0x98, 0xea, 0x2f, 0x0d, 0xcf,
-	0x14, 0xed, 0xd3, 0x49, 0xd8, 0x19, 0xc2, 0xcb, 0x74, 0x5f, 0x3d, 0xbf, 0xb3, 0x5f, 0xcc, 0x7d,
-	0x39, 0xda, 0x9e, 0x06, 0xae, 0x3e, 0x59, 0x7d, 0xb3, 0xdb, 0x2e, 0x5b, 0x3a, 0x0d, 0x55, 0x0f,
-	0xad, 0xdc, 0xee, 0xe2, 0xfa, 0x78, 0xb4, 0x3d, 0x7d, 0x4f, 0xd6, 0xad, 0xb7, 0xa6, 0x2e, 0xd9,
-	0xf3, 0x24, 0x88, 0xd1, 0x15, 0x93, 0x7d, 0xb6, 0x26, 0xeb, 0x07, 0x80, 0x23, 0x5d, 0xa4, 0xc6,
-	0x04, 0x1c, 0xf2, 0xb5, 0xa3, 0x00, 0xa6, 0x40, 0x29, 0xef, 0x76, 0x6c, 0xe3, 0x2d, 0xbc, 0x16,
-	0x92, 0x88, 0x88, 0x42, 0xdf, 0x14, 0x28, 0x0d, 0xcf, 0x8c, 0xda, 0xaa, 0xb3, 0x76, 0xd6, 0x59,
-	0xbb, 0x46, 0x5b, 0xf5, 0xd2, 0x6e, 0xbb, 0x7c, 0xff, 0xdc, 0xcc, 0x52, 0xfa, 0xad, 0xb9, 0x14,
-	0x64, 0xd1, 0x55, 0x60, 0xc6, 0x02, 0x1c, 0x5c, 0x26, 0xa1, 0xc0, 0x71, 0xa1, 0xbf, 0x07, 0xec,
-	0xe3, 0xdd, 0x76, 0xf9, 0x41, 0x6f, 0xd8, 0x59, 0x89, 0xb2, 0xe8, 0x6a, 0x38, 0x8b, 0xc2, 0x91,
-	0x79, 0xb4, 0xd9, 0x40, 0x61, 0xc8, 0x25, 0xa3, 0x31, 0x09, 0xf3, 0x31, 0x8e, 0x10, 0xa1, 0x84,
-	0x06, 0x32, 0xb9, 0x01, 0xf7, 0xd8, 0x51, 0x7d, 0x76, 0x51, 0xe1, 0x69, 0x75, 0x0d, 0x59, 0xdd,
-	0x2e, 0x78, 0xeb, 0x1b, 0x90, 0x84, 0xb3, 0x09, 0x6d, 0x6a, 0xc2, 0xf7, 0xf0, 0x3a, 0x8a, 0x58,
-	0x72, 0xdc, 0xf3, 0x71, 0x5b, 0x37, 0x2f, 0xfd, 0x58, 0x3a, 0xbd, 0x6b, 0x30, 0x42, 0xeb, 0x4f,
-	0xd3, 0x6e, 0x7f, 0xfd, 0x59, 0x2c, 0x05, 0x44, 0xac, 0x24, 0x9e, 0xed, 0xb3, 0x48, 0x7f, 0x67,
-	0xfa, 0x51, 0xe6, 0xcd, 0x55, 0x47, 0xb4, 0xd6, 0x30, 0x97, 0x07, 0xb8, 0x9a, 0x8c, 0x8c, 0xe0,
-	0x92, 0xf2, 0x8f, 0xc5, 0x5a, 0x7f, 0xe4, 0x2c, 0x44, 0x1e, 0xa1, 0xb8, 0xa9, 0xe4, 0x3f, 0x82,
-	0xb7, 0xfc, 0x34, 0xbd, 0xa5, 0xd3, 0x55, 0xbb, 0x29, 0xdd, 0x6e, 0xe6, 0x3d, 0x99, 0x67, 0xdf,
-	0x15, 0xcc, 0xb3, 0x2b, 0x2b, 0xcb, 0x87, 0x63, 0xb5, 0x30, 0x64, 0x1b, 0xb5, 0x30, 0x9c, 0xc7,
-	0x9c, 0xa3, 0x00, 0x73, 0x35, 0x39, 0xd5, 0x57, 0x17, 0x9e, 0xb1, 0x14, 0xfb, 0xae, 0xc4, 0x3e,
-	0x1b, 0xca, 0xfa, 0x00, 0xc7, 0x6b, 0xbe, 0x8f, 0xd7, 0x04, 0x6e, 0xea, 0xc8, 0x6b, 0xdc, 0xd2,
-	0x41, 0xc3, 0x80, 0x03, 0xab, 0xb8, 0xa5, 0x66, 0x22, 0xef, 0xca, 0xf7, 0xea, 0xdc, 0x7f, 0x71,
-	0x9b, 0x8a, 0xfb, 0x3c, 0x06, 0xeb, 0x33, 0x80, 0x63, 0xa7, 0xa2, 0x19, 0xf9, 0x0c, 0x1c, 0x8a,
-	0xb4, 0x47, 0x0a, 0xb8, 0x51, 0x1f, 0xfb, 0xbb, 0x5f, 0x34, 0x5c, 0xb4, 0xd1, 0xf9, 0x57, 0xa8,
-	0xb0, 0xdb, 0xd9, 0x77, 0xb9, 0xc2, 0x9c, 0x49, 0x5f, 0x7f, 0xbe, 0xf3, 0xdb, 0xcc, 0xed, 0x1c,
-	0x98, 0x60, 0xef, 0xc0, 0x04, 0xbf, 0x0e, 0x4c, 0xf0, 0xe9, 0xd0, 0xcc, 0xed, 0x1d, 0x9a, 0xb9,
-	0xef, 0x87, 0x66, 0xee, 0xdd, 0xc3, 0x13, 0x43, 0xd1, 0x60, 0x3c, 0x5a, 0xc8, 0xee, 0xad, 0xa6,
-	0xb3, 0xa9, 0xee, 0x2f, 0x39, 0x18, 0xde, 0xa0, 0xfc, 0x37, 0x3c, 0xf9, 0x17, 0x00, 0x00, 0xff,
-	0xff, 0x84, 0xc4, 0x51, 0x3d, 0xdd, 0x06, 0x00, 0x00,
+func (m *ContractLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
 
-func (m *ContractExecutionAuthorization) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ContractLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
 
-func (m *ContractExecutionAuthorization) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ContractLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractLimit.Merge(m, src)
 }
 
-func (m *ContractExecutionAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Grants) > 0 {
-		for iNdEx := len(m.Grants) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Grants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAuthz(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func (m *ContractLimit) XXX_Size() int {
+	return m.Size()
 }
 
-func (m *ContractMigrationAuthorization) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ContractLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractLimit.DiscardUnknown(m)
 }
 
-func (m *ContractMigrationAuthorization) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ContractLimit proto.InternalMessageInfo
+
+// InstantiateGrant a granted permission to instantiate contracts from a
+// single code
+// Since: wasmd 0.41
+type InstantiateGrant struct {
+	// CodeID is the reference to the stored WASM code
+	CodeID uint64 `protobuf:"varint,1,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+	// Labels the contract label must be one of. Any label is accepted when empty.
+	Labels []string `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty"`
+	// Limit defines execution limits that are enforced and updated when the grant
+	// is applied. When the limit lapsed the grant is removed.
+	Limit *types.Any `protobuf:"bytes,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Filter define more fine-grained control on the instantiate message payload.
+	// When no filter applies on execution, the operation is prohibited.
+	Filter *types.Any `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Admins the contract admin must be one of. When empty, the contract must be
+	// instantiated without an admin or with the granter as admin.
+	Admins []string `protobuf:"bytes,5,rep,name=admins,proto3" json:"admins,omitempty"`
 }
 
-func (m *ContractMigrationAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Grants) > 0 {
-		for iNdEx := len(m.Grants) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Grants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAuthz(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func (m *InstantiateGrant) Reset()         { *m = InstantiateGrant{} }
+func (m *InstantiateGrant) String() string { return proto.CompactTextString(m) }
+func (*InstantiateGrant) ProtoMessage()    {}
+func (*InstantiateGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36ff3a20cf32b258, []int{10}
 }
 
-func (m *ContractGrant) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *ContractGrant) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *InstantiateGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
 
-func (m *ContractGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Filter != nil {
-		{
-			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAuthz(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Limit != nil {
-		{
-			size, err := m.Limit.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAuthz(dAtA, i, uint64(size))
+func (m *InstantiateGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InstantiateGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Contract) > 0 {
-		i -= len(m.Contract)
-		copy(dAtA[i:], m.Contract)
-		i = encodeVarintAuthz(dAtA, i, uint64(len(m.Contract)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
 
-func (m *MaxCallsLimit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *InstantiateGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InstantiateGrant.Merge(m, src)
 }
 
-func (m *MaxCallsLimit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *InstantiateGrant) XXX_Size() int {
+	return m.Size()
 }
 
-func (m *MaxCallsLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Remaining != 0 {
-		i = encodeVarintAuthz(dAtA, i, uint64(m.Remaining))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *InstantiateGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_InstantiateGrant.DiscardUnknown(m)
 }
 
-func (m *MaxFundsLimit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_InstantiateGrant proto.InternalMessageInfo
+
+// CodeGrant a granted permission to upload a single code
+// Since: wasmd 0.41
+type CodeGrant struct {
+	// CodeHash is the sha256 checksum of the uncompressed WASM code
+	CodeHash []byte `protobuf:"bytes,1,opt,name=code_hash,json=codeHash,proto3" json:"code_hash,omitempty"`
+	// InstantiatePermission restricts the instantiate permission that can be set
+	// on upload. The permission in the message must be a subset. Any permission
+	// is accepted when not set.
+	InstantiatePermission *AccessConfig `protobuf:"bytes,2,opt,name=instantiate_permission,json=instantiatePermission,proto3" json:"instantiate_permission,omitempty"`
+	// Limit defines upload limits that are enforced and updated when the grant
+	// is applied. When the limit lapsed the grant is removed. No funds can be
+	// transferred on upload.
+	Limit *types.Any `protobuf:"bytes,3,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (m *MaxFundsLimit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CodeGrant) Reset()         { *m = CodeGrant{} }
+func (m *CodeGrant) String() string { return proto.CompactTextString(m) }
+func (*CodeGrant) ProtoMessage()    {}
+func (*CodeGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36ff3a20cf32b258, []int{11}
 }
 
-func (m *MaxFundsLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amounts) > 0 {
-		for iNdEx := len(m.Amounts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAuthz(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func (m *CodeGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
 
-func (m *CombinedLimit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CodeGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CodeGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
 
-func (m *CombinedLimit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CodeGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CodeGrant.Merge(m, src)
 }
 
-func (m *CombinedLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amounts) > 0 {
-		for iNdEx := len(m.Amounts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAuthz(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.CallsRemaining != 0 {
-		i = encodeVarintAuthz(dAtA, i, uint64(m.CallsRemaining))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *CodeGrant) XXX_Size() int {
+	return m.Size()
 }
 
-func (m *AllowAllMessagesFilter) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *CodeGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_CodeGrant.DiscardUnknown(m)
 }
 
-func (m *AllowAllMessagesFilter) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_CodeGrant proto.InternalMessageInfo
 
-func (m *AllowAllMessagesFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MaxCallsLimit limited number of calls to the contract. No funds transferable.
+// Since: wasmd 0.30
+type MaxCallsLimit struct {
+	// Remaining number that is decremented on each execution
+	Remaining uint64 `protobuf:"varint,1,opt,name=remaining,proto3" json:"remaining,omitempty"`
 }
 
-func (m *AcceptedMessageKeysFilter) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MaxCallsLimit) Reset()         { *m = MaxCallsLimit{} }
+func (m *MaxCallsLimit) String() string { return proto.CompactTextString(m) }
+func (*MaxCallsLimit) ProtoMessage()    {}
+func (*MaxCallsLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36ff3a20cf32b258, []int{12}
 }
 
-func (m *AcceptedMessageKeysFilter) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MaxCallsLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
 
-func (m *AcceptedMessageKeysFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Keys) > 0 {
-		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Keys[iNdEx])
-			copy(dAtA[i:], m.Keys[iNdEx])
-			i = encodeVarintAuthz(dAtA, i, uint64(len(m.Keys[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *MaxCallsLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MaxCallsLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
 
-func (m *AcceptedMessagesFilter) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MaxCallsLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaxCallsLimit.Merge(m, src)
 }
 
-func (m *AcceptedMessagesFilter) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MaxCallsLimit) XXX_Size() int {
+	return m.Size()
 }
 
-func (m *AcceptedMessagesFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Messages) > 0 {
-		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Messages[iNdEx])
-			copy(dAtA[i:], m.Messages[iNdEx])
-			i = encodeVarintAuthz(dAtA, i, uint64(len(m.Messages[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func (m *MaxCallsLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaxCallsLimit.DiscardUnknown(m)
 }
 
-func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
-	offset -= sovAuthz(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+var xxx_messageInfo_MaxCallsLimit proto.InternalMessageInfo
+
+// MaxFundsLimit defines the maximal amounts that can be sent to the contract.
+// Since: wasmd 0.30
+type MaxFundsLimit struct {
+	// Amounts is the maximal amount of tokens transferable to the contract.
+	Amounts github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amounts,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amounts"`
 }
 
-func (m *ContractExecutionAuthorization) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Grants) > 0 {
-		for _, e := range m.Grants {
-			l = e.Size()
-			n += 1 + l + sovAuthz(uint64(l))
+func (m *MaxFundsLimit) Reset()         { *m = MaxFundsLimit{} }
+func (m *MaxFundsLimit) String() string { return proto.CompactTextString(m) }
+func (*MaxFundsLimit) ProtoMessage()    {}
+func (*MaxFundsLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36ff3a20cf32b258, []int{13}
+}
+
+func (m *MaxFundsLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *MaxFundsLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MaxFundsLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
 }
 
-func (m *ContractMigrationAuthorization) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Grants) > 0 {
-		for _, e := range m.Grants {
-			l = e.Size()
-			n += 1 + l + sovAuthz(uint64(l))
+func (m *MaxFundsLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaxFundsLimit.Merge(m, src)
+}
+
+func (m *MaxFundsLimit) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *MaxFundsLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaxFundsLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaxFundsLimit proto.InternalMessageInfo
+
+// CombinedLimit defines the maximal amounts that can be sent to a contract and
+// the maximal number of calls executable. Both need to remain >0 to be valid.
+// Since: wasmd 0.30
+type CombinedLimit struct {
+	// Remaining number that is decremented on each execution
+	CallsRemaining uint64 `protobuf:"varint,1,opt,name=calls_remaining,json=callsRemaining,proto3" json:"calls_remaining,omitempty"`
+	// Amounts is the maximal amount of tokens transferable to the contract.
+	Amounts github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amounts,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amounts"`
+}
+
+func (m *CombinedLimit) Reset()         { *m = CombinedLimit{} }
+func (m *CombinedLimit) String() string { return proto.CompactTextString(m) }
+func (*CombinedLimit) ProtoMessage()    {}
+func (*CombinedLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36ff3a20cf32b258, []int{14}
+}
+
+func (m *CombinedLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *CombinedLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CombinedLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
 }
 
-func (m *ContractGrant) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Contract)
-	if l > 0 {
-		n += 1 + l + sovAuthz(uint64(l))
-	}
-	if m.Limit != nil {
-		l = m.Limit.Size()
-		n += 1 + l + sovAuthz(uint64(l))
-	}
-	if m.Filter != nil {
-		l = m.Filter.Size()
-		n += 1 + l + sovAuthz(uint64(l))
-	}
-	return n
+func (m *CombinedLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CombinedLimit.Merge(m, src)
 }
 
-func (m *MaxCallsLimit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Remaining != 0 {
-		n += 1 + sovAuthz(uint64(m.Remaining))
-	}
-	return n
+func (m *CombinedLimit) XXX_Size() int {
+	return m.Size()
 }
 
-func (m *MaxFundsLimit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Amounts) > 0 {
-		for _, e := range m.Amounts {
-			l = e.Size()
-			n += 1 + l + sovAuthz(uint64(l))
+func (m *CombinedLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_CombinedLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CombinedLimit proto.InternalMessageInfo
+
+// RollingWindowLimit defines the maximal number of calls and amounts that can
+// be sent to a contract within any window of time that ends at the block time.
+// The usage is tracked per sub-window of a fixed fraction of the window
+// duration. A sub-window counts for as long as it overlaps the window so that
+// the usage expires with the granularity of a sub-window and the max values
+// are never exceeded within one window duration. The limit is never used up.
+// Since: wasmd 0.41
+type RollingWindowLimit struct {
+	// Window is the duration of the rolling window
+	Window time.Duration `protobuf:"bytes,1,opt,name=window,proto3,stdduration" json:"window"`
+	// MaxCalls is the maximal number of calls per window. Zero for no limit.
+	MaxCalls uint64 `protobuf:"varint,2,opt,name=max_calls,json=maxCalls,proto3" json:"max_calls,omitempty"`
+	// MaxAmounts is the maximal amount of tokens transferable to the contract per
+	// window. No tokens can be transferred when empty.
+	MaxAmounts github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=max_amounts,json=maxAmounts,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_amounts"`
+	// Usage are the calls and tokens per sub-window in ascending order. Sub-windows
+	// that no longer overlap the window are removed.
+	Usage []WindowUsage `protobuf:"bytes,7,rep,name=usage,proto3" json:"usage"`
+}
+
+func (m *RollingWindowLimit) Reset()         { *m = RollingWindowLimit{} }
+func (m *RollingWindowLimit) String() string { return proto.CompactTextString(m) }
+func (*RollingWindowLimit) ProtoMessage()    {}
+func (*RollingWindowLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36ff3a20cf32b258, []int{15}
+}
+
+func (m *RollingWindowLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *RollingWindowLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RollingWindowLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
 }
 
-func (m *CombinedLimit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CallsRemaining != 0 {
-		n += 1 + sovAuthz(uint64(m.CallsRemaining))
-	}
-	if len(m.Amounts) > 0 {
-		for _, e := range m.Amounts {
-			l = e.Size()
-			n += 1 + l + sovAuthz(uint64(l))
+func (m *RollingWindowLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollingWindowLimit.Merge(m, src)
+}
+
+func (m *RollingWindowLimit) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *RollingWindowLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollingWindowLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollingWindowLimit proto.InternalMessageInfo
+
+// WindowUsage are the calls and tokens of a sub-window of a RollingWindowLimit
+type WindowUsage struct {
+	// Start of the sub-window
+	Start time.Time `protobuf:"bytes,1,opt,name=start,proto3,stdtime" json:"start"`
+	// Calls is the number of calls within the sub-window
+	Calls uint64 `protobuf:"varint,2,opt,name=calls,proto3" json:"calls,omitempty"`
+	// Amounts are the tokens transferred within the sub-window
+	Amounts github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amounts,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amounts"`
+}
+
+func (m *WindowUsage) Reset()         { *m = WindowUsage{} }
+func (m *WindowUsage) String() string { return proto.CompactTextString(m) }
+func (*WindowUsage) ProtoMessage()    {}
+func (*WindowUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36ff3a20cf32b258, []int{16}
+}
+
+func (m *WindowUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *WindowUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WindowUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
 }
 
-func (m *AllowAllMessagesFilter) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *WindowUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WindowUsage.Merge(m, src)
+}
+
+func (m *WindowUsage) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *WindowUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_WindowUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WindowUsage proto.InternalMessageInfo
+
+// CooldownLimit enforces a minimum gap of block time between calls to the
+// contract. No funds transferable.
+// Since: wasmd 0.41
+type CooldownLimit struct {
+	// Cooldown is the minimum duration between two calls
+	Cooldown time.Duration `protobuf:"bytes,1,opt,name=cooldown,proto3,stdduration" json:"cooldown"`
+	// LastCall is the block time of the last call. It is set on each call.
+	LastCall time.Time `protobuf:"bytes,2,opt,name=last_call,json=lastCall,proto3,stdtime" json:"last_call"`
+}
+
+func (m *CooldownLimit) Reset()         { *m = CooldownLimit{} }
+func (m *CooldownLimit) String() string { return proto.CompactTextString(m) }
+func (*CooldownLimit) ProtoMessage()    {}
+func (*CooldownLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36ff3a20cf32b258, []int{17}
+}
+
+func (m *CooldownLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *CooldownLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CooldownLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
 }
 
-func (m *AcceptedMessageKeysFilter) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CooldownLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CooldownLimit.Merge(m, src)
+}
+
+func (m *CooldownLimit) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *CooldownLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_CooldownLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CooldownLimit proto.InternalMessageInfo
+
+// TimeWindow is a range of block time with inclusive start and exclusive end
+// Since: wasmd 0.41
+type TimeWindow struct {
+	// Start of the window, inclusive
+	Start time.Time `protobuf:"bytes,1,opt,name=start,proto3,stdtime" json:"start"`
+	// End of the window, exclusive
+	End time.Time `protobuf:"bytes,2,opt,name=end,proto3,stdtime" json:"end"`
+}
+
+func (m *TimeWindow) Reset()         { *m = TimeWindow{} }
+func (m *TimeWindow) String() string { return proto.CompactTextString(m) }
+func (*TimeWindow) ProtoMessage()    {}
+func (*TimeWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36ff3a20cf32b258, []int{18}
+}
+
+func (m *TimeWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *TimeWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimeWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if len(m.Keys) > 0 {
-		for _, s := range m.Keys {
-			l = len(s)
-			n += 1 + l + sovAuthz(uint64(l))
+}
+
+func (m *TimeWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimeWindow.Merge(m, src)
+}
+
+func (m *TimeWindow) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *TimeWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimeWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimeWindow proto.InternalMessageInfo
+
+// BlockTimeWindowsLimit allows calls to the contract only when the block time
+// is within one of the windows. Windows that have passed are removed. A grant
+// with all windows passed is removed with the next accepted call of the
+// authorization. No funds transferable.
+// Since: wasmd 0.41
+type BlockTimeWindowsLimit struct {
+	// Windows are the non overlapping time windows in ascending order
+	Windows []TimeWindow `protobuf:"bytes,1,rep,name=windows,proto3" json:"windows"`
+}
+
+func (m *BlockTimeWindowsLimit) Reset()         { *m = BlockTimeWindowsLimit{} }
+func (m *BlockTimeWindowsLimit) String() string { return proto.CompactTextString(m) }
+func (*BlockTimeWindowsLimit) ProtoMessage()    {}
+func (*BlockTimeWindowsLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36ff3a20cf32b258, []int{19}
+}
+
+func (m *BlockTimeWindowsLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *BlockTimeWindowsLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockTimeWindowsLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *BlockTimeWindowsLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockTimeWindowsLimit.Merge(m, src)
+}
+
+func (m *BlockTimeWindowsLimit) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *BlockTimeWindowsLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockTimeWindowsLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockTimeWindowsLimit proto.InternalMessageInfo
+
+// AllowAllMessagesFilter is a wildcard to allow any type of contract payload
+// message.
+// Since: wasmd 0.30
+type AllowAllMessagesFilter struct{}
+
+func (m *AllowAllMessagesFilter) Reset()         { *m = AllowAllMessagesFilter{} }
+func (m *AllowAllMessagesFilter) String() string { return proto.CompactTextString(m) }
+func (*AllowAllMessagesFilter) ProtoMessage()    {}
+func (*AllowAllMessagesFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36ff3a20cf32b258, []int{20}
+}
+
+func (m *AllowAllMessagesFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *AllowAllMessagesFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllowAllMessagesFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *AllowAllMessagesFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllowAllMessagesFilter.Merge(m, src)
+}
+
+func (m *AllowAllMessagesFilter) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *AllowAllMessagesFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllowAllMessagesFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllowAllMessagesFilter proto.InternalMessageInfo
+
+// AcceptedMessageKeysFilter accept only the specific contract message keys in
+// the json object to be executed.
+// Since: wasmd 0.30
+type AcceptedMessageKeysFilter struct {
+	// Messages is the list of unique keys
+	Keys []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (m *AcceptedMessageKeysFilter) Reset()         { *m = AcceptedMessageKeysFilter{} }
+func (m *AcceptedMessageKeysFilter) String() string { return proto.CompactTextString(m) }
+func (*AcceptedMessageKeysFilter) ProtoMessage()    {}
+func (*AcceptedMessageKeysFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36ff3a20cf32b258, []int{21}
+}
+
+func (m *AcceptedMessageKeysFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *AcceptedMessageKeysFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AcceptedMessageKeysFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *AcceptedMessageKeysFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AcceptedMessageKeysFilter.Merge(m, src)
+}
+
+func (m *AcceptedMessageKeysFilter) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *AcceptedMessageKeysFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_AcceptedMessageKeysFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AcceptedMessageKeysFilter proto.InternalMessageInfo
+
+// AcceptedMessagesFilter accept only the specific raw contract messages to be
+// executed.
+// Since: wasmd 0.30
+type AcceptedMessagesFilter struct {
+	// Messages is the list of raw contract messages
+	Messages []RawContractMessage `protobuf:"bytes,1,rep,name=messages,proto3,casttype=RawContractMessage" json:"messages,omitempty"`
+}
+
+func (m *AcceptedMessagesFilter) Reset()         { *m = AcceptedMessagesFilter{} }
+func (m *AcceptedMessagesFilter) String() string { return proto.CompactTextString(m) }
+func (*AcceptedMessagesFilter) ProtoMessage()    {}
+func (*AcceptedMessagesFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36ff3a20cf32b258, []int{22}
+}
+
+func (m *AcceptedMessagesFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *AcceptedMessagesFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AcceptedMessagesFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *AcceptedMessagesFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AcceptedMessagesFilter.Merge(m, src)
+}
+
+func (m *AcceptedMessagesFilter) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *AcceptedMessagesFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_AcceptedMessagesFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AcceptedMessagesFilter proto.InternalMessageInfo
+
+// JSONPathFilter accepts only contract messages where the values at the given
+// JSON paths meet all conditions.
+// Since: wasmd 0.41
+type JSONPathFilter struct {
+	// Conditions that all must be met
+	Conditions []JSONPathCondition `protobuf:"bytes,1,rep,name=conditions,proto3" json:"conditions"`
+}
+
+func (m *JSONPathFilter) Reset()         { *m = JSONPathFilter{} }
+func (m *JSONPathFilter) String() string { return proto.CompactTextString(m) }
+func (*JSONPathFilter) ProtoMessage()    {}
+func (*JSONPathFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36ff3a20cf32b258, []int{23}
+}
+
+func (m *JSONPathFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *JSONPathFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JSONPathFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *JSONPathFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JSONPathFilter.Merge(m, src)
+}
+
+func (m *JSONPathFilter) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *JSONPathFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_JSONPathFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JSONPathFilter proto.InternalMessageInfo
+
+// JSONPathCondition is a constraint on the value at a JSON path of the contract
+// message. Exactly one of the matchers must be set.
+// Since: wasmd 0.41
+type JSONPathCondition struct {
+	// Path is a dot separated list of object keys and array indexes, for example
+	// "swap.routes.0.pool_id"
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// Equals is the JSON encoded value that the value must be equal to, for
+	// example "\"uatom\"" or "{\"denom\":\"uatom\"}"
+	Equals string `protobuf:"bytes,2,opt,name=equals,proto3" json:"equals,omitempty"`
+	// Range is the inclusive range that the numeric value must be in. JSON
+	// numbers and numeric strings are supported.
+	Range *NumericRange `protobuf:"bytes,3,opt,name=range,proto3" json:"range,omitempty"`
+	// AnyOf is a list of values that the string value must be one of, for
+	// example addresses
+	AnyOf []string `protobuf:"bytes,4,rep,name=any_of,json=anyOf,proto3" json:"any_of,omitempty"`
+	// Regex is a RE2 regular expression that the string value must fully match
+	Regex string `protobuf:"bytes,5,opt,name=regex,proto3" json:"regex,omitempty"`
+}
+
+func (m *JSONPathCondition) Reset()         { *m = JSONPathCondition{} }
+func (m *JSONPathCondition) String() string { return proto.CompactTextString(m) }
+func (*JSONPathCondition) ProtoMessage()    {}
+func (*JSONPathCondition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36ff3a20cf32b258, []int{24}
+}
+
+func (m *JSONPathCondition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *JSONPathCondition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JSONPathCondition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *JSONPathCondition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JSONPathCondition.Merge(m, src)
+}
+
+func (m *JSONPathCondition) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *JSONPathCondition) XXX_DiscardUnknown() {
+	xxx_messageInfo_JSONPathCondition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JSONPathCondition proto.InternalMessageInfo
+
+// NumericRange is an inclusive range of decimal numbers
+// Since: wasmd 0.41
+type NumericRange struct {
+	// Min is the lower bound. Empty for no bound.
+	Min string `protobuf:"bytes,1,opt,name=min,proto3" json:"min,omitempty"`
+	// Max is the upper bound. Empty for no bound.
+	Max string `protobuf:"bytes,2,opt,name=max,proto3" json:"max,omitempty"`
+}
+
+func (m *NumericRange) Reset()         { *m = NumericRange{} }
+func (m *NumericRange) String() string { return proto.CompactTextString(m) }
+func (*NumericRange) ProtoMessage()    {}
+func (*NumericRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36ff3a20cf32b258, []int{25}
+}
+
+func (m *NumericRange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *NumericRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NumericRange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *NumericRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NumericRange.Merge(m, src)
+}
+
+func (m *NumericRange) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *NumericRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_NumericRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NumericRange proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*ContractExecutionAuthorization)(nil), "cosmwasm.wasm.v1.ContractExecutionAuthorization")
+	proto.RegisterType((*ContractMigrationAuthorization)(nil), "cosmwasm.wasm.v1.ContractMigrationAuthorization")
+	proto.RegisterType((*ExecuteContractsAuthorization)(nil), "cosmwasm.wasm.v1.ExecuteContractsAuthorization")
+	proto.RegisterType((*ContractInstantiationAuthorization)(nil), "cosmwasm.wasm.v1.ContractInstantiationAuthorization")
+	proto.RegisterType((*ContractInstantiation2Authorization)(nil), "cosmwasm.wasm.v1.ContractInstantiation2Authorization")
+	proto.RegisterType((*StoreCodeAuthorization)(nil), "cosmwasm.wasm.v1.StoreCodeAuthorization")
+	proto.RegisterType((*ContractUpdateAdminAuthorization)(nil), "cosmwasm.wasm.v1.ContractUpdateAdminAuthorization")
+	proto.RegisterType((*ContractClearAdminAuthorization)(nil), "cosmwasm.wasm.v1.ContractClearAdminAuthorization")
+	proto.RegisterType((*ContractGrant)(nil), "cosmwasm.wasm.v1.ContractGrant")
+	proto.RegisterType((*ContractLimit)(nil), "cosmwasm.wasm.v1.ContractLimit")
+	proto.RegisterType((*InstantiateGrant)(nil), "cosmwasm.wasm.v1.InstantiateGrant")
+	proto.RegisterType((*CodeGrant)(nil), "cosmwasm.wasm.v1.CodeGrant")
+	proto.RegisterType((*MaxCallsLimit)(nil), "cosmwasm.wasm.v1.MaxCallsLimit")
+	proto.RegisterType((*MaxFundsLimit)(nil), "cosmwasm.wasm.v1.MaxFundsLimit")
+	proto.RegisterType((*CombinedLimit)(nil), "cosmwasm.wasm.v1.CombinedLimit")
+	proto.RegisterType((*RollingWindowLimit)(nil), "cosmwasm.wasm.v1.RollingWindowLimit")
+	proto.RegisterType((*WindowUsage)(nil), "cosmwasm.wasm.v1.WindowUsage")
+	proto.RegisterType((*CooldownLimit)(nil), "cosmwasm.wasm.v1.CooldownLimit")
+	proto.RegisterType((*TimeWindow)(nil), "cosmwasm.wasm.v1.TimeWindow")
+	proto.RegisterType((*BlockTimeWindowsLimit)(nil), "cosmwasm.wasm.v1.BlockTimeWindowsLimit")
+	proto.RegisterType((*AllowAllMessagesFilter)(nil), "cosmwasm.wasm.v1.AllowAllMessagesFilter")
+	proto.RegisterType((*AcceptedMessageKeysFilter)(nil), "cosmwasm.wasm.v1.AcceptedMessageKeysFilter")
+	proto.RegisterType((*AcceptedMessagesFilter)(nil), "cosmwasm.wasm.v1.AcceptedMessagesFilter")
+	proto.RegisterType((*JSONPathFilter)(nil), "cosmwasm.wasm.v1.JSONPathFilter")
+	proto.RegisterType((*JSONPathCondition)(nil), "cosmwasm.wasm.v1.JSONPathCondition")
+	proto.RegisterType((*NumericRange)(nil), "cosmwasm.wasm.v1.NumericRange")
+}
+
+func init() { proto.RegisterFile("cosmwasm/wasm/v1/authz.proto", fileDescriptor_36ff3a20cf32b258) }
+
+var fileDescriptor_36ff3a20cf32b258 = []byte{
+	// 1489 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4b, 0x6c, 0x1b, 0x45,
+	0x18, 0xce, 0xc6, 0xaf, 0x78, 0xd2, 0x47, 0xba, 0xb4, 0x61, 0x9b, 0xb6, 0x76, 0x34, 0xe9, 0xc3,
+	0x54, 0x8a, 0xad, 0x04, 0xb8, 0x04, 0xa9, 0xc5, 0x76, 0x9a, 0x92, 0xd2, 0xa4, 0xd5, 0xa6, 0x55,
+	0x2b, 0x2e, 0xd6, 0x64, 0x77, 0x62, 0x2f, 0xdd, 0xdd, 0x71, 0x77, 0xd6, 0x8d, 0x5d, 0x89, 0x33,
+	0x52, 0x4f, 0x3d, 0x01, 0x47, 0x04, 0x17, 0xc4, 0xa9, 0x42, 0x11, 0xe2, 0x80, 0x84, 0xb8, 0x55,
+	0x3d, 0x55, 0x48, 0x48, 0x9c, 0x52, 0x48, 0x85, 0x7a, 0xe7, 0xc8, 0x09, 0xcd, 0x63, 0xed, 0xf5,
+	0x2b, 0xd8, 0xa5, 0x06, 0x2e, 0xeb, 0x9d, 0xf9, 0x5f, 0xdf, 0xff, 0xcd, 0x3f, 0xff, 0xcc, 0x1a,
+	0x9c, 0x34, 0x08, 0x75, 0xb6, 0x11, 0x75, 0x72, 0xfc, 0x71, 0x6f, 0x21, 0x87, 0x6a, 0x7e, 0xe5,
+	0x7e, 0xb6, 0xea, 0x11, 0x9f, 0xa8, 0x53, 0x81, 0x34, 0xcb, 0x1f, 0xf7, 0x16, 0x66, 0x8e, 0x96,
+	0x49, 0x99, 0x70, 0x61, 0x8e, 0xbd, 0x09, 0xbd, 0x99, 0xe3, 0x4c, 0x8f, 0xd0, 0x92, 0x10, 0x88,
+	0x81, 0x14, 0xa5, 0xc4, 0x28, 0xb7, 0x89, 0x28, 0xce, 0xdd, 0x5b, 0xd8, 0xc4, 0x3e, 0x5a, 0xc8,
+	0x19, 0xc4, 0x72, 0x03, 0xd3, 0x32, 0x21, 0x65, 0x1b, 0xe7, 0xf8, 0x68, 0xb3, 0xb6, 0x95, 0x43,
+	0x6e, 0x23, 0x30, 0xed, 0x14, 0x99, 0x35, 0x0f, 0xf9, 0x16, 0x09, 0x4c, 0xd3, 0x9d, 0x72, 0xdf,
+	0x72, 0x30, 0xf5, 0x91, 0x53, 0x95, 0x0a, 0x47, 0x90, 0x63, 0xb9, 0x24, 0xc7, 0x9f, 0x72, 0xaa,
+	0x3b, 0x5f, 0xbf, 0x51, 0xc5, 0x12, 0x2c, 0xdc, 0x51, 0x40, 0xaa, 0x48, 0x5c, 0xdf, 0x43, 0x86,
+	0x7f, 0xa9, 0x8e, 0x8d, 0x1a, 0x8b, 0x96, 0xaf, 0xf9, 0x15, 0xe2, 0x59, 0xf7, 0x79, 0x68, 0xb5,
+	0x00, 0xe2, 0x65, 0x0f, 0xb9, 0x3e, 0xd5, 0x94, 0xd9, 0x48, 0x66, 0x72, 0x31, 0x9d, 0xed, 0xe4,
+	0x28, 0x1b, 0x78, 0xb8, 0xcc, 0xf4, 0x0a, 0xc9, 0xc7, 0xbb, 0xe9, 0xb1, 0xaf, 0x5e, 0x3c, 0x3a,
+	0xaf, 0xe8, 0xd2, 0x72, 0x69, 0xfd, 0xc9, 0xce, 0x3c, 0x94, 0x2c, 0x09, 0xba, 0x25, 0x31, 0xd9,
+	0xb6, 0x58, 0x0f, 0x5e, 0x3c, 0x3a, 0x3f, 0xc7, 0x61, 0xee, 0x8f, 0xa9, 0x0d, 0xf6, 0x9a, 0x55,
+	0xf6, 0x50, 0x97, 0xca, 0x7f, 0x0b, 0xbb, 0x37, 0x26, 0xf8, 0x8d, 0x02, 0x4e, 0x89, 0x8c, 0x70,
+	0xa0, 0x49, 0x5f, 0x3d, 0xea, 0xb5, 0xc1, 0x51, 0x43, 0x8e, 0x7a, 0x5f, 0x48, 0xf0, 0x07, 0x05,
+	0xc0, 0x40, 0xb4, 0xea, 0x52, 0x1f, 0xb9, 0xbe, 0xd5, 0x83, 0xef, 0x4b, 0x1d, 0xc8, 0x61, 0x37,
+	0xf2, 0x96, 0x35, 0xee, 0x0b, 0x5e, 0x1f, 0x1c, 0xfc, 0xb9, 0x36, 0xca, 0xfb, 0x43, 0x83, 0x3f,
+	0x2a, 0x60, 0xae, 0xa7, 0xda, 0xe2, 0x48, 0x52, 0xd8, 0x18, 0x3c, 0x85, 0x4c, 0xff, 0x14, 0xda,
+	0xb1, 0xc1, 0xcf, 0x15, 0x30, 0xbd, 0xe1, 0x13, 0x0f, 0x17, 0x89, 0x89, 0xdb, 0x61, 0x5f, 0xe8,
+	0x80, 0x7d, 0xa2, 0x57, 0xcd, 0x98, 0xfd, 0xf1, 0xae, 0x0c, 0x8e, 0xf7, 0x04, 0xc7, 0xdb, 0x1b,
+	0x07, 0xfc, 0x4e, 0x01, 0xb3, 0x41, 0x2a, 0x37, 0xab, 0x26, 0xf2, 0x71, 0xde, 0x74, 0xac, 0x11,
+	0x6c, 0xcb, 0xeb, 0x83, 0x03, 0x3e, 0xd3, 0x46, 0x70, 0x3f, 0x54, 0xf0, 0x5b, 0x05, 0xa4, 0x03,
+	0xa5, 0xa2, 0x8d, 0x91, 0x37, 0x22, 0xe4, 0xd7, 0x06, 0x47, 0x7e, 0xba, 0x0d, 0x79, 0x1f, 0x50,
+	0xf0, 0xa7, 0x08, 0x38, 0xd8, 0x16, 0x55, 0x9d, 0x01, 0x13, 0x86, 0x9c, 0xd0, 0x94, 0x59, 0x25,
+	0x93, 0xd4, 0x9b, 0x63, 0xf5, 0x06, 0x88, 0xd9, 0x96, 0x63, 0xf9, 0xda, 0xf8, 0xac, 0x92, 0x99,
+	0x5c, 0x3c, 0x9a, 0x15, 0xe7, 0x49, 0x36, 0x38, 0x4f, 0xb2, 0x79, 0xb7, 0x51, 0xc8, 0x3c, 0xd9,
+	0x99, 0x3f, 0xdd, 0x37, 0x35, 0x16, 0xfe, 0xfe, 0x55, 0xe6, 0xe4, 0xb6, 0x2e, 0x9c, 0xa9, 0xb7,
+	0x40, 0x7c, 0xcb, 0xb2, 0x7d, 0xec, 0x69, 0x91, 0x7d, 0xdc, 0xbe, 0xf1, 0x64, 0x67, 0xfe, 0xcc,
+	0xfe, 0x6e, 0x57, 0xb8, 0x97, 0xdb, 0xba, 0x74, 0xa7, 0x9e, 0x65, 0xa9, 0x98, 0xb8, 0x64, 0x99,
+	0x54, 0x8b, 0xce, 0x46, 0x32, 0xd1, 0xc2, 0xe4, 0xde, 0x6e, 0x3a, 0xc1, 0x2a, 0x6f, 0x75, 0x99,
+	0xea, 0x09, 0x26, 0x5c, 0x35, 0xa9, 0xaa, 0x81, 0x84, 0xe1, 0x61, 0xe4, 0x13, 0x4f, 0x8b, 0xf1,
+	0x8c, 0x83, 0xa1, 0xba, 0x01, 0x0e, 0x07, 0xc9, 0x97, 0x38, 0x58, 0xaa, 0xc5, 0xff, 0x6e, 0xf1,
+	0x78, 0x72, 0xe1, 0xc5, 0x3b, 0x64, 0x84, 0x25, 0x54, 0x2d, 0x81, 0x49, 0x9f, 0xf8, 0xc8, 0x16,
+	0x1e, 0xb5, 0xc4, 0x2b, 0xe1, 0x12, 0x70, 0x97, 0x7c, 0x00, 0x3f, 0x56, 0x5a, 0x8b, 0xca, 0x67,
+	0xfe, 0xfd, 0x45, 0xbd, 0x12, 0x9d, 0x88, 0x4c, 0x45, 0xe1, 0x27, 0xe3, 0x60, 0xaa, 0xb3, 0xe5,
+	0xa9, 0x73, 0x20, 0x21, 0x97, 0x85, 0x63, 0x89, 0x16, 0xc0, 0xde, 0x6e, 0x3a, 0x2e, 0x56, 0x45,
+	0x8f, 0x8b, 0x45, 0x51, 0xa7, 0x41, 0xdc, 0x46, 0x9b, 0xd8, 0xa6, 0xda, 0xf8, 0x6c, 0x24, 0x93,
+	0xd4, 0xe5, 0xa8, 0x85, 0x36, 0x32, 0x9a, 0x12, 0x8c, 0xbe, 0xda, 0x12, 0x9c, 0x06, 0x71, 0xc4,
+	0x76, 0x1d, 0xd5, 0x62, 0x22, 0x0d, 0x31, 0x82, 0x3f, 0x2b, 0x20, 0xd9, 0x6c, 0xaa, 0xea, 0x09,
+	0x90, 0xe4, 0x8c, 0x54, 0x10, 0xad, 0x70, 0x4e, 0x0e, 0xe8, 0xbc, 0x72, 0xdf, 0x43, 0xb4, 0xa2,
+	0xde, 0x04, 0xd3, 0x56, 0x8b, 0xc2, 0x52, 0x15, 0x7b, 0x8e, 0x45, 0xa9, 0x45, 0x5c, 0xb9, 0x60,
+	0xa9, 0xee, 0x52, 0xcc, 0x1b, 0x06, 0xa6, 0xb4, 0x48, 0xdc, 0x2d, 0xab, 0xac, 0x1f, 0x0b, 0x59,
+	0x5f, 0x6f, 0x1a, 0x8f, 0x86, 0x48, 0xe8, 0x82, 0x83, 0x6b, 0xa8, 0x5e, 0x44, 0xb6, 0x4d, 0x45,
+	0xe5, 0x9d, 0x04, 0x49, 0x0f, 0x3b, 0xc8, 0x72, 0x2d, 0xb7, 0x2c, 0x96, 0x5b, 0x6f, 0x4d, 0x2c,
+	0x5d, 0x1c, 0xd4, 0x3f, 0xeb, 0x68, 0x2a, 0xef, 0x68, 0x6d, 0xee, 0xe1, 0xf7, 0x0a, 0x0f, 0xb8,
+	0x52, 0x73, 0x4d, 0x19, 0xf0, 0x43, 0x90, 0x40, 0x0e, 0xa9, 0xb5, 0xfa, 0xec, 0xf1, 0xac, 0x6c,
+	0x98, 0xec, 0x42, 0xdd, 0xec, 0x97, 0x45, 0x62, 0xb9, 0x85, 0xb7, 0xd9, 0x26, 0xfd, 0xfa, 0x59,
+	0x3a, 0x53, 0xb6, 0xfc, 0x4a, 0x6d, 0x33, 0x6b, 0x10, 0x47, 0xde, 0xc5, 0xe5, 0xcf, 0x3c, 0x35,
+	0xef, 0xc8, 0xfb, 0x2e, 0x33, 0xa0, 0x62, 0x43, 0x07, 0x01, 0x5e, 0x12, 0x7e, 0x0b, 0x2c, 0xfc,
+	0x9d, 0xef, 0x54, 0x67, 0xd3, 0x72, 0xb1, 0x29, 0xe0, 0x9f, 0x03, 0x87, 0x0d, 0x96, 0x5e, 0xa9,
+	0x93, 0xb5, 0x43, 0x7c, 0x5a, 0x0f, 0x66, 0xc3, 0x79, 0x8e, 0xff, 0x0f, 0xf3, 0x6c, 0xcb, 0x0a,
+	0xfe, 0x31, 0x0e, 0x54, 0x9d, 0xd8, 0xb6, 0xe5, 0x96, 0x6f, 0x59, 0xae, 0x49, 0xb6, 0x45, 0xb2,
+	0xef, 0x82, 0xf8, 0x36, 0x1f, 0xf2, 0x1c, 0x59, 0x0a, 0x9d, 0x45, 0xb8, 0x2c, 0x3f, 0x60, 0x0a,
+	0x07, 0x59, 0x0a, 0x9f, 0x3d, 0x4b, 0x2b, 0xf2, 0x40, 0x14, 0x76, 0x6c, 0xe7, 0x38, 0xa8, 0x5e,
+	0xe2, 0xdc, 0xf0, 0xfd, 0x10, 0xd5, 0x27, 0x1c, 0x59, 0x21, 0xea, 0x5d, 0x30, 0xc9, 0x84, 0x01,
+	0x4d, 0x91, 0x11, 0xd1, 0x04, 0x1c, 0x54, 0xcf, 0x8b, 0x18, 0xea, 0x05, 0x10, 0xab, 0x51, 0x54,
+	0xc6, 0x5a, 0x82, 0x07, 0x3b, 0xd5, 0xbd, 0x37, 0x45, 0xfe, 0x37, 0x99, 0x52, 0xf8, 0x90, 0x10,
+	0x66, 0x4b, 0xcb, 0xc3, 0x30, 0xfd, 0x3a, 0x67, 0xba, 0x9b, 0xd7, 0x2b, 0xd1, 0x89, 0xe8, 0x54,
+	0x02, 0x3e, 0x55, 0xc0, 0x64, 0x28, 0x9a, 0x7a, 0x11, 0xc4, 0xa8, 0x8f, 0x3c, 0x5f, 0x92, 0x3d,
+	0xd3, 0x45, 0xf6, 0x8d, 0xe0, 0x6b, 0x50, 0xb0, 0xfd, 0xb0, 0xc9, 0xb6, 0xb0, 0x53, 0x8f, 0x82,
+	0x58, 0x98, 0x68, 0x31, 0x08, 0x17, 0x62, 0x64, 0xc4, 0x85, 0x08, 0x77, 0xf9, 0x7e, 0x21, 0xb6,
+	0x49, 0xb6, 0x5d, 0x51, 0x42, 0xcb, 0xec, 0x64, 0x13, 0x13, 0x43, 0x17, 0x51, 0xd3, 0x52, 0x5d,
+	0x01, 0x49, 0x1b, 0x51, 0x9f, 0xd7, 0x91, 0x36, 0x3e, 0x2c, 0x3d, 0x13, 0xcc, 0x96, 0x95, 0xdc,
+	0xcb, 0x6d, 0x94, 0x50, 0x3a, 0xf0, 0x81, 0x02, 0x00, 0x8b, 0x23, 0xd6, 0xed, 0x9f, 0x2f, 0xd9,
+	0x3b, 0x20, 0x82, 0x5d, 0x73, 0xf8, 0x94, 0x98, 0x15, 0xfc, 0x52, 0x01, 0xc7, 0x0a, 0x36, 0x31,
+	0xee, 0xb4, 0x10, 0xc9, 0x26, 0x9b, 0x07, 0x09, 0xb1, 0x01, 0x83, 0x26, 0x7b, 0xb2, 0xbb, 0xd0,
+	0x5b, 0x46, 0xe1, 0x3a, 0x0f, 0xec, 0x96, 0x2e, 0x0f, 0x43, 0xd5, 0x0c, 0xa7, 0xaa, 0x27, 0x16,
+	0x68, 0x80, 0xe9, 0xbc, 0x6d, 0x93, 0xed, 0xbc, 0x6d, 0xaf, 0x61, 0xca, 0x2a, 0x9d, 0x8a, 0x53,
+	0x78, 0x69, 0x75, 0xe0, 0xf3, 0xba, 0xf5, 0x6d, 0xd2, 0xdb, 0x15, 0xfc, 0x08, 0x1c, 0x67, 0x87,
+	0x6a, 0xd5, 0xc7, 0xa6, 0x94, 0xbc, 0x8f, 0x1b, 0x52, 0xa8, 0xaa, 0x20, 0x7a, 0x07, 0x37, 0x04,
+	0x15, 0x49, 0x9d, 0xbf, 0x2f, 0x5d, 0x1d, 0x2a, 0x76, 0x4a, 0xc4, 0xee, 0x17, 0x01, 0x7e, 0xaa,
+	0x80, 0xe9, 0x0e, 0x69, 0x10, 0x7c, 0x11, 0x4c, 0x38, 0x72, 0x86, 0x03, 0x38, 0x50, 0x98, 0xfe,
+	0x73, 0x37, 0xad, 0xea, 0x68, 0xbb, 0xf9, 0x67, 0x82, 0x10, 0xeb, 0x4d, 0xbd, 0x97, 0x23, 0xa6,
+	0x67, 0x78, 0x56, 0x23, 0x87, 0xae, 0x6c, 0x5c, 0x5b, 0xbf, 0x8e, 0xfc, 0x8a, 0x44, 0xb4, 0x0e,
+	0x80, 0x41, 0x5c, 0xd3, 0x62, 0x5b, 0x2e, 0xa8, 0x8f, 0xb9, 0xee, 0xfa, 0x08, 0xac, 0x8a, 0x81,
+	0x6e, 0xb8, 0x4c, 0x42, 0x1e, 0x96, 0xf2, 0x43, 0xa1, 0x7d, 0x8d, 0xa3, 0x6d, 0x87, 0x04, 0xbf,
+	0x50, 0xc0, 0x91, 0xae, 0x78, 0x6c, 0xdd, 0xaa, 0xc8, 0xaf, 0xc8, 0x1b, 0x31, 0x7f, 0x67, 0x17,
+	0x36, 0x7c, 0xb7, 0x86, 0x64, 0x93, 0x4b, 0xea, 0x72, 0xa4, 0xbe, 0x05, 0x62, 0x1e, 0x72, 0xcb,
+	0x58, 0x8b, 0xf4, 0xbb, 0x74, 0xad, 0xd7, 0x1c, 0xec, 0x59, 0x86, 0xce, 0xb4, 0x74, 0xa1, 0xac,
+	0x1e, 0x03, 0x71, 0xe4, 0x36, 0x4a, 0x64, 0x8b, 0x7f, 0x7f, 0x24, 0xf5, 0x18, 0x72, 0x1b, 0xd7,
+	0xb6, 0x58, 0x23, 0xf5, 0x70, 0x19, 0xd7, 0xe5, 0xe7, 0x86, 0x18, 0xc0, 0x45, 0x70, 0x20, 0xec,
+	0x43, 0x9d, 0x02, 0x11, 0xc7, 0x72, 0x25, 0x3a, 0xf6, 0xca, 0x67, 0x50, 0x5d, 0x22, 0x63, 0xaf,
+	0x85, 0xe5, 0xc7, 0xbf, 0xa5, 0xc6, 0x1e, 0xef, 0xa5, 0x94, 0xa7, 0x7b, 0x29, 0xe5, 0xd7, 0xbd,
+	0x94, 0xf2, 0xf0, 0x79, 0x6a, 0xec, 0xe9, 0xf3, 0xd4, 0xd8, 0x2f, 0xcf, 0x53, 0x63, 0x1f, 0x9c,
+	0x0d, 0xb5, 0xd9, 0x22, 0xa1, 0xce, 0xad, 0xe0, 0x6f, 0x3c, 0x33, 0x57, 0xe7, 0xbf, 0xa2, 0xd5,
+	0x6e, 0xc6, 0x79, 0x43, 0x78, 0xf3, 0xaf, 0x01, 0x00, 0x12, 0xdc, 0x33, 0x78, 0xdc, 0x14, 0x00,
+	0x00,
+}
+
+func (m *ContractExecutionAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractExecutionAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractExecutionAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Grants) > 0 {
+		for iNdEx := len(m.Grants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Grants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractMigrationAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractMigrationAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractMigrationAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Grants) > 0 {
+		for iNdEx := len(m.Grants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Grants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecuteContractsAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecuteContractsAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecuteContractsAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Grants) > 0 {
+		for iNdEx := len(m.Grants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Grants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractInstantiationAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractInstantiationAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractInstantiationAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Grants) > 0 {
+		for iNdEx := len(m.Grants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Grants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractInstantiation2Authorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractInstantiation2Authorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractInstantiation2Authorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Grants) > 0 {
+		for iNdEx := len(m.Grants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Grants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StoreCodeAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StoreCodeAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StoreCodeAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Grants) > 0 {
+		for iNdEx := len(m.Grants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Grants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractUpdateAdminAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractUpdateAdminAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractUpdateAdminAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Grants) > 0 {
+		for iNdEx := len(m.Grants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Grants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractClearAdminAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractClearAdminAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractClearAdminAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Grants) > 0 {
+		for iNdEx := len(m.Grants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Grants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalLimit != nil {
+		{
+			size, err := m.TotalLimit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuthz(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ContractLimits) > 0 {
+		for iNdEx := len(m.ContractLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.CodeIDs) > 0 {
+		dAtA3 := make([]byte, len(m.CodeIDs)*10)
+		var j2 int
+		for _, num := range m.CodeIDs {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintAuthz(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Filter != nil {
+		{
+			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuthz(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Limit != nil {
+		{
+			size, err := m.Limit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuthz(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != nil {
+		{
+			size, err := m.Limit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuthz(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InstantiateGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InstantiateGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InstantiateGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Admins) > 0 {
+		for iNdEx := len(m.Admins) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Admins[iNdEx])
+			copy(dAtA[i:], m.Admins[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.Admins[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Filter != nil {
+		{
+			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuthz(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Limit != nil {
+		{
+			size, err := m.Limit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuthz(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Labels) > 0 {
+		for iNdEx := len(m.Labels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Labels[iNdEx])
+			copy(dAtA[i:], m.Labels[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.Labels[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.CodeID != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.CodeID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CodeGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CodeGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CodeGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != nil {
+		{
+			size, err := m.Limit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuthz(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.InstantiatePermission != nil {
+		{
+			size, err := m.InstantiatePermission.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuthz(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CodeHash) > 0 {
+		i -= len(m.CodeHash)
+		copy(dAtA[i:], m.CodeHash)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.CodeHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MaxCallsLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MaxCallsLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MaxCallsLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Remaining != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.Remaining))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MaxFundsLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MaxFundsLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MaxFundsLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amounts) > 0 {
+		for iNdEx := len(m.Amounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CombinedLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CombinedLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CombinedLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amounts) > 0 {
+		for iNdEx := len(m.Amounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.CallsRemaining != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.CallsRemaining))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RollingWindowLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RollingWindowLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RollingWindowLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Usage) > 0 {
+		for iNdEx := len(m.Usage) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Usage[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.MaxAmounts) > 0 {
+		for iNdEx := len(m.MaxAmounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxAmounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.MaxCalls != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.MaxCalls))
+		i--
+		dAtA[i] = 0x10
+	}
+	n11, err11 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintAuthz(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *WindowUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WindowUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WindowUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amounts) > 0 {
+		for iNdEx := len(m.Amounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Calls != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.Calls))
+		i--
+		dAtA[i] = 0x10
+	}
+	n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Start, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Start):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintAuthz(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CooldownLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CooldownLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CooldownLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastCall, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastCall):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintAuthz(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x12
+	n14, err14 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Cooldown, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Cooldown):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintAuthz(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TimeWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimeWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TimeWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n15, err15 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.End, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.End):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintAuthz(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0x12
+	n16, err16 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Start, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Start):])
+	if err16 != nil {
+		return 0, err16
+	}
+	i -= n16
+	i = encodeVarintAuthz(dAtA, i, uint64(n16))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockTimeWindowsLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockTimeWindowsLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockTimeWindowsLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Windows) > 0 {
+		for iNdEx := len(m.Windows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Windows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AllowAllMessagesFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllowAllMessagesFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllowAllMessagesFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *AcceptedMessageKeysFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AcceptedMessageKeysFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AcceptedMessageKeysFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Keys[iNdEx])
+			copy(dAtA[i:], m.Keys[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.Keys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AcceptedMessagesFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AcceptedMessagesFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AcceptedMessagesFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Messages[iNdEx])
+			copy(dAtA[i:], m.Messages[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.Messages[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JSONPathFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JSONPathFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JSONPathFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Conditions) > 0 {
+		for iNdEx := len(m.Conditions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Conditions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JSONPathCondition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JSONPathCondition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JSONPathCondition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Regex) > 0 {
+		i -= len(m.Regex)
+		copy(dAtA[i:], m.Regex)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.Regex)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.AnyOf) > 0 {
+		for iNdEx := len(m.AnyOf) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AnyOf[iNdEx])
+			copy(dAtA[i:], m.AnyOf[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.AnyOf[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Range != nil {
+		{
+			size, err := m.Range.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuthz(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Equals) > 0 {
+		i -= len(m.Equals)
+		copy(dAtA[i:], m.Equals)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.Equals)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NumericRange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NumericRange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NumericRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Max) > 0 {
+		i -= len(m.Max)
+		copy(dAtA[i:], m.Max)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.Max)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Min) > 0 {
+		i -= len(m.Min)
+		copy(dAtA[i:], m.Min)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.Min)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthz(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *ContractExecutionAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Grants) > 0 {
+		for _, e := range m.Grants {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ContractMigrationAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Grants) > 0 {
+		for _, e := range m.Grants {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ExecuteContractsAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Grants) > 0 {
+		for _, e := range m.Grants {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ContractInstantiationAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Grants) > 0 {
+		for _, e := range m.Grants {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ContractInstantiation2Authorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Grants) > 0 {
+		for _, e := range m.Grants {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StoreCodeAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Grants) > 0 {
+		for _, e := range m.Grants {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ContractUpdateAdminAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Grants) > 0 {
+		for _, e := range m.Grants {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ContractClearAdminAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Grants) > 0 {
+		for _, e := range m.Grants {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ContractGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if m.Limit != nil {
+		l = m.Limit.Size()
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if m.Filter != nil {
+		l = m.Filter.Size()
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if len(m.CodeIDs) > 0 {
+		l = 0
+		for _, e := range m.CodeIDs {
+			l += sovAuthz(uint64(e))
+		}
+		n += 1 + sovAuthz(uint64(l)) + l
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if len(m.ContractLimits) > 0 {
+		for _, e := range m.ContractLimits {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if m.TotalLimit != nil {
+		l = m.TotalLimit.Size()
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if m.Limit != nil {
+		l = m.Limit.Size()
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	return n
+}
+
+func (m *InstantiateGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CodeID != 0 {
+		n += 1 + sovAuthz(uint64(m.CodeID))
+	}
+	if len(m.Labels) > 0 {
+		for _, s := range m.Labels {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if m.Limit != nil {
+		l = m.Limit.Size()
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if m.Filter != nil {
+		l = m.Filter.Size()
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if len(m.Admins) > 0 {
+		for _, s := range m.Admins {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CodeGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CodeHash)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if m.InstantiatePermission != nil {
+		l = m.InstantiatePermission.Size()
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if m.Limit != nil {
+		l = m.Limit.Size()
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	return n
+}
+
+func (m *MaxCallsLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Remaining != 0 {
+		n += 1 + sovAuthz(uint64(m.Remaining))
+	}
+	return n
+}
+
+func (m *MaxFundsLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amounts) > 0 {
+		for _, e := range m.Amounts {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CombinedLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CallsRemaining != 0 {
+		n += 1 + sovAuthz(uint64(m.CallsRemaining))
+	}
+	if len(m.Amounts) > 0 {
+		for _, e := range m.Amounts {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RollingWindowLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window)
+	n += 1 + l + sovAuthz(uint64(l))
+	if m.MaxCalls != 0 {
+		n += 1 + sovAuthz(uint64(m.MaxCalls))
+	}
+	if len(m.MaxAmounts) > 0 {
+		for _, e := range m.MaxAmounts {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.Usage) > 0 {
+		for _, e := range m.Usage {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WindowUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Start)
+	n += 1 + l + sovAuthz(uint64(l))
+	if m.Calls != 0 {
+		n += 1 + sovAuthz(uint64(m.Calls))
+	}
+	if len(m.Amounts) > 0 {
+		for _, e := range m.Amounts {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CooldownLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Cooldown)
+	n += 1 + l + sovAuthz(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastCall)
+	n += 1 + l + sovAuthz(uint64(l))
+	return n
+}
+
+func (m *TimeWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Start)
+	n += 1 + l + sovAuthz(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.End)
+	n += 1 + l + sovAuthz(uint64(l))
+	return n
+}
+
+func (m *BlockTimeWindowsLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Windows) > 0 {
+		for _, e := range m.Windows {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AllowAllMessagesFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *AcceptedMessageKeysFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Keys) > 0 {
+		for _, s := range m.Keys {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AcceptedMessagesFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for _, b := range m.Messages {
+			l = len(b)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *JSONPathFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Conditions) > 0 {
+		for _, e := range m.Conditions {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *JSONPathCondition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	l = len(m.Equals)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if m.Range != nil {
+		l = m.Range.Size()
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if len(m.AnyOf) > 0 {
+		for _, s := range m.AnyOf {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	l = len(m.Regex)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	return n
+}
+
+func (m *NumericRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Min)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	l = len(m.Max)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	return n
+}
+
+func sovAuthz(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func sozAuthz(x uint64) (n int) {
+	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+
+func (m *ContractExecutionAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractExecutionAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractExecutionAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grants = append(m.Grants, ContractGrant{})
+			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *ContractMigrationAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractMigrationAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractMigrationAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grants = append(m.Grants, ContractGrant{})
+			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *ExecuteContractsAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecuteContractsAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecuteContractsAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grants = append(m.Grants, ContractGrant{})
+			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *ContractInstantiationAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractInstantiationAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractInstantiationAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grants = append(m.Grants, InstantiateGrant{})
+			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *ContractInstantiation2Authorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractInstantiation2Authorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractInstantiation2Authorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grants = append(m.Grants, InstantiateGrant{})
+			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *StoreCodeAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StoreCodeAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StoreCodeAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grants = append(m.Grants, CodeGrant{})
+			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *ContractUpdateAdminAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractUpdateAdminAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractUpdateAdminAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grants = append(m.Grants, ContractGrant{})
+			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *ContractClearAdminAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractClearAdminAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractClearAdminAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grants = append(m.Grants, ContractGrant{})
+			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *ContractGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractGrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Limit == nil {
+				m.Limit = &types.Any{}
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Filter == nil {
+				m.Filter = &types.Any{}
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuthz
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.CodeIDs = append(m.CodeIDs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuthz
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAuthz
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAuthz
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.CodeIDs) == 0 {
+					m.CodeIDs = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAuthz
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.CodeIDs = append(m.CodeIDs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeIDs", wireType)
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractLimits = append(m.ContractLimits, ContractLimit{})
+			if err := m.ContractLimits[len(m.ContractLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TotalLimit == nil {
+				m.TotalLimit = &types.Any{}
+			}
+			if err := m.TotalLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *ContractLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Limit == nil {
+				m.Limit = &types.Any{}
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *InstantiateGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InstantiateGrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InstantiateGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			}
+			m.CodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Labels = append(m.Labels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Limit == nil {
+				m.Limit = &types.Any{}
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Filter == nil {
+				m.Filter = &types.Any{}
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admins", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admins = append(m.Admins, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *CodeGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CodeGrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CodeGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeHash = append(m.CodeHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.CodeHash == nil {
+				m.CodeHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantiatePermission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InstantiatePermission == nil {
+				m.InstantiatePermission = &AccessConfig{}
+			}
+			if err := m.InstantiatePermission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Limit == nil {
+				m.Limit = &types.Any{}
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *MaxCallsLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MaxCallsLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MaxCallsLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			m.Remaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Remaining |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *MaxFundsLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MaxFundsLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MaxFundsLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amounts = append(m.Amounts, types1.Coin{})
+			if err := m.Amounts[len(m.Amounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *CombinedLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CombinedLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CombinedLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallsRemaining", wireType)
+			}
+			m.CallsRemaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CallsRemaining |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amounts = append(m.Amounts, types1.Coin{})
+			if err := m.Amounts[len(m.Amounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *RollingWindowLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RollingWindowLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RollingWindowLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCalls", wireType)
+			}
+			m.MaxCalls = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCalls |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxAmounts = append(m.MaxAmounts, types1.Coin{})
+			if err := m.MaxAmounts[len(m.MaxAmounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Usage = append(m.Usage, WindowUsage{})
+			if err := m.Usage[len(m.Usage)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *WindowUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WindowUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WindowUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Start, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calls", wireType)
+			}
+			m.Calls = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Calls |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amounts = append(m.Amounts, types1.Coin{})
+			if err := m.Amounts[len(m.Amounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *AcceptedMessagesFilter) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Messages) > 0 {
-		for _, b := range m.Messages {
-			l = len(b)
-			n += 1 + l + sovAuthz(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovAuthz(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-
-func sozAuthz(x uint64) (n int) {
-	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
 
-func (m *ContractExecutionAuthorization) Unmarshal(dAtA []byte) error {
+func (m *CooldownLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1006,15 +5142,15 @@ func (m *ContractExecutionAuthorization) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractExecutionAuthorization: wiretype end group for non-group")
+			return fmt.Errorf("proto: CooldownLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractExecutionAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CooldownLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cooldown", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1041,8 +5177,40 @@ func (m *ContractExecutionAuthorization) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Grants = append(m.Grants, ContractGrant{})
-			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Cooldown, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastCall", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.LastCall, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1068,7 +5236,7 @@ func (m *ContractExecutionAuthorization) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *ContractMigrationAuthorization) Unmarshal(dAtA []byte) error {
+func (m *TimeWindow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1091,15 +5259,15 @@ func (m *ContractMigrationAuthorization) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractMigrationAuthorization: wiretype end group for non-group")
+			return fmt.Errorf("proto: TimeWindow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractMigrationAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TimeWindow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1126,8 +5294,40 @@ func (m *ContractMigrationAuthorization) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Grants = append(m.Grants, ContractGrant{})
-			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Start, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.End, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1153,7 +5353,7 @@ func (m *ContractMigrationAuthorization) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *ContractGrant) Unmarshal(dAtA []byte) error {
+func (m *BlockTimeWindowsLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1176,17 +5376,17 @@ func (m *ContractGrant) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractGrant: wiretype end group for non-group")
+			return fmt.Errorf("proto: BlockTimeWindowsLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BlockTimeWindowsLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuthz
@@ -1196,65 +5396,133 @@ func (m *ContractGrant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAuthz
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuthz
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contract = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			m.Windows = append(m.Windows, TimeWindow{})
+			if err := m.Windows[len(m.Windows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuthz
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthAuthz
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAuthz
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *AllowAllMessagesFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Limit == nil {
-				m.Limit = &types.Any{}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllowAllMessagesFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllowAllMessagesFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *AcceptedMessageKeysFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AcceptedMessageKeysFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AcceptedMessageKeysFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuthz
@@ -1264,27 +5532,23 @@ func (m *ContractGrant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAuthz
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuthz
 			}
 			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Filter == nil {
-				m.Filter = &types.Any{}
-			}
-			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+				return io.ErrUnexpectedEOF
 			}
+			m.Keys = append(m.Keys, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1308,7 +5572,7 @@ func (m *ContractGrant) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *MaxCallsLimit) Unmarshal(dAtA []byte) error {
+func (m *AcceptedMessagesFilter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1331,17 +5595,17 @@ func (m *MaxCallsLimit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MaxCallsLimit: wiretype end group for non-group")
+			return fmt.Errorf("proto: AcceptedMessagesFilter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MaxCallsLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AcceptedMessagesFilter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
 			}
-			m.Remaining = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuthz
@@ -1351,11 +5615,24 @@ func (m *MaxCallsLimit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Remaining |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, make([]byte, postIndex-iNdEx))
+			copy(m.Messages[len(m.Messages)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuthz(dAtA[iNdEx:])
@@ -1378,7 +5655,7 @@ func (m *MaxCallsLimit) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *MaxFundsLimit) Unmarshal(dAtA []byte) error {
+func (m *JSONPathFilter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1401,15 +5678,15 @@ func (m *MaxFundsLimit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MaxFundsLimit: wiretype end group for non-group")
+			return fmt.Errorf("proto: JSONPathFilter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MaxFundsLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JSONPathFilter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1436,8 +5713,8 @@ func (m *MaxFundsLimit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amounts = append(m.Amounts, types1.Coin{})
-			if err := m.Amounts[len(m.Amounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Conditions = append(m.Conditions, JSONPathCondition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1463,7 +5740,7 @@ func (m *MaxFundsLimit) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *CombinedLimit) Unmarshal(dAtA []byte) error {
+func (m *JSONPathCondition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1486,17 +5763,17 @@ func (m *CombinedLimit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CombinedLimit: wiretype end group for non-group")
+			return fmt.Errorf("proto: JSONPathCondition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CombinedLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JSONPathCondition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CallsRemaining", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
-			m.CallsRemaining = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuthz
@@ -1506,16 +5783,29 @@ func (m *CombinedLimit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CallsRemaining |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Equals", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuthz
@@ -1525,131 +5815,95 @@ func (m *CombinedLimit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAuthz
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuthz
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amounts = append(m.Amounts, types1.Coin{})
-			if err := m.Amounts[len(m.Amounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Equals = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAuthz(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Range", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthAuthz
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-
-func (m *AllowAllMessagesFilter) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAuthz
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Range == nil {
+				m.Range = &NumericRange{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AllowAllMessagesFilter: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllowAllMessagesFilter: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAuthz(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Range.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthAuthz
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnyOf", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-
-func (m *AcceptedMessageKeysFilter) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAuthz
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AcceptedMessageKeysFilter: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AcceptedMessageKeysFilter: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.AnyOf = append(m.AnyOf, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Regex", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1677,7 +5931,7 @@ func (m *AcceptedMessageKeysFilter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Keys = append(m.Keys, string(dAtA[iNdEx:postIndex]))
+			m.Regex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1701,7 +5955,7 @@ func (m *AcceptedMessageKeysFilter) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *AcceptedMessagesFilter) Unmarshal(dAtA []byte) error {
+func (m *NumericRange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1724,17 +5978,17 @@ func (m *AcceptedMessagesFilter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AcceptedMessagesFilter: wiretype end group for non-group")
+			return fmt.Errorf("proto: NumericRange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AcceptedMessagesFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NumericRange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuthz
@@ -1744,23 +5998,55 @@ func (m *AcceptedMessagesFilter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAuthz
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuthz
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Messages = append(m.Messages, make([]byte, postIndex-iNdEx))
-			copy(m.Messages[len(m.Messages)-1], dAtA[iNdEx:postIndex])
+			m.Min = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Max = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
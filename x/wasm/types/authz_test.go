@@ -1,8 +1,14 @@
 package types
 
 import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
 	"math"
+	"strings"
 	"testing"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -72,6 +78,79 @@ func TestContractAuthzFilterValidate(t *testing.T) {
 		"allow all message - always valid": {
 			src: NewAllowAllMessagesFilter(),
 		},
+		"json path - all matchers": {
+			src: NewJSONPathFilter(
+				NewJSONPathEqualsCondition("swap.pool_id", `"1"`),
+				NewJSONPathRangeCondition("swap.amount", "1", "1000.5"),
+				NewJSONPathAnyOfCondition("swap.recipient", "foo", "bar"),
+				NewJSONPathRegexCondition("swap.denom", `u[a-z]+`),
+			),
+		},
+		"json path - range with min only": {
+			src: NewJSONPathFilter(NewJSONPathRangeCondition("amount", "-1", "")),
+		},
+		"json path - range with max only": {
+			src: NewJSONPathFilter(NewJSONPathRangeCondition("amount", "", "1")),
+		},
+		"json path - empty": {
+			src:    NewJSONPathFilter(),
+			expErr: true,
+		},
+		"json path - empty path": {
+			src:    NewJSONPathFilter(NewJSONPathEqualsCondition("", `1`)),
+			expErr: true,
+		},
+		"json path - empty path segment": {
+			src:    NewJSONPathFilter(NewJSONPathEqualsCondition("swap..pool_id", `1`)),
+			expErr: true,
+		},
+		"json path - no matcher": {
+			src:    NewJSONPathFilter(JSONPathCondition{Path: "swap"}),
+			expErr: true,
+		},
+		"json path - multiple matchers": {
+			src:    NewJSONPathFilter(JSONPathCondition{Path: "swap", Equals: `1`, Regex: "1"}),
+			expErr: true,
+		},
+		"json path - equals non json": {
+			src:    NewJSONPathFilter(NewJSONPathEqualsCondition("swap", `foo`)),
+			expErr: true,
+		},
+		"json path - range without bounds": {
+			src:    NewJSONPathFilter(NewJSONPathRangeCondition("amount", "", "")),
+			expErr: true,
+		},
+		"json path - range with invalid bound": {
+			src:    NewJSONPathFilter(NewJSONPathRangeCondition("amount", "one", "")),
+			expErr: true,
+		},
+		"json path - range with min greater max": {
+			src:    NewJSONPathFilter(NewJSONPathRangeCondition("amount", "2", "1")),
+			expErr: true,
+		},
+		"json path - any of with duplicates": {
+			src:    NewJSONPathFilter(NewJSONPathAnyOfCondition("recipient", "foo", "foo")),
+			expErr: true,
+		},
+		"json path - any of with empty value": {
+			src:    NewJSONPathFilter(NewJSONPathAnyOfCondition("recipient", "foo", "")),
+			expErr: true,
+		},
+		"json path - invalid regex": {
+			src:    NewJSONPathFilter(NewJSONPathRegexCondition("denom", `u[a-z`)),
+			expErr: true,
+		},
+		"json path - regex exceeds max length": {
+			src:    NewJSONPathFilter(NewJSONPathRegexCondition("denom", strings.Repeat("a", MaxJSONPathRegexLength+1))),
+			expErr: true,
+		},
+		"json path - regex with large repetition": {
+			src: NewJSONPathFilter(NewJSONPathRegexCondition("denom", `u[a-z]{1000}`)),
+		},
+		"json path - regex program exceeds max size": {
+			src:    NewJSONPathFilter(NewJSONPathRegexCondition("denom", `(?:[a-z]{1000}){3}`)),
+			expErr: true,
+		},
 		"undefined - always invalid": {
 			src:    &UndefinedFilter{},
 			expErr: true,
@@ -90,6 +169,7 @@ func TestContractAuthzFilterValidate(t *testing.T) {
 }
 
 func TestContractAuthzFilterAccept(t *testing.T) {
+	const swapMsg = `{"swap":{"pool_id":"1","amount":"1000","min_out":5,"recipient":"foo","denom":"uatom","routes":[{"pool_id":7}]}}`
 	specs := map[string]struct {
 		filter         ContractAuthzFilterX
 		src            RawContractMessage
@@ -166,6 +246,67 @@ func TestContractAuthzFilterAccept(t *testing.T) {
 			src:    []byte(`not json`),
 			expErr: true,
 		},
+		"json path - all conditions met": {
+			filter: NewJSONPathFilter(
+				NewJSONPathEqualsCondition("swap.pool_id", `"1"`),
+				NewJSONPathRangeCondition("swap.amount", "1", "1000"),
+				NewJSONPathRangeCondition("swap.min_out", "5", ""),
+				NewJSONPathAnyOfCondition("swap.recipient", "foo", "bar"),
+				NewJSONPathRegexCondition("swap.denom", `u[a-z]+`),
+				NewJSONPathEqualsCondition("swap.routes.0", `{"pool_id": 7}`),
+			),
+			src:            []byte(swapMsg),
+			exp:            true,
+			expGasConsumed: sdk.Gas(len(swapMsg) + len(`"1"`) + 10*len(`u[a-z]+`) + len(`{"pool_id": 7}`)),
+		},
+		"json path - equals not met": {
+			filter:         NewJSONPathFilter(NewJSONPathEqualsCondition("swap.pool_id", `"2"`)),
+			src:            []byte(swapMsg),
+			expGasConsumed: sdk.Gas(len(swapMsg) + len(`"2"`)),
+		},
+		"json path - equals with other type": {
+			filter:         NewJSONPathFilter(NewJSONPathEqualsCondition("swap.pool_id", `1`)),
+			src:            []byte(swapMsg),
+			expGasConsumed: sdk.Gas(len(swapMsg) + len(`1`)),
+		},
+		"json path - range exceeded": {
+			filter:         NewJSONPathFilter(NewJSONPathRangeCondition("swap.amount", "", "999.9")),
+			src:            []byte(swapMsg),
+			expGasConsumed: sdk.Gas(len(swapMsg)),
+		},
+		"json path - range on non numeric value": {
+			filter:         NewJSONPathFilter(NewJSONPathRangeCondition("swap.denom", "0", "")),
+			src:            []byte(swapMsg),
+			expGasConsumed: sdk.Gas(len(swapMsg)),
+		},
+		"json path - any of not met": {
+			filter:         NewJSONPathFilter(NewJSONPathAnyOfCondition("swap.recipient", "bar")),
+			src:            []byte(swapMsg),
+			expGasConsumed: sdk.Gas(len(swapMsg)),
+		},
+		"json path - regex must match in full": {
+			filter:         NewJSONPathFilter(NewJSONPathRegexCondition("swap.denom", `u[a-z]`)),
+			src:            []byte(swapMsg),
+			expGasConsumed: sdk.Gas(len(swapMsg) + 10*len(`u[a-z]`)),
+		},
+		"json path - one of multiple conditions not met": {
+			filter: NewJSONPathFilter(
+				NewJSONPathEqualsCondition("swap.pool_id", `"1"`),
+				NewJSONPathRangeCondition("swap.amount", "1", "10"),
+			),
+			src:            []byte(swapMsg),
+			expGasConsumed: sdk.Gas(len(swapMsg) + len(`"1"`)),
+		},
+		"json path - path not found": {
+			filter:         NewJSONPathFilter(NewJSONPathEqualsCondition("swap.routes.1.pool_id", `7`)),
+			src:            []byte(swapMsg),
+			expGasConsumed: sdk.Gas(len(swapMsg) + len(`7`)),
+		},
+		"json path - invalid msg": {
+			filter: NewJSONPathFilter(NewJSONPathEqualsCondition("swap", `1`)),
+			src:    []byte(`not a json msg`),
+			expErr: true,
+		},
 		"undefined - always errors": {
 			filter: &UndefinedFilter{},
 			src:    []byte(`{"foo":"bar"}`),
@@ -239,6 +380,102 @@ func TestContractAuthzLimitValidate(t *testing.T) {
 			src:    &CombinedLimit{CallsRemaining: 1, Amounts: sdk.Coins{oneToken, oneToken}},
 			expErr: true,
 		},
+		"rolling window": {
+			src: NewRollingWindowLimit(time.Hour, 1, oneToken),
+		},
+		"rolling window - calls only": {
+			src: NewRollingWindowLimit(time.Hour, 1),
+		},
+		"rolling window - amounts only": {
+			src: NewRollingWindowLimit(time.Hour, 0, oneToken),
+		},
+		"rolling window - with usage": {
+			src: &RollingWindowLimit{Window: time.Hour, MaxCalls: 2, MaxAmounts: sdk.NewCoins(oneToken), Usage: []WindowUsage{
+				{Start: time.Unix(100, 0), Calls: 1, Amounts: sdk.NewCoins(oneToken)},
+				{Start: time.Unix(250, 0), Calls: 1},
+			}},
+		},
+		"rolling window - empty window": {
+			src:    NewRollingWindowLimit(0, 1),
+			expErr: true,
+		},
+		"rolling window - negative window": {
+			src:    NewRollingWindowLimit(-time.Hour, 1),
+			expErr: true,
+		},
+		"rolling window - sub-window below a second": {
+			src:    NewRollingWindowLimit(time.Duration(RollingWindowLimitBuckets)*time.Second-1, 1),
+			expErr: true,
+		},
+		"rolling window - empty calls and amounts": {
+			src:    NewRollingWindowLimit(time.Hour, 0),
+			expErr: true,
+		},
+		"rolling window - invalid amounts": {
+			src:    &RollingWindowLimit{Window: time.Hour, MaxAmounts: sdk.Coins{oneToken, oneToken}},
+			expErr: true,
+		},
+		"rolling window - usage not ascending": {
+			src: &RollingWindowLimit{Window: time.Hour, MaxCalls: 2, Usage: []WindowUsage{
+				{Start: time.Unix(250, 0), Calls: 1},
+				{Start: time.Unix(100, 0), Calls: 1},
+			}},
+			expErr: true,
+		},
+		"rolling window - too many usage entries": {
+			src: &RollingWindowLimit{Window: time.Hour, MaxCalls: 100, Usage: func() []WindowUsage {
+				r := make([]WindowUsage, RollingWindowLimitBuckets+2)
+				for i := range r {
+					r[i] = WindowUsage{Start: time.Unix(int64(i), 0), Calls: 1}
+				}
+				return r
+			}()},
+			expErr: true,
+		},
+		"rolling window - calls used exceed max": {
+			src:    &RollingWindowLimit{Window: time.Hour, MaxCalls: 1, Usage: []WindowUsage{{Start: time.Unix(100, 0), Calls: 2}}},
+			expErr: true,
+		},
+		"rolling window - amounts used exceed max": {
+			src:    &RollingWindowLimit{Window: time.Hour, MaxCalls: 1, Usage: []WindowUsage{{Start: time.Unix(100, 0), Amounts: sdk.NewCoins(oneToken)}}},
+			expErr: true,
+		},
+		"cooldown": {
+			src: NewCooldownLimit(time.Minute),
+		},
+		"cooldown - empty": {
+			src:    NewCooldownLimit(0),
+			expErr: true,
+		},
+		"block time windows": {
+			src: NewBlockTimeWindowsLimit(
+				TimeWindow{Start: time.Unix(100, 0), End: time.Unix(200, 0)},
+				TimeWindow{Start: time.Unix(200, 0), End: time.Unix(300, 0)},
+			),
+		},
+		"block time windows - empty": {
+			src:    NewBlockTimeWindowsLimit(),
+			expErr: true,
+		},
+		"block time windows - end before start": {
+			src:    NewBlockTimeWindowsLimit(TimeWindow{Start: time.Unix(200, 0), End: time.Unix(100, 0)}),
+			expErr: true,
+		},
+		"block time windows - empty range": {
+			src:    NewBlockTimeWindowsLimit(TimeWindow{Start: time.Unix(100, 0), End: time.Unix(100, 0)}),
+			expErr: true,
+		},
+		"block time windows - empty start": {
+			src:    NewBlockTimeWindowsLimit(TimeWindow{End: time.Unix(100, 0)}),
+			expErr: true,
+		},
+		"block time windows - overlapping": {
+			src: NewBlockTimeWindowsLimit(
+				TimeWindow{Start: time.Unix(100, 0), End: time.Unix(200, 0)},
+				TimeWindow{Start: time.Unix(150, 0), End: time.Unix(300, 0)},
+			),
+			expErr: true,
+		},
 		"undefined": {
 			src:    &UndefinedLimit{},
 			expErr: true,
@@ -389,6 +626,155 @@ func TestContractAuthzLimitAccept(t *testing.T) {
 	}
 }
 
+func TestContractAuthzTimeLimitAccept(t *testing.T) {
+	oneToken := sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())
+	otherToken := sdk.NewCoin("other", sdk.OneInt())
+	now := time.Unix(1_000_000, 0).UTC()
+	subWindow := time.Hour / time.Duration(RollingWindowLimitBuckets)
+	subWindowStart := now.Truncate(subWindow)
+	window := func(start, end int64) TimeWindow {
+		return TimeWindow{Start: now.Add(time.Duration(start) * time.Second), End: now.Add(time.Duration(end) * time.Second)}
+	}
+	specs := map[string]struct {
+		limit  ContractAuthzLimitX
+		src    AuthzableWasmMsg
+		exp    *ContractAuthzLimitAcceptResult
+		expErr bool
+	}{
+		"rolling window - first call": {
+			limit: NewRollingWindowLimit(time.Hour, 2, oneToken.Add(oneToken)),
+			src:   &MsgExecuteContract{Funds: sdk.NewCoins(oneToken)},
+			exp: &ContractAuthzLimitAcceptResult{Accepted: true, UpdateLimit: &RollingWindowLimit{
+				Window: time.Hour, MaxCalls: 2, MaxAmounts: sdk.NewCoins(oneToken.Add(oneToken)),
+				Usage: []WindowUsage{{Start: subWindowStart, Calls: 1, Amounts: sdk.NewCoins(oneToken)}},
+			}},
+		},
+		"rolling window - usage added to current sub-window": {
+			limit: &RollingWindowLimit{
+				Window: time.Hour, MaxCalls: 2, MaxAmounts: sdk.NewCoins(oneToken.Add(oneToken)),
+				Usage: []WindowUsage{{Start: subWindowStart, Calls: 1, Amounts: sdk.NewCoins(oneToken)}},
+			},
+			src: &MsgExecuteContract{Funds: sdk.NewCoins(oneToken)},
+			exp: &ContractAuthzLimitAcceptResult{Accepted: true, UpdateLimit: &RollingWindowLimit{
+				Window: time.Hour, MaxCalls: 2, MaxAmounts: sdk.NewCoins(oneToken.Add(oneToken)),
+				Usage: []WindowUsage{{Start: subWindowStart, Calls: 2, Amounts: sdk.NewCoins(oneToken.Add(oneToken))}},
+			}},
+		},
+		"rolling window - new sub-window appended": {
+			limit: &RollingWindowLimit{
+				Window: time.Hour, MaxCalls: 3, MaxAmounts: sdk.NewCoins(oneToken.Add(oneToken)),
+				Usage: []WindowUsage{{Start: subWindowStart.Add(-subWindow), Calls: 1, Amounts: sdk.NewCoins(oneToken)}},
+			},
+			src: &MsgExecuteContract{},
+			exp: &ContractAuthzLimitAcceptResult{Accepted: true, UpdateLimit: &RollingWindowLimit{
+				Window: time.Hour, MaxCalls: 3, MaxAmounts: sdk.NewCoins(oneToken.Add(oneToken)),
+				Usage: []WindowUsage{
+					{Start: subWindowStart.Add(-subWindow), Calls: 1, Amounts: sdk.NewCoins(oneToken)},
+					{Start: subWindowStart, Calls: 1, Amounts: sdk.NewCoins()},
+				},
+			}},
+		},
+		"rolling window - calls exceeded by sub-window at the start of the window": {
+			limit: &RollingWindowLimit{
+				Window: time.Hour, MaxCalls: 1, MaxAmounts: sdk.NewCoins(oneToken),
+				Usage: []WindowUsage{{Start: subWindowStart.Add(-time.Hour), Calls: 1}},
+			},
+			src: &MsgExecuteContract{},
+			exp: &ContractAuthzLimitAcceptResult{Accepted: false},
+		},
+		"rolling window - amounts exceeded across sub-windows": {
+			limit: &RollingWindowLimit{
+				Window: time.Hour, MaxAmounts: sdk.NewCoins(oneToken.Add(oneToken)),
+				Usage: []WindowUsage{
+					{Start: subWindowStart.Add(-30 * time.Minute), Calls: 1},
+					{Start: subWindowStart.Add(-subWindow), Calls: 1, Amounts: sdk.NewCoins(oneToken)},
+				},
+			},
+			src: &MsgExecuteContract{Funds: sdk.NewCoins(oneToken.Add(oneToken))},
+			exp: &ContractAuthzLimitAcceptResult{Accepted: false},
+		},
+		"rolling window - elapsed sub-windows removed": {
+			limit: &RollingWindowLimit{
+				Window: time.Hour, MaxCalls: 1, MaxAmounts: sdk.NewCoins(oneToken),
+				Usage: []WindowUsage{{Start: subWindowStart.Add(-time.Hour - subWindow), Calls: 1, Amounts: sdk.NewCoins(oneToken)}},
+			},
+			src: &MsgExecuteContract{Funds: sdk.NewCoins(oneToken)},
+			exp: &ContractAuthzLimitAcceptResult{Accepted: true, UpdateLimit: &RollingWindowLimit{
+				Window: time.Hour, MaxCalls: 1, MaxAmounts: sdk.NewCoins(oneToken),
+				Usage: []WindowUsage{{Start: subWindowStart, Calls: 1, Amounts: sdk.NewCoins(oneToken)}},
+			}},
+		},
+		"rolling window - unknown token": {
+			limit: NewRollingWindowLimit(time.Hour, 0, oneToken),
+			src:   &MsgExecuteContract{Funds: sdk.NewCoins(otherToken)},
+			exp:   &ContractAuthzLimitAcceptResult{Accepted: false},
+		},
+		"rolling window - no funds allowed without max amounts": {
+			limit: NewRollingWindowLimit(time.Hour, 1),
+			src:   &MsgExecuteContract{Funds: sdk.NewCoins(oneToken)},
+			exp:   &ContractAuthzLimitAcceptResult{Accepted: false},
+		},
+		"cooldown - first call": {
+			limit: NewCooldownLimit(time.Minute),
+			src:   &MsgExecuteContract{},
+			exp:   &ContractAuthzLimitAcceptResult{Accepted: true, UpdateLimit: &CooldownLimit{Cooldown: time.Minute, LastCall: now}},
+		},
+		"cooldown - passed": {
+			limit: &CooldownLimit{Cooldown: time.Minute, LastCall: now.Add(-time.Minute)},
+			src:   &MsgExecuteContract{},
+			exp:   &ContractAuthzLimitAcceptResult{Accepted: true, UpdateLimit: &CooldownLimit{Cooldown: time.Minute, LastCall: now}},
+		},
+		"cooldown - not passed": {
+			limit: &CooldownLimit{Cooldown: time.Minute, LastCall: now.Add(-time.Minute + time.Second)},
+			src:   &MsgExecuteContract{},
+			exp:   &ContractAuthzLimitAcceptResult{Accepted: false},
+		},
+		"cooldown - rejected with some fund transfer": {
+			limit: NewCooldownLimit(time.Minute),
+			src:   &MsgExecuteContract{Funds: sdk.NewCoins(oneToken)},
+			exp:   &ContractAuthzLimitAcceptResult{Accepted: false},
+		},
+		"block time windows - in first window": {
+			limit: NewBlockTimeWindowsLimit(window(0, 10), window(20, 30)),
+			src:   &MsgExecuteContract{},
+			exp:   &ContractAuthzLimitAcceptResult{Accepted: true},
+		},
+		"block time windows - passed windows removed": {
+			limit: NewBlockTimeWindowsLimit(window(-20, -10), window(-1, 1), window(20, 30)),
+			src:   &MsgExecuteContract{},
+			exp:   &ContractAuthzLimitAcceptResult{Accepted: true, UpdateLimit: NewBlockTimeWindowsLimit(window(-1, 1), window(20, 30))},
+		},
+		"block time windows - end exclusive": {
+			limit: NewBlockTimeWindowsLimit(window(-10, 0), window(20, 30)),
+			src:   &MsgExecuteContract{},
+			exp:   &ContractAuthzLimitAcceptResult{Accepted: false},
+		},
+		"block time windows - before windows": {
+			limit: NewBlockTimeWindowsLimit(window(1, 10)),
+			src:   &MsgExecuteContract{},
+			exp:   &ContractAuthzLimitAcceptResult{Accepted: false},
+		},
+		"block time windows - rejected with some fund transfer": {
+			limit: NewBlockTimeWindowsLimit(window(0, 10)),
+			src:   &MsgExecuteContract{Funds: sdk.NewCoins(oneToken)},
+			exp:   &ContractAuthzLimitAcceptResult{Accepted: false},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx := sdk.Context{}.WithBlockTime(now)
+			gotResult, gotErr := spec.limit.Accept(ctx, spec.src)
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.exp, gotResult)
+		})
+	}
+}
+
 func TestValidateContractGrant(t *testing.T) {
 	specs := map[string]struct {
 		setup  func(t *testing.T) ContractGrant
@@ -452,6 +838,113 @@ func TestValidateContractGrant(t *testing.T) {
 			},
 			expErr: true,
 		},
+		"code ids": {
+			setup: func(t *testing.T) ContractGrant {
+				return mustCodeIDsGrant([]uint64{1, 2}, NewMaxCallsLimit(1), NewMaxCallsLimit(10), NewAllowAllMessagesFilter())
+			},
+		},
+		"creator": {
+			setup: func(t *testing.T) ContractGrant {
+				return mustCreatorGrant(randBytes(SDKAddrLen), NewMaxCallsLimit(1), NewMaxCallsLimit(10), NewAllowAllMessagesFilter())
+			},
+		},
+		"invalid code id": {
+			setup: func(t *testing.T) ContractGrant {
+				return mustCodeIDsGrant([]uint64{0}, NewMaxCallsLimit(1), NewMaxCallsLimit(10), NewAllowAllMessagesFilter())
+			},
+			expErr: true,
+		},
+		"invalid creator": {
+			setup: func(t *testing.T) ContractGrant {
+				r := mustCreatorGrant(randBytes(SDKAddrLen), NewMaxCallsLimit(1), NewMaxCallsLimit(10), NewAllowAllMessagesFilter())
+				r.Creator = "invalid"
+				return r
+			},
+			expErr: true,
+		},
+		"contract and code ids": {
+			setup: func(t *testing.T) ContractGrant {
+				r := mustGrant(randBytes(ContractAddrLen), NewMaxCallsLimit(1), NewAllowAllMessagesFilter())
+				r.CodeIDs = []uint64{1}
+				return r
+			},
+			expErr: true,
+		},
+		"code ids and creator": {
+			setup: func(t *testing.T) ContractGrant {
+				r := mustCodeIDsGrant([]uint64{1}, NewMaxCallsLimit(1), NewMaxCallsLimit(10), NewAllowAllMessagesFilter())
+				r.Creator = sdk.AccAddress(randBytes(SDKAddrLen)).String()
+				return r
+			},
+			expErr: true,
+		},
+		"contract limits": {
+			setup: func(t *testing.T) ContractGrant {
+				r := mustCodeIDsGrant([]uint64{1}, NewMaxCallsLimit(2), NewMaxCallsLimit(10), NewAllowAllMessagesFilter())
+				r.ContractLimits = []ContractLimit{
+					mustContractLimit(randBytes(ContractAddrLen), NewMaxCallsLimit(1)),
+					mustContractLimit(randBytes(ContractAddrLen), NewMaxCallsLimit(2)),
+				}
+				return r
+			},
+		},
+		"too many contract limits": {
+			setup: func(t *testing.T) ContractGrant {
+				r := mustCodeIDsGrant([]uint64{1}, NewMaxCallsLimit(2), NewMaxCallsLimit(10), NewAllowAllMessagesFilter())
+				for i := 0; i <= MaxContractLimitsPerGrant; i++ {
+					r.ContractLimits = append(r.ContractLimits, mustContractLimit(randBytes(ContractAddrLen), NewMaxCallsLimit(1)))
+				}
+				return r
+			},
+			expErr: true,
+		},
+		"total limit missing": {
+			setup: func(t *testing.T) ContractGrant {
+				r := mustCodeIDsGrant([]uint64{1}, NewMaxCallsLimit(1), NewMaxCallsLimit(10), NewAllowAllMessagesFilter())
+				r.TotalLimit = nil
+				return r
+			},
+			expErr: true,
+		},
+		"invalid total limit": {
+			setup: func(t *testing.T) ContractGrant {
+				return mustCreatorGrant(randBytes(SDKAddrLen), NewMaxCallsLimit(1), NewMaxCallsLimit(0), NewAllowAllMessagesFilter())
+			},
+			expErr: true,
+		},
+		"total limit for single contract": {
+			setup: func(t *testing.T) ContractGrant {
+				r := mustGrant(randBytes(ContractAddrLen), NewMaxCallsLimit(1), NewAllowAllMessagesFilter())
+				r.TotalLimit = mustCodeIDsGrant([]uint64{1}, NewMaxCallsLimit(1), NewMaxCallsLimit(10), NewAllowAllMessagesFilter()).TotalLimit
+				return r
+			},
+			expErr: true,
+		},
+		"contract limits for single contract": {
+			setup: func(t *testing.T) ContractGrant {
+				r := mustGrant(randBytes(ContractAddrLen), NewMaxCallsLimit(1), NewAllowAllMessagesFilter())
+				r.ContractLimits = []ContractLimit{mustContractLimit(randBytes(ContractAddrLen), NewMaxCallsLimit(1))}
+				return r
+			},
+			expErr: true,
+		},
+		"duplicate contract limits": {
+			setup: func(t *testing.T) ContractGrant {
+				r := mustCodeIDsGrant([]uint64{1}, NewMaxCallsLimit(1), NewMaxCallsLimit(10), NewAllowAllMessagesFilter())
+				l := mustContractLimit(randBytes(ContractAddrLen), NewMaxCallsLimit(1))
+				r.ContractLimits = []ContractLimit{l, l}
+				return r
+			},
+			expErr: true,
+		},
+		"invalid contract limit": {
+			setup: func(t *testing.T) ContractGrant {
+				r := mustCodeIDsGrant([]uint64{1}, NewMaxCallsLimit(1), NewMaxCallsLimit(10), NewAllowAllMessagesFilter())
+				r.ContractLimits = []ContractLimit{mustContractLimit(randBytes(ContractAddrLen), NewMaxCallsLimit(0))}
+				return r
+			},
+			expErr: true,
+		},
 	}
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
@@ -608,6 +1101,24 @@ func TestAcceptGrantedMessage(t *testing.T) {
 				),
 			},
 		},
+		"accepted and updated - rolling window": {
+			auth: NewContractExecutionAuthorization(mustGrant(myContractAddr, NewRollingWindowLimit(time.Hour, 2, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(2))), NewAllowAllMessagesFilter())),
+			msg: &MsgExecuteContract{
+				Sender:   sdk.AccAddress(randBytes(SDKAddrLen)).String(),
+				Contract: myContractAddr.String(),
+				Msg:      []byte(`{"foo":"bar"}`),
+				Funds:    sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())),
+			},
+			expResult: authztypes.AcceptResponse{
+				Accept: true,
+				Updated: NewContractExecutionAuthorization(mustGrant(myContractAddr, &RollingWindowLimit{
+					Window:     time.Hour,
+					MaxCalls:   2,
+					MaxAmounts: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(2))),
+					Usage:      []WindowUsage{{Calls: 1, Amounts: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt()))}},
+				}, NewAllowAllMessagesFilter())),
+			},
+		},
 		"not accepted - no matching contract address": {
 			auth: NewContractExecutionAuthorization(mustGrant(myContractAddr, NewMaxCallsLimit(1), NewAllowAllMessagesFilter())),
 			msg: &MsgExecuteContract{
@@ -727,3 +1238,922 @@ func mustGrant(contract sdk.AccAddress, limit ContractAuthzLimitX, filter Contra
 	}
 	return *g
 }
+
+func TestValidateInstantiateGrant(t *testing.T) {
+	specs := map[string]struct {
+		setup  func(t *testing.T) InstantiateGrant
+		expErr bool
+	}{
+		"all good": {
+			setup: func(t *testing.T) InstantiateGrant {
+				return mustInstantiateGrant(1, []string{"foo"}, NewMaxCallsLimit(1), NewAllowAllMessagesFilter())
+			},
+		},
+		"any label": {
+			setup: func(t *testing.T) InstantiateGrant {
+				return mustInstantiateGrant(1, nil, NewMaxCallsLimit(1), NewAllowAllMessagesFilter())
+			},
+		},
+		"code id missing": {
+			setup: func(t *testing.T) InstantiateGrant {
+				return mustInstantiateGrant(0, nil, NewMaxCallsLimit(1), NewAllowAllMessagesFilter())
+			},
+			expErr: true,
+		},
+		"with admins": {
+			setup: func(t *testing.T) InstantiateGrant {
+				g := mustInstantiateGrant(1, nil, NewMaxCallsLimit(1), NewAllowAllMessagesFilter())
+				g.Admins = []string{sdk.AccAddress(randBytes(SDKAddrLen)).String()}
+				return g
+			},
+		},
+		"invalid admin": {
+			setup: func(t *testing.T) InstantiateGrant {
+				g := mustInstantiateGrant(1, nil, NewMaxCallsLimit(1), NewAllowAllMessagesFilter())
+				g.Admins = []string{"invalid"}
+				return g
+			},
+			expErr: true,
+		},
+		"empty label": {
+			setup: func(t *testing.T) InstantiateGrant {
+				return mustInstantiateGrant(1, []string{""}, NewMaxCallsLimit(1), NewAllowAllMessagesFilter())
+			},
+			expErr: true,
+		},
+		"invalid limit": {
+			setup: func(t *testing.T) InstantiateGrant {
+				return mustInstantiateGrant(1, nil, NewMaxCallsLimit(0), NewAllowAllMessagesFilter())
+			},
+			expErr: true,
+		},
+		"filter missing": {
+			setup: func(t *testing.T) InstantiateGrant {
+				g := mustInstantiateGrant(1, nil, NewMaxCallsLimit(1), NewAllowAllMessagesFilter())
+				g.Filter = nil
+				return g
+			},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotErr := spec.setup(t).ValidateBasic()
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+}
+
+func TestValidateCodeGrant(t *testing.T) {
+	checksum := sha256.Sum256([]byte("foo"))
+	specs := map[string]struct {
+		setup  func(t *testing.T) CodeGrant
+		expErr bool
+	}{
+		"all good": {
+			setup: func(t *testing.T) CodeGrant {
+				return mustCodeGrant(checksum[:], &AllowNobody, NewMaxCallsLimit(1))
+			},
+		},
+		"any permission": {
+			setup: func(t *testing.T) CodeGrant {
+				return mustCodeGrant(checksum[:], nil, NewMaxCallsLimit(1))
+			},
+		},
+		"invalid checksum": {
+			setup: func(t *testing.T) CodeGrant {
+				return mustCodeGrant([]byte("foo"), nil, NewMaxCallsLimit(1))
+			},
+			expErr: true,
+		},
+		"invalid permission": {
+			setup: func(t *testing.T) CodeGrant {
+				return mustCodeGrant(checksum[:], &AccessConfig{Permission: AccessTypeUnspecified}, NewMaxCallsLimit(1))
+			},
+			expErr: true,
+		},
+		"limit missing": {
+			setup: func(t *testing.T) CodeGrant {
+				g := mustCodeGrant(checksum[:], nil, NewMaxCallsLimit(1))
+				g.Limit = nil
+				return g
+			},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotErr := spec.setup(t).ValidateBasic()
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+}
+
+func TestAcceptGrantedInstantiateStoreCodeAndAdminMessages(t *testing.T) {
+	myContractAddr := sdk.AccAddress(randBytes(SDKAddrLen))
+	otherContractAddr := sdk.AccAddress(randBytes(SDKAddrLen))
+	mySenderAddr := sdk.AccAddress(randBytes(SDKAddrLen))
+	myGranteeAddr := sdk.AccAddress(randBytes(SDKAddrLen))
+	myNewAdminAddr := sdk.AccAddress(randBytes(SDKAddrLen))
+	myWasmCode := []byte("my wasm code")
+	myChecksum := sha256.Sum256(myWasmCode)
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	_, err := zw.Write(myWasmCode)
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+	myGzippedWasmCode := buf.Bytes()
+
+	specs := map[string]struct {
+		auth      authztypes.Authorization
+		msg       sdk.Msg
+		expResult authztypes.AcceptResponse
+		expErr    *errorsmod.Error
+	}{
+		"instantiate accepted and updated": {
+			auth: NewContractInstantiationAuthorization(mustInstantiateGrant(1, []string{"foo"}, NewMaxCallsLimit(2), NewAllowAllMessagesFilter())),
+			msg: &MsgInstantiateContract{
+				Sender: mySenderAddr.String(),
+				CodeID: 1,
+				Label:  "foo",
+				Msg:    []byte(`{"foo":"bar"}`),
+			},
+			expResult: authztypes.AcceptResponse{
+				Accept:  true,
+				Updated: NewContractInstantiationAuthorization(mustInstantiateGrant(1, []string{"foo"}, NewMaxCallsLimit(1), NewAllowAllMessagesFilter())),
+			},
+		},
+		"instantiate accepted and removed": {
+			auth: NewContractInstantiationAuthorization(mustInstantiateGrant(1, nil, NewMaxCallsLimit(1), NewAcceptedMessageKeysFilter("foo"))),
+			msg: &MsgInstantiateContract{
+				Sender: mySenderAddr.String(),
+				CodeID: 1,
+				Label:  "any",
+				Msg:    []byte(`{"foo":"bar"}`),
+			},
+			expResult: authztypes.AcceptResponse{Accept: true, Delete: true},
+		},
+		"instantiate not accepted - other code id": {
+			auth: NewContractInstantiationAuthorization(mustInstantiateGrant(2, nil, NewMaxCallsLimit(1), NewAllowAllMessagesFilter())),
+			msg: &MsgInstantiateContract{
+				Sender: mySenderAddr.String(),
+				CodeID: 1,
+				Label:  "foo",
+				Msg:    []byte(`{"foo":"bar"}`),
+			},
+			expResult: authztypes.AcceptResponse{Accept: false},
+		},
+		"instantiate not accepted - other label": {
+			auth: NewContractInstantiationAuthorization(mustInstantiateGrant(1, []string{"bar"}, NewMaxCallsLimit(1), NewAllowAllMessagesFilter())),
+			msg: &MsgInstantiateContract{
+				Sender: mySenderAddr.String(),
+				CodeID: 1,
+				Label:  "foo",
+				Msg:    []byte(`{"foo":"bar"}`),
+			},
+			expResult: authztypes.AcceptResponse{Accept: false},
+		},
+		"instantiate accepted - granter as admin": {
+			auth: NewContractInstantiationAuthorization(mustInstantiateGrant(1, nil, NewMaxCallsLimit(1), NewAllowAllMessagesFilter())),
+			msg: &MsgInstantiateContract{
+				Sender: mySenderAddr.String(),
+				Admin:  mySenderAddr.String(),
+				CodeID: 1,
+				Label:  "foo",
+				Msg:    []byte(`{"foo":"bar"}`),
+			},
+			expResult: authztypes.AcceptResponse{Accept: true, Delete: true},
+		},
+		"instantiate not accepted - grantee as admin": {
+			auth: NewContractInstantiationAuthorization(mustInstantiateGrant(1, nil, NewMaxCallsLimit(1), NewAllowAllMessagesFilter())),
+			msg: &MsgInstantiateContract{
+				Sender: mySenderAddr.String(),
+				Admin:  myGranteeAddr.String(),
+				CodeID: 1,
+				Label:  "foo",
+				Msg:    []byte(`{"foo":"bar"}`),
+			},
+			expResult: authztypes.AcceptResponse{Accept: false},
+		},
+		"instantiate accepted - granted admin": {
+			auth: NewContractInstantiationAuthorization(mustInstantiateGrantWithAdmins(1, []string{myNewAdminAddr.String()})),
+			msg: &MsgInstantiateContract{
+				Sender: mySenderAddr.String(),
+				Admin:  myNewAdminAddr.String(),
+				CodeID: 1,
+				Label:  "foo",
+				Msg:    []byte(`{"foo":"bar"}`),
+			},
+			expResult: authztypes.AcceptResponse{Accept: true, Delete: true},
+		},
+		"instantiate2 not accepted - not a granted admin": {
+			auth: NewContractInstantiation2Authorization(mustInstantiateGrantWithAdmins(1, []string{myNewAdminAddr.String()})),
+			msg: &MsgInstantiateContract2{
+				Sender: mySenderAddr.String(),
+				Admin:  mySenderAddr.String(),
+				CodeID: 1,
+				Label:  "foo",
+				Msg:    []byte(`{"foo":"bar"}`),
+				Salt:   []byte("salt"),
+			},
+			expResult: authztypes.AcceptResponse{Accept: false},
+		},
+		"instantiate not accepted - funds exceed limit": {
+			auth: NewContractInstantiationAuthorization(mustInstantiateGrant(1, nil, NewMaxFundsLimit(sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())), NewAllowAllMessagesFilter())),
+			msg: &MsgInstantiateContract{
+				Sender: mySenderAddr.String(),
+				CodeID: 1,
+				Label:  "foo",
+				Msg:    []byte(`{"foo":"bar"}`),
+				Funds:  sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(2))),
+			},
+			expResult: authztypes.AcceptResponse{Accept: false},
+		},
+		"instantiate not accepted - filter": {
+			auth: NewContractInstantiationAuthorization(mustInstantiateGrant(1, nil, NewMaxCallsLimit(1), NewAcceptedMessageKeysFilter("bar"))),
+			msg: &MsgInstantiateContract{
+				Sender: mySenderAddr.String(),
+				CodeID: 1,
+				Label:  "foo",
+				Msg:    []byte(`{"foo":"bar"}`),
+			},
+			expResult: authztypes.AcceptResponse{Accept: false},
+		},
+		"instantiate2 accepted": {
+			auth: NewContractInstantiation2Authorization(mustInstantiateGrant(1, nil, NewMaxFundsLimit(sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())), NewAllowAllMessagesFilter())),
+			msg: &MsgInstantiateContract2{
+				Sender: mySenderAddr.String(),
+				CodeID: 1,
+				Label:  "foo",
+				Msg:    []byte(`{"foo":"bar"}`),
+				Salt:   []byte("salt"),
+			},
+			expResult: authztypes.AcceptResponse{Accept: true},
+		},
+		"instantiate2 type mismatch": {
+			auth: NewContractInstantiation2Authorization(mustInstantiateGrant(1, nil, NewMaxCallsLimit(1), NewAllowAllMessagesFilter())),
+			msg: &MsgInstantiateContract{
+				Sender: mySenderAddr.String(),
+				CodeID: 1,
+				Label:  "foo",
+				Msg:    []byte(`{"foo":"bar"}`),
+			},
+			expErr: sdkerrors.ErrInvalidType,
+		},
+		"store code accepted and removed": {
+			auth: NewStoreCodeAuthorization(mustCodeGrant(myChecksum[:], nil, NewMaxCallsLimit(1))),
+			msg: &MsgStoreCode{
+				Sender:       mySenderAddr.String(),
+				WASMByteCode: myWasmCode,
+			},
+			expResult: authztypes.AcceptResponse{Accept: true, Delete: true},
+		},
+		"store code accepted - gzipped": {
+			auth: NewStoreCodeAuthorization(mustCodeGrant(myChecksum[:], nil, NewMaxCallsLimit(2))),
+			msg: &MsgStoreCode{
+				Sender:       mySenderAddr.String(),
+				WASMByteCode: myGzippedWasmCode,
+			},
+			expResult: authztypes.AcceptResponse{
+				Accept:  true,
+				Updated: NewStoreCodeAuthorization(mustCodeGrant(myChecksum[:], nil, NewMaxCallsLimit(1))),
+			},
+		},
+		"store code accepted - permission subset": {
+			auth: NewStoreCodeAuthorization(mustCodeGrant(myChecksum[:], &AllowEverybody, NewMaxCallsLimit(1))),
+			msg: &MsgStoreCode{
+				Sender:                mySenderAddr.String(),
+				WASMByteCode:          myWasmCode,
+				InstantiatePermission: &AllowNobody,
+			},
+			expResult: authztypes.AcceptResponse{Accept: true, Delete: true},
+		},
+		"store code not accepted - other checksum": {
+			auth: NewStoreCodeAuthorization(mustCodeGrant(myChecksum[:], nil, NewMaxCallsLimit(1))),
+			msg: &MsgStoreCode{
+				Sender:       mySenderAddr.String(),
+				WASMByteCode: []byte("other wasm code"),
+			},
+			expResult: authztypes.AcceptResponse{Accept: false},
+		},
+		"store code not accepted - permission not subset": {
+			auth: NewStoreCodeAuthorization(mustCodeGrant(myChecksum[:], &AllowNobody, NewMaxCallsLimit(1))),
+			msg: &MsgStoreCode{
+				Sender:                mySenderAddr.String(),
+				WASMByteCode:          myWasmCode,
+				InstantiatePermission: &AllowEverybody,
+			},
+			expResult: authztypes.AcceptResponse{Accept: false},
+		},
+		"store code not accepted - permission not set": {
+			auth: NewStoreCodeAuthorization(mustCodeGrant(myChecksum[:], &AllowNobody, NewMaxCallsLimit(1))),
+			msg: &MsgStoreCode{
+				Sender:       mySenderAddr.String(),
+				WASMByteCode: myWasmCode,
+			},
+			expResult: authztypes.AcceptResponse{Accept: false},
+		},
+		"update admin accepted and removed": {
+			auth: NewContractUpdateAdminAuthorization(mustGrant(myContractAddr, NewMaxCallsLimit(1), NewAllowAllMessagesFilter())),
+			msg: &MsgUpdateAdmin{
+				Sender:   mySenderAddr.String(),
+				NewAdmin: myNewAdminAddr.String(),
+				Contract: myContractAddr.String(),
+			},
+			expResult: authztypes.AcceptResponse{Accept: true, Delete: true},
+		},
+		"update admin accepted - new admin filtered": {
+			auth: NewContractUpdateAdminAuthorization(mustGrant(myContractAddr, NewMaxCallsLimit(1), NewJSONPathFilter(NewJSONPathAnyOfCondition("new_admin", myNewAdminAddr.String())))),
+			msg: &MsgUpdateAdmin{
+				Sender:   mySenderAddr.String(),
+				NewAdmin: myNewAdminAddr.String(),
+				Contract: myContractAddr.String(),
+			},
+			expResult: authztypes.AcceptResponse{Accept: true, Delete: true},
+		},
+		"update admin not accepted - new admin filtered": {
+			auth: NewContractUpdateAdminAuthorization(mustGrant(myContractAddr, NewMaxCallsLimit(1), NewJSONPathFilter(NewJSONPathAnyOfCondition("new_admin", myNewAdminAddr.String())))),
+			msg: &MsgUpdateAdmin{
+				Sender:   mySenderAddr.String(),
+				NewAdmin: otherContractAddr.String(),
+				Contract: myContractAddr.String(),
+			},
+			expResult: authztypes.AcceptResponse{Accept: false},
+		},
+		"update admin not accepted - other contract": {
+			auth: NewContractUpdateAdminAuthorization(mustGrant(otherContractAddr, NewMaxCallsLimit(1), NewAllowAllMessagesFilter())),
+			msg: &MsgUpdateAdmin{
+				Sender:   mySenderAddr.String(),
+				NewAdmin: myNewAdminAddr.String(),
+				Contract: myContractAddr.String(),
+			},
+			expResult: authztypes.AcceptResponse{Accept: false},
+		},
+		"clear admin accepted and updated": {
+			auth: NewContractClearAdminAuthorization(
+				mustGrant(otherContractAddr, NewMaxCallsLimit(1), NewAllowAllMessagesFilter()),
+				mustGrant(myContractAddr, NewMaxCallsLimit(2), NewAllowAllMessagesFilter()),
+			),
+			msg: &MsgClearAdmin{
+				Sender:   mySenderAddr.String(),
+				Contract: myContractAddr.String(),
+			},
+			expResult: authztypes.AcceptResponse{
+				Accept: true,
+				Updated: NewContractClearAdminAuthorization(
+					mustGrant(otherContractAddr, NewMaxCallsLimit(1), NewAllowAllMessagesFilter()),
+					mustGrant(myContractAddr, NewMaxCallsLimit(1), NewAllowAllMessagesFilter()),
+				),
+			},
+		},
+		"clear admin type mismatch": {
+			auth: NewContractClearAdminAuthorization(mustGrant(myContractAddr, NewMaxCallsLimit(1), NewAllowAllMessagesFilter())),
+			msg: &MsgUpdateAdmin{
+				Sender:   mySenderAddr.String(),
+				NewAdmin: myNewAdminAddr.String(),
+				Contract: myContractAddr.String(),
+			},
+			expErr: sdkerrors.ErrInvalidType,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx := sdk.Context{}.WithGasMeter(sdk.NewInfiniteGasMeter())
+			gotResult, gotErr := spec.auth.Accept(ctx, spec.msg)
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expResult, gotResult)
+		})
+	}
+}
+
+func mustInstantiateGrant(codeID uint64, labels []string, limit ContractAuthzLimitX, filter ContractAuthzFilterX) InstantiateGrant {
+	g, err := NewInstantiateGrant(codeID, labels, nil, limit, filter)
+	if err != nil {
+		panic(err)
+	}
+	return *g
+}
+
+func mustInstantiateGrantWithAdmins(codeID uint64, admins []string) InstantiateGrant {
+	g, err := NewInstantiateGrant(codeID, nil, admins, NewMaxCallsLimit(1), NewAllowAllMessagesFilter())
+	if err != nil {
+		panic(err)
+	}
+	return *g
+}
+
+func mustCodeGrant(codeHash []byte, instantiatePermission *AccessConfig, limit ContractAuthzLimitX) CodeGrant {
+	g, err := NewCodeGrant(codeHash, instantiatePermission, limit)
+	if err != nil {
+		panic(err)
+	}
+	return *g
+}
+
+func TestAcceptGrantedMessageForCodeIDsOrCreator(t *testing.T) {
+	myContractAddr := sdk.AccAddress(randBytes(SDKAddrLen))
+	otherContractAddr := sdk.AccAddress(randBytes(SDKAddrLen))
+	myCreatorAddr := sdk.AccAddress(randBytes(SDKAddrLen))
+	source := contractInfoSourceFn(func(_ sdk.Context, contractAddress sdk.AccAddress) *ContractInfo {
+		switch {
+		case contractAddress.Equals(myContractAddr):
+			return &ContractInfo{CodeID: 1, Creator: myCreatorAddr.String()}
+		case contractAddress.Equals(otherContractAddr):
+			return &ContractInfo{CodeID: 2, Creator: sdk.AccAddress(randBytes(SDKAddrLen)).String()}
+		default:
+			return nil
+		}
+	})
+	myExecMsg := &MsgExecuteContract{
+		Sender:   sdk.AccAddress(randBytes(SDKAddrLen)).String(),
+		Contract: myContractAddr.String(),
+		Msg:      []byte(`{"foo":"bar"}`),
+	}
+	oneToken, twoTokens := sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt()), sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(2))
+	specs := map[string]struct {
+		auth      authztypes.Authorization
+		msg       sdk.Msg
+		noSource  bool
+		expResult authztypes.AcceptResponse
+		expErr    error
+	}{
+		"code id - first call tracks contract and total limit": {
+			auth: NewContractExecutionAuthorization(mustCodeIDsGrant([]uint64{1}, NewMaxCallsLimit(2), NewMaxCallsLimit(5), NewAllowAllMessagesFilter())),
+			msg:  myExecMsg,
+			expResult: authztypes.AcceptResponse{
+				Accept: true,
+				Updated: NewContractExecutionAuthorization(withContractLimits(
+					mustCodeIDsGrant([]uint64{1}, NewMaxCallsLimit(2), NewMaxCallsLimit(4), NewAllowAllMessagesFilter()),
+					mustContractLimit(myContractAddr, NewMaxCallsLimit(1)),
+				)),
+			},
+		},
+		"code id - lapsed contract limit removed": {
+			auth: NewContractExecutionAuthorization(withContractLimits(
+				mustCodeIDsGrant([]uint64{1}, NewMaxCallsLimit(2), NewMaxCallsLimit(5), NewAllowAllMessagesFilter()),
+				mustContractLimit(otherContractAddr, NewMaxCallsLimit(1)),
+				mustContractLimit(myContractAddr, NewMaxCallsLimit(1)),
+			)),
+			msg: myExecMsg,
+			expResult: authztypes.AcceptResponse{
+				Accept: true,
+				Updated: NewContractExecutionAuthorization(withContractLimits(
+					mustCodeIDsGrant([]uint64{1}, NewMaxCallsLimit(2), NewMaxCallsLimit(4), NewAllowAllMessagesFilter()),
+					mustContractLimit(otherContractAddr, NewMaxCallsLimit(1)),
+				)),
+			},
+		},
+		"code id - lapsed total limit removes grant": {
+			auth:      NewContractExecutionAuthorization(mustCodeIDsGrant([]uint64{1}, NewMaxCallsLimit(2), NewMaxCallsLimit(1), NewAllowAllMessagesFilter())),
+			msg:       myExecMsg,
+			expResult: authztypes.AcceptResponse{Accept: true, Delete: true},
+		},
+		"code id - not accepted by total limit": {
+			auth: NewContractExecutionAuthorization(mustCodeIDsGrant([]uint64{1}, NewMaxFundsLimit(twoTokens), NewMaxFundsLimit(oneToken), NewAllowAllMessagesFilter())),
+			msg: &MsgExecuteContract{
+				Sender:   sdk.AccAddress(randBytes(SDKAddrLen)).String(),
+				Contract: myContractAddr.String(),
+				Msg:      []byte(`{"foo":"bar"}`),
+				Funds:    sdk.NewCoins(twoTokens),
+			},
+			expResult: authztypes.AcceptResponse{Accept: false},
+		},
+		"code id - not accepted by contract limit": {
+			auth: NewContractExecutionAuthorization(mustCodeIDsGrant([]uint64{1}, NewMaxFundsLimit(oneToken), NewMaxFundsLimit(twoTokens), NewAllowAllMessagesFilter())),
+			msg: &MsgExecuteContract{
+				Sender:   sdk.AccAddress(randBytes(SDKAddrLen)).String(),
+				Contract: myContractAddr.String(),
+				Msg:      []byte(`{"foo":"bar"}`),
+				Funds:    sdk.NewCoins(twoTokens),
+			},
+			expResult: authztypes.AcceptResponse{Accept: false},
+		},
+		"code id - max contract limits": {
+			auth: NewContractExecutionAuthorization(withContractLimits(
+				mustCodeIDsGrant([]uint64{1}, NewMaxCallsLimit(2), NewMaxCallsLimit(5), NewAllowAllMessagesFilter()),
+				contractLimitsN(MaxContractLimitsPerGrant)...,
+			)),
+			msg:    myExecMsg,
+			expErr: ErrLimit,
+		},
+		"code id - other code": {
+			auth:      NewContractExecutionAuthorization(mustCodeIDsGrant([]uint64{2, 3}, NewMaxCallsLimit(1), NewMaxCallsLimit(1), NewAllowAllMessagesFilter())),
+			msg:       myExecMsg,
+			expResult: authztypes.AcceptResponse{Accept: false},
+		},
+		"code id - limits not touched": {
+			auth:      NewContractExecutionAuthorization(mustCodeIDsGrant([]uint64{1}, NewMaxFundsLimit(oneToken), NewMaxFundsLimit(oneToken), NewAllowAllMessagesFilter())),
+			msg:       myExecMsg,
+			expResult: authztypes.AcceptResponse{Accept: true},
+		},
+		"creator": {
+			auth: NewContractExecutionAuthorization(mustCreatorGrant(myCreatorAddr, NewMaxCallsLimit(1), NewMaxCallsLimit(2), NewAllowAllMessagesFilter())),
+			msg:  myExecMsg,
+			expResult: authztypes.AcceptResponse{
+				Accept:  true,
+				Updated: NewContractExecutionAuthorization(mustCreatorGrant(myCreatorAddr, NewMaxCallsLimit(1), NewMaxCallsLimit(1), NewAllowAllMessagesFilter())),
+			},
+		},
+		"other creator": {
+			auth: NewContractExecutionAuthorization(mustCreatorGrant(myCreatorAddr, NewMaxCallsLimit(1), NewMaxCallsLimit(1), NewAllowAllMessagesFilter())),
+			msg: &MsgExecuteContract{
+				Sender:   sdk.AccAddress(randBytes(SDKAddrLen)).String(),
+				Contract: otherContractAddr.String(),
+				Msg:      []byte(`{"foo":"bar"}`),
+			},
+			expResult: authztypes.AcceptResponse{Accept: false},
+		},
+		"unknown contract": {
+			auth: NewContractExecutionAuthorization(mustCodeIDsGrant([]uint64{1}, NewMaxCallsLimit(1), NewMaxCallsLimit(1), NewAllowAllMessagesFilter())),
+			msg: &MsgExecuteContract{
+				Sender:   sdk.AccAddress(randBytes(SDKAddrLen)).String(),
+				Contract: sdk.AccAddress(randBytes(SDKAddrLen)).String(),
+				Msg:      []byte(`{"foo":"bar"}`),
+			},
+			expResult: authztypes.AcceptResponse{Accept: false},
+		},
+		"no contract info source": {
+			auth:     NewContractExecutionAuthorization(mustCodeIDsGrant([]uint64{1}, NewMaxCallsLimit(1), NewMaxCallsLimit(1), NewAllowAllMessagesFilter())),
+			msg:      myExecMsg,
+			noSource: true,
+			expErr:   sdkerrors.ErrNotSupported,
+		},
+		"no contract info source - contract grant accepted": {
+			auth: NewContractExecutionAuthorization(
+				mustCodeIDsGrant([]uint64{1}, NewMaxCallsLimit(1), NewMaxCallsLimit(1), NewAllowAllMessagesFilter()),
+				mustGrant(myContractAddr, NewMaxCallsLimit(2), NewAllowAllMessagesFilter()),
+			),
+			msg:      myExecMsg,
+			noSource: true,
+			expResult: authztypes.AcceptResponse{
+				Accept: true,
+				Updated: NewContractExecutionAuthorization(
+					mustCodeIDsGrant([]uint64{1}, NewMaxCallsLimit(1), NewMaxCallsLimit(1), NewAllowAllMessagesFilter()),
+					mustGrant(myContractAddr, NewMaxCallsLimit(1), NewAllowAllMessagesFilter()),
+				),
+			},
+		},
+		"single contract grant preferred in order": {
+			auth: NewContractExecutionAuthorization(
+				mustGrant(myContractAddr, NewMaxCallsLimit(2), NewAllowAllMessagesFilter()),
+				mustCodeIDsGrant([]uint64{1}, NewMaxCallsLimit(1), NewMaxCallsLimit(1), NewAllowAllMessagesFilter()),
+			),
+			msg: myExecMsg,
+			expResult: authztypes.AcceptResponse{
+				Accept: true,
+				Updated: NewContractExecutionAuthorization(
+					mustGrant(myContractAddr, NewMaxCallsLimit(1), NewAllowAllMessagesFilter()),
+					mustCodeIDsGrant([]uint64{1}, NewMaxCallsLimit(1), NewMaxCallsLimit(1), NewAllowAllMessagesFilter()),
+				),
+			},
+		},
+		"clear admin by code id": {
+			auth: NewContractClearAdminAuthorization(mustCodeIDsGrant([]uint64{1}, NewMaxCallsLimit(1), NewMaxCallsLimit(2), NewAllowAllMessagesFilter())),
+			msg: &MsgClearAdmin{
+				Sender:   sdk.AccAddress(randBytes(SDKAddrLen)).String(),
+				Contract: myContractAddr.String(),
+			},
+			expResult: authztypes.AcceptResponse{
+				Accept:  true,
+				Updated: NewContractClearAdminAuthorization(mustCodeIDsGrant([]uint64{1}, NewMaxCallsLimit(1), NewMaxCallsLimit(1), NewAllowAllMessagesFilter())),
+			},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx := sdk.Context{}.WithContext(context.Background()).WithGasMeter(sdk.NewInfiniteGasMeter())
+			if !spec.noSource {
+				ctx = WithContractInfoSource(ctx, source)
+			}
+			gotResult, gotErr := spec.auth.Accept(ctx, spec.msg)
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expResult, gotResult)
+		})
+	}
+}
+
+type contractInfoSourceFn func(ctx sdk.Context, contractAddress sdk.AccAddress) *ContractInfo
+
+func (f contractInfoSourceFn) GetContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) *ContractInfo {
+	return f(ctx, contractAddress)
+}
+
+func mustCodeIDsGrant(codeIDs []uint64, limit, totalLimit ContractAuthzLimitX, filter ContractAuthzFilterX) ContractGrant {
+	g, err := NewContractGrantForCodeIDs(codeIDs, limit, totalLimit, filter)
+	if err != nil {
+		panic(err)
+	}
+	return *g
+}
+
+func mustCreatorGrant(creator sdk.AccAddress, limit, totalLimit ContractAuthzLimitX, filter ContractAuthzFilterX) ContractGrant {
+	g, err := NewContractGrantForCreator(creator, limit, totalLimit, filter)
+	if err != nil {
+		panic(err)
+	}
+	return *g
+}
+
+func mustContractLimit(contract sdk.AccAddress, limit ContractAuthzLimitX) ContractLimit {
+	anyLimit, err := newAnyLimit(limit)
+	if err != nil {
+		panic(err)
+	}
+	return ContractLimit{Contract: contract.String(), Limit: anyLimit}
+}
+
+func withContractLimits(g ContractGrant, limits ...ContractLimit) ContractGrant {
+	g.ContractLimits = limits
+	return g
+}
+
+func contractLimitsN(n int) []ContractLimit {
+	limits := make([]ContractLimit, n)
+	for i := range limits {
+		limits[i] = mustContractLimit(randBytes(ContractAddrLen), NewMaxCallsLimit(1))
+	}
+	return limits
+}
+
+func TestAcceptGrantedMessageForCodeIDsTotalLimit(t *testing.T) {
+	myCreatorAddr := sdk.AccAddress(randBytes(SDKAddrLen))
+	myPoolAddr, myNewPoolAddr := sdk.AccAddress(randBytes(SDKAddrLen)), sdk.AccAddress(randBytes(SDKAddrLen))
+	source := contractInfoSourceFn(func(_ sdk.Context, _ sdk.AccAddress) *ContractInfo {
+		return &ContractInfo{CodeID: 1, Creator: myCreatorAddr.String()}
+	})
+	ctx := WithContractInfoSource(sdk.Context{}.WithContext(context.Background()).WithGasMeter(sdk.NewInfiniteGasMeter()), source)
+	execWithTokens := func(contract sdk.AccAddress, amount int64) *MsgExecuteContract {
+		return &MsgExecuteContract{
+			Sender:   sdk.AccAddress(randBytes(SDKAddrLen)).String(),
+			Contract: contract.String(),
+			Msg:      []byte(`{"swap":{}}`),
+			Funds:    sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(amount))),
+		}
+	}
+	var auth authztypes.Authorization = NewContractExecutionAuthorization(mustCodeIDsGrant(
+		[]uint64{1},
+		NewMaxFundsLimit(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(2))),
+		NewMaxFundsLimit(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(3))),
+		NewAllowAllMessagesFilter(),
+	))
+	// when the contract limit of the pool is used
+	gotResult, err := auth.Accept(ctx, execWithTokens(myPoolAddr, 2))
+	require.NoError(t, err)
+	require.True(t, gotResult.Accept)
+	auth = gotResult.Updated
+	// then a new pool instantiated from the same code does not reset the funds cap
+	gotResult, err = auth.Accept(ctx, execWithTokens(myNewPoolAddr, 2))
+	require.NoError(t, err)
+	assert.False(t, gotResult.Accept)
+	// and the funds left in the total limit can be spent
+	gotResult, err = auth.Accept(ctx, execWithTokens(myNewPoolAddr, 1))
+	require.NoError(t, err)
+	assert.Equal(t, authztypes.AcceptResponse{Accept: true, Delete: true}, gotResult)
+}
+
+func TestAcceptGrantedMessageRemovesLapsedGrants(t *testing.T) {
+	myContractAddr := sdk.AccAddress(randBytes(SDKAddrLen))
+	otherContractAddr := sdk.AccAddress(randBytes(SDKAddrLen))
+	now := time.Unix(1_000_000, 0).UTC()
+	passedWindows := NewBlockTimeWindowsLimit(TimeWindow{Start: now.Add(-2 * time.Hour), End: now.Add(-time.Hour)}, TimeWindow{Start: now.Add(-time.Minute), End: now})
+	currentWindow := NewBlockTimeWindowsLimit(TimeWindow{Start: now.Add(-time.Minute), End: now.Add(time.Minute)})
+	mustGrant := func(contract sdk.AccAddress, limit ContractAuthzLimitX) ContractGrant {
+		g, err := NewContractGrant(contract, limit, NewAllowAllMessagesFilter())
+		require.NoError(t, err)
+		return *g
+	}
+	myMsg := &MsgExecuteContract{
+		Sender:   sdk.AccAddress(randBytes(SDKAddrLen)).String(),
+		Contract: myContractAddr.String(),
+		Msg:      []byte(`{"foo":"bar"}`),
+	}
+	specs := map[string]struct {
+		auth      authztypes.Authorization
+		expResult authztypes.AcceptResponse
+	}{
+		"lapsed grant removed with limit update": {
+			auth: NewContractExecutionAuthorization(
+				mustGrant(otherContractAddr, passedWindows),
+				mustGrant(myContractAddr, NewMaxCallsLimit(2)),
+			),
+			expResult: authztypes.AcceptResponse{
+				Accept:  true,
+				Updated: NewContractExecutionAuthorization(mustGrant(myContractAddr, NewMaxCallsLimit(1))),
+			},
+		},
+		"lapsed grant removed without limit update": {
+			auth: NewContractExecutionAuthorization(
+				mustGrant(myContractAddr, passedWindows),
+				mustGrant(myContractAddr, currentWindow),
+			),
+			expResult: authztypes.AcceptResponse{
+				Accept:  true,
+				Updated: NewContractExecutionAuthorization(mustGrant(myContractAddr, currentWindow)),
+			},
+		},
+		"authorization removed when all grants lapsed": {
+			auth: NewContractExecutionAuthorization(
+				mustGrant(otherContractAddr, passedWindows),
+				mustGrant(myContractAddr, NewMaxCallsLimit(1)),
+			),
+			expResult: authztypes.AcceptResponse{Accept: true, Delete: true},
+		},
+		"not lapsed grants kept": {
+			auth: NewContractExecutionAuthorization(
+				mustGrant(otherContractAddr, currentWindow),
+				mustGrant(myContractAddr, currentWindow),
+			),
+			expResult: authztypes.AcceptResponse{Accept: true},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx := sdk.Context{}.WithGasMeter(sdk.NewInfiniteGasMeter()).WithBlockTime(now)
+			gotResult, gotErr := spec.auth.Accept(ctx, myMsg)
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expResult, gotResult)
+		})
+	}
+}
+
+func TestContractAuthzLimitRemainingUsage(t *testing.T) {
+	now := time.Now().UTC()
+	oneToken, twoToken := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1)), sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(2))
+	specs := map[string]struct {
+		limit ContractAuthzLimitRemaining
+		exp   ContractAuthzRemaining
+	}{
+		"max calls": {
+			limit: NewMaxCallsLimit(2),
+			exp:   ContractAuthzRemaining{CallsLimited: true, Calls: 2, FundsLimited: true, Funds: sdk.NewCoins()},
+		},
+		"max funds": {
+			limit: NewMaxFundsLimit(oneToken),
+			exp:   ContractAuthzRemaining{FundsLimited: true, Funds: sdk.NewCoins(oneToken)},
+		},
+		"combined": {
+			limit: NewCombinedLimit(1, oneToken),
+			exp:   ContractAuthzRemaining{CallsLimited: true, Calls: 1, FundsLimited: true, Funds: sdk.NewCoins(oneToken)},
+		},
+		"rolling window - in window": {
+			limit: &RollingWindowLimit{Window: time.Hour, MaxCalls: 3, MaxAmounts: sdk.NewCoins(twoToken), Usage: []WindowUsage{
+				{Start: now.Add(-time.Hour), Calls: 1},
+				{Start: now.Add(-time.Minute), Calls: 1, Amounts: sdk.NewCoins(oneToken)},
+			}},
+			exp: ContractAuthzRemaining{CallsLimited: true, Calls: 1, FundsLimited: true, Funds: sdk.NewCoins(oneToken)},
+		},
+		"rolling window - sub-windows elapsed": {
+			limit: &RollingWindowLimit{Window: time.Hour, MaxCalls: 3, MaxAmounts: sdk.NewCoins(twoToken), Usage: []WindowUsage{
+				{Start: now.Add(-2 * time.Hour), Calls: 3, Amounts: sdk.NewCoins(twoToken)},
+			}},
+			exp: ContractAuthzRemaining{CallsLimited: true, Calls: 3, FundsLimited: true, Funds: sdk.NewCoins(twoToken)},
+		},
+		"rolling window - unlimited calls": {
+			limit: NewRollingWindowLimit(time.Hour, 0, twoToken),
+			exp:   ContractAuthzRemaining{FundsLimited: true, Funds: sdk.NewCoins(twoToken)},
+		},
+		"cooldown": {
+			limit: NewCooldownLimit(time.Minute),
+			exp:   ContractAuthzRemaining{FundsLimited: true, Funds: sdk.NewCoins()},
+		},
+		"block time windows - future window": {
+			limit: &BlockTimeWindowsLimit{Windows: []TimeWindow{{Start: now.Add(time.Hour), End: now.Add(2 * time.Hour)}}},
+			exp:   ContractAuthzRemaining{FundsLimited: true, Funds: sdk.NewCoins()},
+		},
+		"block time windows - all passed": {
+			limit: &BlockTimeWindowsLimit{Windows: []TimeWindow{{Start: now.Add(-2 * time.Hour), End: now}}},
+			exp:   ContractAuthzRemaining{CallsLimited: true, FundsLimited: true, Funds: sdk.NewCoins()},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, spec.exp, spec.limit.RemainingUsage(now))
+		})
+	}
+}
+
+func TestAcceptGrantedExecuteContractsMessage(t *testing.T) {
+	myContractAddr := sdk.AccAddress(randBytes(SDKAddrLen))
+	otherContractAddr := sdk.AccAddress(randBytes(SDKAddrLen))
+	mySender := sdk.AccAddress(randBytes(SDKAddrLen)).String()
+	execs := func(e ...ContractExecution) *MsgExecuteContracts {
+		return &MsgExecuteContracts{Sender: mySender, Executions: e}
+	}
+	myExecution := ContractExecution{Contract: myContractAddr.String(), Msg: []byte(`{"foo":"bar"}`)}
+	specs := map[string]struct {
+		auth      authztypes.Authorization
+		msg       sdk.Msg
+		expResult authztypes.AcceptResponse
+		expErr    *errorsmod.Error
+	}{
+		"all accepted - limit applied per execution": {
+			auth: NewExecuteContractsAuthorization(mustGrant(myContractAddr, NewMaxCallsLimit(3), NewAllowAllMessagesFilter())),
+			msg:  execs(myExecution, myExecution),
+			expResult: authztypes.AcceptResponse{
+				Accept:  true,
+				Updated: NewExecuteContractsAuthorization(mustGrant(myContractAddr, NewMaxCallsLimit(1), NewAllowAllMessagesFilter())),
+			},
+		},
+		"all accepted - grant consumed": {
+			auth:      NewExecuteContractsAuthorization(mustGrant(myContractAddr, NewMaxCallsLimit(2), NewAllowAllMessagesFilter())),
+			msg:       execs(myExecution, myExecution),
+			expResult: authztypes.AcceptResponse{Accept: true, Delete: true},
+		},
+		"all accepted - other grants kept": {
+			auth: NewExecuteContractsAuthorization(
+				mustGrant(myContractAddr, NewMaxCallsLimit(1), NewAllowAllMessagesFilter()),
+				mustGrant(otherContractAddr, NewMaxCallsLimit(2), NewAllowAllMessagesFilter()),
+			),
+			msg: execs(myExecution, ContractExecution{Contract: otherContractAddr.String(), Msg: []byte(`{}`)}),
+			expResult: authztypes.AcceptResponse{
+				Accept:  true,
+				Updated: NewExecuteContractsAuthorization(mustGrant(otherContractAddr, NewMaxCallsLimit(1), NewAllowAllMessagesFilter())),
+			},
+		},
+		"calls exceed limit": {
+			auth:      NewExecuteContractsAuthorization(mustGrant(myContractAddr, NewMaxCallsLimit(1), NewAllowAllMessagesFilter())),
+			msg:       execs(myExecution, myExecution),
+			expResult: authztypes.AcceptResponse{Accept: false},
+		},
+		"funds exceed limit in sum": {
+			auth: NewExecuteContractsAuthorization(mustGrant(myContractAddr, NewMaxFundsLimit(sdk.NewInt64Coin("foo", 100)), NewAllowAllMessagesFilter())),
+			msg: execs(
+				ContractExecution{Contract: myContractAddr.String(), Msg: []byte(`{}`), Funds: sdk.NewCoins(sdk.NewInt64Coin("foo", 60))},
+				ContractExecution{Contract: myContractAddr.String(), Msg: []byte(`{}`), Funds: sdk.NewCoins(sdk.NewInt64Coin("foo", 60))},
+			),
+			expResult: authztypes.AcceptResponse{Accept: false},
+		},
+		"contract not granted": {
+			auth:      NewExecuteContractsAuthorization(mustGrant(myContractAddr, NewMaxCallsLimit(3), NewAllowAllMessagesFilter())),
+			msg:       execs(myExecution, ContractExecution{Contract: otherContractAddr.String(), Msg: []byte(`{}`)}),
+			expResult: authztypes.AcceptResponse{Accept: false},
+		},
+		"filter rejects msg": {
+			auth:      NewExecuteContractsAuthorization(mustGrant(myContractAddr, NewMaxCallsLimit(3), NewAcceptedMessageKeysFilter("foo"))),
+			msg:       execs(myExecution, ContractExecution{Contract: myContractAddr.String(), Msg: []byte(`{"bar":"{{responses.0}}"}`)}),
+			expResult: authztypes.AcceptResponse{Accept: false},
+		},
+		"templated msg - allow all filter": {
+			auth:      NewExecuteContractsAuthorization(mustGrant(myContractAddr, NewMaxCallsLimit(2), NewAllowAllMessagesFilter())),
+			msg:       execs(myExecution, ContractExecution{Contract: myContractAddr.String(), Msg: []byte(`{"foo":"{{responses.0.bar}}"}`)}),
+			expResult: authztypes.AcceptResponse{Accept: true, Delete: true},
+		},
+		"templated msg - filtered grant not applied": {
+			auth:      NewExecuteContractsAuthorization(mustGrant(myContractAddr, NewMaxCallsLimit(3), NewAcceptedMessageKeysFilter("foo"))),
+			msg:       execs(myExecution, ContractExecution{Contract: myContractAddr.String(), Msg: []byte(`{"foo":"{{responses.0}}"}`)}),
+			expResult: authztypes.AcceptResponse{Accept: false},
+		},
+		"templated msg - allow all grant applied": {
+			auth: NewExecuteContractsAuthorization(
+				mustGrant(myContractAddr, NewMaxCallsLimit(3), NewAcceptedMessageKeysFilter("foo")),
+				mustGrant(myContractAddr, NewMaxCallsLimit(3), NewAllowAllMessagesFilter()),
+			),
+			msg: execs(myExecution, ContractExecution{Contract: myContractAddr.String(), Msg: []byte(`{"foo":"{{responses.0}}"}`)}),
+			expResult: authztypes.AcceptResponse{
+				Accept: true,
+				Updated: NewExecuteContractsAuthorization(
+					mustGrant(myContractAddr, NewMaxCallsLimit(2), NewAcceptedMessageKeysFilter("foo")),
+					mustGrant(myContractAddr, NewMaxCallsLimit(2), NewAllowAllMessagesFilter()),
+				),
+			},
+		},
+		"invalid msg": {
+			auth:   NewExecuteContractsAuthorization(mustGrant(myContractAddr, NewMaxCallsLimit(3), NewAllowAllMessagesFilter())),
+			msg:    execs(),
+			expErr: ErrEmpty,
+		},
+		"wrong msg type": {
+			auth:   NewExecuteContractsAuthorization(mustGrant(myContractAddr, NewMaxCallsLimit(3), NewAllowAllMessagesFilter())),
+			msg:    &MsgExecuteContract{Sender: mySender, Contract: myContractAddr.String(), Msg: []byte(`{}`)},
+			expErr: sdkerrors.ErrInvalidType,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx := sdk.Context{}.WithGasMeter(sdk.NewInfiniteGasMeter())
+			gotResult, gotErr := spec.auth.Accept(ctx, spec.msg)
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expResult, gotResult)
+		})
+	}
+}
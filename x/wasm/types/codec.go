@@ -28,6 +28,15 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgPinCodes{}, "wasm/MsgPinCodes", nil)
 	cdc.RegisterConcrete(&MsgUnpinCodes{}, "wasm/MsgUnpinCodes", nil)
 	cdc.RegisterConcrete(&MsgStoreAndInstantiateContract{}, "wasm/MsgStoreAndInstantiateContract", nil)
+	cdc.RegisterConcrete(&MsgSetStargateQueryAllowlist{}, "wasm/MsgSetStargateQueryAllowlist", nil)
+	cdc.RegisterConcrete(&MsgRemoveStargateQueryAllowlist{}, "wasm/MsgRemoveStargateQueryAllowlist", nil)
+	cdc.RegisterConcrete(&MsgExecuteContracts{}, "wasm/MsgExecuteContracts", nil)
+	cdc.RegisterConcrete(&MsgUpdateContractMetadata{}, "wasm/MsgUpdateContractMetadata", nil)
+	cdc.RegisterConcrete(&MsgSetContractSchema{}, "wasm/MsgSetContractSchema", nil)
+	cdc.RegisterConcrete(&MsgMigrateContractsByCode{}, "wasm/MsgMigrateContractsByCode", nil)
+	cdc.RegisterConcrete(&MsgProposeAdmin{}, "wasm/MsgProposeAdmin", nil)
+	cdc.RegisterConcrete(&MsgAcceptAdmin{}, "wasm/MsgAcceptAdmin", nil)
+	cdc.RegisterConcrete(&MsgCancelAdminTransfer{}, "wasm/MsgCancelAdminTransfer", nil)
 
 	cdc.RegisterConcrete(&PinCodesProposal{}, "wasm/PinCodesProposal", nil)
 	cdc.RegisterConcrete(&UnpinCodesProposal{}, "wasm/UnpinCodesProposal", nil)
@@ -48,14 +57,24 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&AllowAllMessagesFilter{}, "wasm/AllowAllMessagesFilter", nil)
 	cdc.RegisterConcrete(&AcceptedMessageKeysFilter{}, "wasm/AcceptedMessageKeysFilter", nil)
 	cdc.RegisterConcrete(&AcceptedMessagesFilter{}, "wasm/AcceptedMessagesFilter", nil)
+	cdc.RegisterConcrete(&JSONPathFilter{}, "wasm/JSONPathFilter", nil)
 
 	cdc.RegisterInterface((*ContractAuthzLimitX)(nil), nil)
 	cdc.RegisterConcrete(&MaxCallsLimit{}, "wasm/MaxCallsLimit", nil)
 	cdc.RegisterConcrete(&MaxFundsLimit{}, "wasm/MaxFundsLimit", nil)
 	cdc.RegisterConcrete(&CombinedLimit{}, "wasm/CombinedLimit", nil)
+	cdc.RegisterConcrete(&RollingWindowLimit{}, "wasm/RollingWindowLimit", nil)
+	cdc.RegisterConcrete(&CooldownLimit{}, "wasm/CooldownLimit", nil)
+	cdc.RegisterConcrete(&BlockTimeWindowsLimit{}, "wasm/BlockTimeWindowsLimit", nil)
 
 	cdc.RegisterConcrete(&ContractExecutionAuthorization{}, "wasm/ContractExecutionAuthorization", nil)
 	cdc.RegisterConcrete(&ContractMigrationAuthorization{}, "wasm/ContractMigrationAuthorization", nil)
+	cdc.RegisterConcrete(&ExecuteContractsAuthorization{}, "wasm/ExecuteContractsAuthorization", nil)
+	cdc.RegisterConcrete(&ContractInstantiationAuthorization{}, "wasm/ContractInstantiationAuthorization", nil)
+	cdc.RegisterConcrete(&ContractInstantiation2Authorization{}, "wasm/ContractInstantiation2Authorization", nil)
+	cdc.RegisterConcrete(&StoreCodeAuthorization{}, "wasm/StoreCodeAuthorization", nil)
+	cdc.RegisterConcrete(&ContractUpdateAdminAuthorization{}, "wasm/ContractUpdateAdminAuthorization", nil)
+	cdc.RegisterConcrete(&ContractClearAdminAuthorization{}, "wasm/ContractClearAdminAuthorization", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -76,6 +95,15 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgPinCodes{},
 		&MsgUnpinCodes{},
 		&MsgStoreAndInstantiateContract{},
+		&MsgSetStargateQueryAllowlist{},
+		&MsgRemoveStargateQueryAllowlist{},
+		&MsgExecuteContracts{},
+		&MsgUpdateContractMetadata{},
+		&MsgSetContractSchema{},
+		&MsgMigrateContractsByCode{},
+		&MsgProposeAdmin{},
+		&MsgAcceptAdmin{},
+		&MsgCancelAdminTransfer{},
 	)
 	registry.RegisterImplementations(
 		(*v1beta1.Content)(nil),
@@ -101,6 +129,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&AllowAllMessagesFilter{},
 		&AcceptedMessageKeysFilter{},
 		&AcceptedMessagesFilter{},
+		&JSONPathFilter{},
 	)
 
 	registry.RegisterInterface("cosmwasm.wasm.v1.ContractAuthzLimitX", (*ContractAuthzLimitX)(nil))
@@ -109,12 +138,21 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MaxCallsLimit{},
 		&MaxFundsLimit{},
 		&CombinedLimit{},
+		&RollingWindowLimit{},
+		&CooldownLimit{},
+		&BlockTimeWindowsLimit{},
 	)
 
 	registry.RegisterImplementations(
 		(*authz.Authorization)(nil),
 		&ContractExecutionAuthorization{},
 		&ContractMigrationAuthorization{},
+		&ExecuteContractsAuthorization{},
+		&ContractInstantiationAuthorization{},
+		&ContractInstantiation2Authorization{},
+		&StoreCodeAuthorization{},
+		&ContractUpdateAdminAuthorization{},
+		&ContractClearAdminAuthorization{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
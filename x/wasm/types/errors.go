@@ -85,6 +85,9 @@ var (
 	ErrNoSuchCodeFn = WasmVMFlavouredErrorFactory(errorsmod.Register(DefaultCodespace, 28, "no such code"),
 		func(id uint64) error { return wasmvmtypes.NoSuchCode{CodeID: id} },
 	)
+
+	// ErrSchemaValidation error for a contract message that does not conform to the registered schema
+	ErrSchemaValidation = errorsmod.Register(DefaultCodespace, 29, "contract message does not match schema")
 )
 
 // WasmVMErrorable mapped error type in wasmvm and are not redacted
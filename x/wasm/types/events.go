@@ -21,10 +21,19 @@ const (
 	EventTypeUnpinCode              = "unpin_code"
 	EventTypeSudo                   = "sudo"
 	EventTypeReply                  = "reply"
+	EventTypeSubMsg                 = "submsg"
 	EventTypeGovContractResult      = "gov_contract_result"
 	EventTypeUpdateContractAdmin    = "update_contract_admin"
 	EventTypeUpdateCodeAccessConfig = "update_code_access_config"
 	EventTypePacketRecv             = "ibc_packet_received"
+	EventTypeUpdateContractMetadata = "update_contract_metadata"
+	EventTypeSetContractSchema      = "set_contract_schema"
+	EventTypeMigrateContractsByCode = "migrate_contracts_by_code"
+	EventTypeContractMigration      = "contract_migration"
+	EventTypeContractsMigrationDone = "contracts_migration_done"
+	EventTypeProposeContractAdmin   = "propose_contract_admin"
+	EventTypeCancelAdminTransfer    = "cancel_contract_admin_transfer"
+	EventTypeExpireAdminTransfer    = "expire_contract_admin_transfer"
 	// add new types to IsAcceptedEventOnRecvPacketErrorAck
 )
 
@@ -64,4 +73,11 @@ const (
 	AttributeKeyAuthorizedAddresses = "authorized_addresses"
 	AttributeKeyAckSuccess          = "success"
 	AttributeKeyAckError            = "error"
+	AttributeKeySubMsgID            = "submsg_id"
+	AttributeKeySubMsgGasUsed       = "submsg_gas_used"
+	AttributeKeyMigrationID         = "migration_id"
+	AttributeKeyNewCodeID           = "new_code_id"
+	AttributeKeySucceeded           = "succeeded"
+	AttributeKeyFailed              = "failed"
+	AttributeKeyExpiryHeight        = "expiry_height"
 )
@@ -2,14 +2,20 @@ package types
 
 import (
 	"context"
+	"time"
 
 	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	"github.com/cosmos/cosmos-sdk/x/nft"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ibcfeetypes "github.com/cosmos/ibc-go/v7/modules/apps/29-fee/types"
 	connectiontypes "github.com/cosmos/ibc-go/v7/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
 	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
@@ -74,8 +80,13 @@ type StakingKeeper interface {
 type ChannelKeeper interface {
 	GetChannel(ctx sdk.Context, srcPort, srcChan string) (channel channeltypes.Channel, found bool)
 	GetNextSequenceSend(ctx sdk.Context, portID, channelID string) (uint64, bool)
+	GetNextSequenceRecv(ctx sdk.Context, portID, channelID string) (uint64, bool)
+	GetNextSequenceAck(ctx sdk.Context, portID, channelID string) (uint64, bool)
+	PacketCommitments(c context.Context, req *channeltypes.QueryPacketCommitmentsRequest) (*channeltypes.QueryPacketCommitmentsResponse, error)
+	PacketAcknowledgements(c context.Context, req *channeltypes.QueryPacketAcknowledgementsRequest) (*channeltypes.QueryPacketAcknowledgementsResponse, error)
 	ChanCloseInit(ctx sdk.Context, portID, channelID string, chanCap *capabilitytypes.Capability) error
 	GetAllChannels(ctx sdk.Context) (channels []channeltypes.IdentifiedChannel)
+	GetAllChannelsWithPortPrefix(ctx sdk.Context, portPrefix string) []channeltypes.IdentifiedChannel
 	IterateChannels(ctx sdk.Context, cb func(channeltypes.IdentifiedChannel) bool)
 	SetChannel(ctx sdk.Context, portID, channelID string, channel channeltypes.Channel)
 }
@@ -127,3 +138,33 @@ type CapabilityKeeper interface {
 type ICS20TransferPortSource interface {
 	GetPort(ctx sdk.Context) string
 }
+
+// ICS29FeeKeeper defines the expected ics-29 fee middleware keeper to escrow relayer incentives
+// for packets sent by contracts
+type ICS29FeeKeeper interface {
+	PayPacketFee(goCtx context.Context, msg *ibcfeetypes.MsgPayPacketFee) (*ibcfeetypes.MsgPayPacketFeeResponse, error)
+}
+
+// AuthzKeeper defines a subset of methods implemented by the cosmos-sdk authz keeper
+type AuthzKeeper interface {
+	IterateGrants(ctx sdk.Context, handler func(granterAddr sdk.AccAddress, granteeAddr sdk.AccAddress, grant authz.Grant) bool)
+	GetAuthorization(ctx sdk.Context, grantee sdk.AccAddress, granter sdk.AccAddress, msgType string) (authz.Authorization, *time.Time)
+}
+
+// FeegrantKeeper defines a subset of methods implemented by the cosmos-sdk feegrant keeper
+type FeegrantKeeper interface {
+	GetAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress) (feegrant.FeeAllowanceI, error)
+}
+
+// NFTKeeper defines a subset of methods implemented by the cosmos-sdk nft keeper
+type NFTKeeper interface {
+	GetOwner(ctx sdk.Context, classID string, nftID string) sdk.AccAddress
+	GetBalance(ctx sdk.Context, classID string, owner sdk.AccAddress) uint64
+	GetNFT(ctx sdk.Context, classID, nftID string) (nft.NFT, bool)
+}
+
+// GroupKeeper defines a subset of methods implemented by the cosmos-sdk group keeper
+type GroupKeeper interface {
+	Proposal(goCtx context.Context, request *group.QueryProposalRequest) (*group.QueryProposalResponse, error)
+	VoteByProposalVoter(goCtx context.Context, request *group.QueryVoteByProposalVoterRequest) (*group.QueryVoteByProposalVoterResponse, error)
+}
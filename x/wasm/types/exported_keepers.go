@@ -3,6 +3,7 @@ package types
 import (
 	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
 )
@@ -16,6 +17,8 @@ type ViewKeeper interface {
 	GetContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) *ContractInfo
 	IterateContractInfo(ctx sdk.Context, cb func(sdk.AccAddress, ContractInfo) bool)
 	IterateContractsByCreator(ctx sdk.Context, creator sdk.AccAddress, cb func(address sdk.AccAddress) bool)
+	IterateContractsByAdmin(ctx sdk.Context, admin sdk.AccAddress, cb func(address sdk.AccAddress) bool)
+	IterateContractsByLabelPrefix(ctx sdk.Context, labelPrefix string, cb func(address sdk.AccAddress) bool)
 	IterateContractsByCode(ctx sdk.Context, codeID uint64, cb func(address sdk.AccAddress) bool)
 	IterateContractState(ctx sdk.Context, contractAddress sdk.AccAddress, cb func(key, value []byte) bool)
 	GetCodeInfo(ctx sdk.Context, codeID uint64) *CodeInfo
@@ -23,6 +26,16 @@ type ViewKeeper interface {
 	GetByteCode(ctx sdk.Context, codeID uint64) ([]byte, error)
 	IsPinnedCode(ctx sdk.Context, codeID uint64) bool
 	GetParams(ctx sdk.Context) Params
+	GetIBCChannelsByPort(ctx sdk.Context, portID, channelID string, commitmentsPagination, acknowledgementsPagination *query.PageRequest) ([]ContractIBCChannel, error)
+	GetCodeStargateQueryAllowlist(ctx sdk.Context, codeID uint64) []string
+	GetContractStargateQueryAllowlist(ctx sdk.Context, contractAddr sdk.AccAddress) []string
+	GetContractMetadata(ctx sdk.Context, contractAddress sdk.AccAddress) *ContractMetadata
+	IterateContractsByMetadataTag(ctx sdk.Context, key, value string, cb func(address sdk.AccAddress) bool)
+	GetContractSchema(ctx sdk.Context, codeID uint64) *ContractSchema
+	GetContractAuthzGrants(ctx sdk.Context, contractAddr sdk.AccAddress, pagination *query.PageRequest) ([]ContractAuthzGrant, *query.PageResponse, error)
+	PeekAutoIncrementID(ctx sdk.Context, lastIDKey []byte) uint64
+	GetContractsMigration(ctx sdk.Context, id uint64) *ContractsMigration
+	GetPendingAdminTransfer(ctx sdk.Context, contractAddress sdk.AccAddress) *PendingAdminTransfer
 }
 
 // ContractOpsKeeper contains mutable operations on a contract.
@@ -67,6 +80,13 @@ type ContractOpsKeeper interface {
 	// ClearContractAdmin sets the admin value on the ContractInfo to nil, to disable further migrations/ updates.
 	ClearContractAdmin(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress) error
 
+	// UpdateContractMetadata replaces the descriptive metadata of a contract. An empty metadata removes the record.
+	UpdateContractMetadata(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, metadata ContractMetadata) error
+
+	// SetContractSchema sets the JSON schema of the messages of a code. The schema can be set once and only before
+	// the first contract is instantiated from the code.
+	SetContractSchema(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, schema ContractSchema) error
+
 	// PinCode pins the wasm contract in wasmvm cache
 	PinCode(ctx sdk.Context, codeID uint64) error
 
@@ -78,6 +98,10 @@ type ContractOpsKeeper interface {
 
 	// SetAccessConfig updates the access config of a code id.
 	SetAccessConfig(ctx sdk.Context, codeID uint64, caller sdk.AccAddress, newConfig AccessConfig) error
+
+	// SetCodeVerificationInfo stores the source and builder of a code id when the code hash matches the checksum.
+	// The verification info can be set only once.
+	SetCodeVerificationInfo(ctx sdk.Context, codeID uint64, source, builder string, codeHash []byte) error
 }
 
 // IBCContractKeeper IBC lifecycle event handler
@@ -118,3 +142,11 @@ type IBCContractKeeper interface {
 	// AuthenticateCapability wraps the scopedKeeper's AuthenticateCapability function
 	AuthenticateCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) bool
 }
+
+// IBCQueryHostKeeper answers contract queries that are received from counterparty chains
+type IBCQueryHostKeeper interface {
+	// OnRecvIBCQuery executes the query and returns the raw result
+	OnRecvIBCQuery(ctx sdk.Context, channelID string, request wasmvmtypes.QueryRequest) ([]byte, error)
+	// ClaimCapability allows the query host to claim a capability that IBC module passes to it
+	ClaimCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) error
+}
@@ -32,6 +32,16 @@ func (s GenesisState) ValidateBasic() error {
 			return errorsmod.Wrapf(err, "sequence: %d", i)
 		}
 	}
+	for i := range s.ContractsMigrations {
+		if err := s.ContractsMigrations[i].ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "contracts migration: %d", i)
+		}
+	}
+	for i := range s.PendingAdminTransfers {
+		if err := s.PendingAdminTransfers[i].ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "pending admin transfer: %d", i)
+		}
+	}
 
 	return nil
 }
@@ -46,6 +56,14 @@ func (c Code) ValidateBasic() error {
 	if err := validateWasmCode(c.CodeBytes, MaxProposalWasmSize); err != nil {
 		return errorsmod.Wrap(err, "code bytes")
 	}
+	if err := ValidateStargateQueryPaths(c.StargateQueryPaths); err != nil {
+		return errorsmod.Wrap(err, "stargate query paths")
+	}
+	if c.Schema != nil {
+		if err := c.Schema.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(err, "schema")
+		}
+	}
 	return nil
 }
 
@@ -73,6 +91,14 @@ func (c Contract) ValidateBasic() error {
 			return errorsmod.Wrapf(err, "code history element %d", i)
 		}
 	}
+	if err := ValidateStargateQueryPaths(c.StargateQueryPaths); err != nil {
+		return errorsmod.Wrap(err, "stargate query paths")
+	}
+	if c.Metadata != nil {
+		if err := c.Metadata.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(err, "metadata")
+		}
+	}
 	return nil
 }
 
@@ -100,3 +126,20 @@ var _ codectypes.UnpackInterfacesMessage = &Contract{}
 func (c *Contract) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	return c.ContractInfo.UnpackInterfaces(unpacker)
 }
+
+func (m ContractsMigrationState) ValidateBasic() error {
+	if err := m.Migration.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "migration")
+	}
+	unique := make(map[string]struct{}, len(m.Results))
+	for i, r := range m.Results {
+		if err := r.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "result: %d", i)
+		}
+		if _, exists := unique[r.Contract]; exists {
+			return errorsmod.Wrapf(ErrDuplicate, "result: %d", i)
+		}
+		unique[r.Contract] = struct{}{}
+	}
+	return nil
+}
@@ -29,10 +29,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState - genesis state of x/wasm
 type GenesisState struct {
-	Params    Params     `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	Codes     []Code     `protobuf:"bytes,2,rep,name=codes,proto3" json:"codes,omitempty"`
-	Contracts []Contract `protobuf:"bytes,3,rep,name=contracts,proto3" json:"contracts,omitempty"`
-	Sequences []Sequence `protobuf:"bytes,4,rep,name=sequences,proto3" json:"sequences,omitempty"`
+	Params                Params                    `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Codes                 []Code                    `protobuf:"bytes,2,rep,name=codes,proto3" json:"codes,omitempty"`
+	Contracts             []Contract                `protobuf:"bytes,3,rep,name=contracts,proto3" json:"contracts,omitempty"`
+	Sequences             []Sequence                `protobuf:"bytes,4,rep,name=sequences,proto3" json:"sequences,omitempty"`
+	ContractsMigrations   []ContractsMigrationState `protobuf:"bytes,5,rep,name=contracts_migrations,json=contractsMigrations,proto3" json:"contracts_migrations,omitempty"`
+	PendingAdminTransfers []PendingAdminTransfer    `protobuf:"bytes,6,rep,name=pending_admin_transfers,json=pendingAdminTransfers,proto3" json:"pending_admin_transfers,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -101,6 +103,79 @@ func (m *GenesisState) GetSequences() []Sequence {
 	return nil
 }
 
+func (m *GenesisState) GetContractsMigrations() []ContractsMigrationState {
+	if m != nil {
+		return m.ContractsMigrations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingAdminTransfers() []PendingAdminTransfer {
+	if m != nil {
+		return m.PendingAdminTransfers
+	}
+	return nil
+}
+
+// ContractsMigrationState is a migration of all contracts of a code with the
+// results of the processed contracts
+type ContractsMigrationState struct {
+	Migration ContractsMigration        `protobuf:"bytes,1,opt,name=migration,proto3" json:"migration"`
+	Results   []ContractMigrationResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results"`
+}
+
+func (m *ContractsMigrationState) Reset()         { *m = ContractsMigrationState{} }
+func (m *ContractsMigrationState) String() string { return proto.CompactTextString(m) }
+func (*ContractsMigrationState) ProtoMessage()    {}
+func (*ContractsMigrationState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2ab3f539b23472a6, []int{1}
+}
+
+func (m *ContractsMigrationState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *ContractsMigrationState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractsMigrationState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *ContractsMigrationState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractsMigrationState.Merge(m, src)
+}
+
+func (m *ContractsMigrationState) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *ContractsMigrationState) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractsMigrationState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractsMigrationState proto.InternalMessageInfo
+
+func (m *ContractsMigrationState) GetMigration() ContractsMigration {
+	if m != nil {
+		return m.Migration
+	}
+	return ContractsMigration{}
+}
+
+func (m *ContractsMigrationState) GetResults() []ContractMigrationResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
 // Code struct encompasses CodeInfo and CodeBytes
 type Code struct {
 	CodeID    uint64   `protobuf:"varint,1,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
@@ -108,13 +183,18 @@ type Code struct {
 	CodeBytes []byte   `protobuf:"bytes,3,opt,name=code_bytes,json=codeBytes,proto3" json:"code_bytes,omitempty"`
 	// Pinned to wasmvm cache
 	Pinned bool `protobuf:"varint,4,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	// StargateQueryPaths are the additional stargate query paths that contracts
+	// of this code are allowed to call
+	StargateQueryPaths []string `protobuf:"bytes,5,rep,name=stargate_query_paths,json=stargateQueryPaths,proto3" json:"stargate_query_paths,omitempty"`
+	// Schema is the JSON schema of the code messages, optional
+	Schema *ContractSchema `protobuf:"bytes,6,opt,name=schema,proto3" json:"schema,omitempty"`
 }
 
 func (m *Code) Reset()         { *m = Code{} }
 func (m *Code) String() string { return proto.CompactTextString(m) }
 func (*Code) ProtoMessage()    {}
 func (*Code) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2ab3f539b23472a6, []int{1}
+	return fileDescriptor_2ab3f539b23472a6, []int{2}
 }
 
 func (m *Code) XXX_Unmarshal(b []byte) error {
@@ -176,19 +256,38 @@ func (m *Code) GetPinned() bool {
 	return false
 }
 
+func (m *Code) GetStargateQueryPaths() []string {
+	if m != nil {
+		return m.StargateQueryPaths
+	}
+	return nil
+}
+
+func (m *Code) GetSchema() *ContractSchema {
+	if m != nil {
+		return m.Schema
+	}
+	return nil
+}
+
 // Contract struct encompasses ContractAddress, ContractInfo, and ContractState
 type Contract struct {
 	ContractAddress     string                     `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
 	ContractInfo        ContractInfo               `protobuf:"bytes,2,opt,name=contract_info,json=contractInfo,proto3" json:"contract_info"`
 	ContractState       []Model                    `protobuf:"bytes,3,rep,name=contract_state,json=contractState,proto3" json:"contract_state"`
 	ContractCodeHistory []ContractCodeHistoryEntry `protobuf:"bytes,4,rep,name=contract_code_history,json=contractCodeHistory,proto3" json:"contract_code_history"`
+	// StargateQueryPaths are the additional stargate query paths that the
+	// contract is allowed to call
+	StargateQueryPaths []string `protobuf:"bytes,5,rep,name=stargate_query_paths,json=stargateQueryPaths,proto3" json:"stargate_query_paths,omitempty"`
+	// Metadata is the descriptive metadata of the contract, optional
+	Metadata *ContractMetadata `protobuf:"bytes,6,opt,name=metadata,proto3" json:"metadata,omitempty"`
 }
 
 func (m *Contract) Reset()         { *m = Contract{} }
 func (m *Contract) String() string { return proto.CompactTextString(m) }
 func (*Contract) ProtoMessage()    {}
 func (*Contract) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2ab3f539b23472a6, []int{2}
+	return fileDescriptor_2ab3f539b23472a6, []int{3}
 }
 
 func (m *Contract) XXX_Unmarshal(b []byte) error {
@@ -250,6 +349,20 @@ func (m *Contract) GetContractCodeHistory() []ContractCodeHistoryEntry {
 	return nil
 }
 
+func (m *Contract) GetStargateQueryPaths() []string {
+	if m != nil {
+		return m.StargateQueryPaths
+	}
+	return nil
+}
+
+func (m *Contract) GetMetadata() *ContractMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
 // Sequence key and value of an id generation counter
 type Sequence struct {
 	IDKey []byte `protobuf:"bytes,1,opt,name=id_key,json=idKey,proto3" json:"id_key,omitempty"`
@@ -260,7 +373,7 @@ func (m *Sequence) Reset()         { *m = Sequence{} }
 func (m *Sequence) String() string { return proto.CompactTextString(m) }
 func (*Sequence) ProtoMessage()    {}
 func (*Sequence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2ab3f539b23472a6, []int{3}
+	return fileDescriptor_2ab3f539b23472a6, []int{4}
 }
 
 func (m *Sequence) XXX_Unmarshal(b []byte) error {
@@ -310,6 +423,7 @@ func (m *Sequence) GetValue() uint64 {
 
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmwasm.wasm.v1.GenesisState")
+	proto.RegisterType((*ContractsMigrationState)(nil), "cosmwasm.wasm.v1.ContractsMigrationState")
 	proto.RegisterType((*Code)(nil), "cosmwasm.wasm.v1.Code")
 	proto.RegisterType((*Contract)(nil), "cosmwasm.wasm.v1.Contract")
 	proto.RegisterType((*Sequence)(nil), "cosmwasm.wasm.v1.Sequence")
@@ -318,43 +432,56 @@ func init() {
 func init() { proto.RegisterFile("cosmwasm/wasm/v1/genesis.proto", fileDescriptor_2ab3f539b23472a6) }
 
 var fileDescriptor_2ab3f539b23472a6 = []byte{
-	// 563 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0x9b, 0xae, 0x0d, 0xad, 0x57, 0xd8, 0x30, 0x63, 0x44, 0xd5, 0x48, 0xab, 0x22, 0xa1,
-	0x32, 0xa1, 0x46, 0x1b, 0x47, 0x2e, 0x90, 0x0d, 0x41, 0x98, 0x40, 0x28, 0x3b, 0x20, 0xed, 0x52,
-	0xa5, 0xb1, 0xd7, 0x59, 0x2c, 0x71, 0x88, 0xdd, 0x42, 0xbe, 0x05, 0x9f, 0x02, 0x71, 0xe4, 0xce,
-	0x17, 0xd8, 0x8d, 0x1d, 0x39, 0x55, 0x28, 0x3d, 0x20, 0xf1, 0x29, 0x26, 0xdb, 0x49, 0x16, 0xb5,
-	0xeb, 0xc5, 0x4a, 0xde, 0xfb, 0xbf, 0x9f, 0xed, 0xbf, 0xdf, 0x03, 0xa6, 0x4f, 0x59, 0xf0, 0xc5,
-	0x63, 0x81, 0x25, 0x97, 0xe9, 0x9e, 0x35, 0xc6, 0x21, 0x66, 0x84, 0x0d, 0xa2, 0x98, 0x72, 0x0a,
-	0x37, 0xf3, 0xfc, 0x40, 0x2e, 0xd3, 0xbd, 0xf6, 0xd6, 0x98, 0x8e, 0xa9, 0x4c, 0x5a, 0xe2, 0x4b,
-	0xe9, 0xda, 0x3b, 0x4b, 0x1c, 0x9e, 0x44, 0x38, 0xa3, 0xb4, 0xef, 0x7a, 0x01, 0x09, 0xa9, 0x25,
-	0x57, 0x15, 0xea, 0xfd, 0xae, 0x82, 0xd6, 0x6b, 0xb5, 0xd5, 0x31, 0xf7, 0x38, 0x86, 0xcf, 0x81,
-	0x1e, 0x79, 0xb1, 0x17, 0x30, 0x43, 0xeb, 0x6a, 0xfd, 0xf5, 0x7d, 0x63, 0xb0, 0xb8, 0xf5, 0xe0,
-	0x83, 0xcc, 0xdb, 0xcd, 0x8b, 0x59, 0xa7, 0xf2, 0xe3, 0xdf, 0xcf, 0x5d, 0xcd, 0xcd, 0x4a, 0xe0,
-	0x5b, 0x50, 0xf7, 0x29, 0xc2, 0xcc, 0xa8, 0x76, 0xd7, 0xfa, 0xeb, 0xfb, 0xdb, 0xcb, 0xb5, 0x07,
-	0x14, 0x61, 0x7b, 0x47, 0x54, 0xfe, 0x9f, 0x75, 0x36, 0xa4, 0xf8, 0x29, 0x0d, 0x08, 0xc7, 0x41,
-	0xc4, 0x13, 0x05, 0x53, 0x08, 0x78, 0x02, 0x9a, 0x3e, 0x0d, 0x79, 0xec, 0xf9, 0x9c, 0x19, 0x6b,
-	0x92, 0xd7, 0xbe, 0x89, 0xa7, 0x24, 0x76, 0x37, 0x63, 0xde, 0x2b, 0x8a, 0x16, 0xb9, 0xd7, 0x38,
-	0xc1, 0x66, 0xf8, 0xf3, 0x04, 0x87, 0x3e, 0x66, 0x46, 0x6d, 0x15, 0xfb, 0x38, 0x93, 0x5c, 0xb3,
-	0x8b, 0xa2, 0x25, 0x76, 0x91, 0xe9, 0x7d, 0xd7, 0x40, 0x4d, 0xdc, 0x12, 0x3e, 0x02, 0xb7, 0xc4,
-	0x4d, 0x86, 0x04, 0x49, 0x2b, 0x6b, 0x36, 0x48, 0x67, 0x1d, 0x5d, 0xa4, 0x9c, 0x43, 0x57, 0x17,
-	0x29, 0x07, 0x41, 0x5b, 0xdc, 0x52, 0x88, 0xc2, 0x53, 0x6a, 0x54, 0xa5, 0xe3, 0xed, 0x9b, 0x5d,
-	0x73, 0xc2, 0x53, 0x5a, 0xf6, 0xbc, 0xe1, 0x67, 0x41, 0xf8, 0x10, 0x00, 0xc9, 0x18, 0x25, 0x1c,
-	0x0b, 0xab, 0xb4, 0x7e, 0xcb, 0x95, 0x54, 0x5b, 0x04, 0xe0, 0x36, 0xd0, 0x23, 0x12, 0x86, 0x18,
-	0x19, 0xb5, 0xae, 0xd6, 0x6f, 0xb8, 0xd9, 0x5f, 0xef, 0x57, 0x15, 0x34, 0x72, 0xfb, 0xe0, 0x13,
-	0xb0, 0x99, 0xdb, 0x33, 0xf4, 0x10, 0x8a, 0x31, 0x53, 0x0d, 0xd0, 0x74, 0x37, 0xf2, 0xf8, 0x4b,
-	0x15, 0x86, 0xef, 0xc1, 0xed, 0x42, 0x5a, 0x3a, 0xb6, 0xb9, 0xfa, 0x71, 0x16, 0x8f, 0xde, 0xf2,
-	0x4b, 0x09, 0xe8, 0x80, 0x3b, 0x05, 0x8f, 0x89, 0x1e, 0xcc, 0x5e, 0xfb, 0xc1, 0x32, 0xf0, 0x1d,
-	0x45, 0xf8, 0xbc, 0x4c, 0x2a, 0x4e, 0xa2, 0x9a, 0x97, 0x80, 0xfb, 0x05, 0x4a, 0x5a, 0x72, 0x46,
-	0x18, 0xa7, 0x71, 0x92, 0xbd, 0xf1, 0xee, 0xea, 0x23, 0x0a, 0x87, 0xdf, 0x28, 0xf1, 0xab, 0x90,
-	0xc7, 0x49, 0x79, 0x93, 0xa2, 0xa5, 0x4a, 0xa2, 0x9e, 0x0d, 0x1a, 0x79, 0x7f, 0xc0, 0x2e, 0xd0,
-	0x09, 0x1a, 0x7e, 0xc2, 0x89, 0xb4, 0xac, 0x65, 0x37, 0xd3, 0x59, 0xa7, 0xee, 0x1c, 0x1e, 0xe1,
-	0xc4, 0xad, 0x13, 0x74, 0x84, 0x13, 0xb8, 0x05, 0xea, 0x53, 0xef, 0x7c, 0x82, 0xa5, 0x57, 0x35,
-	0x57, 0xfd, 0xd8, 0x2f, 0x2e, 0x52, 0x53, 0xbb, 0x4c, 0x4d, 0xed, 0x6f, 0x6a, 0x6a, 0xdf, 0xe6,
-	0x66, 0xe5, 0x72, 0x6e, 0x56, 0xfe, 0xcc, 0xcd, 0xca, 0xc9, 0xe3, 0x31, 0xe1, 0x67, 0x93, 0xd1,
-	0xc0, 0xa7, 0x81, 0x75, 0x40, 0x59, 0xf0, 0x31, 0x1f, 0x69, 0x64, 0x7d, 0x55, 0xa3, 0x2d, 0xe7,
-	0x7a, 0xa4, 0xcb, 0x29, 0x7e, 0x76, 0x15, 0x00, 0x00, 0xff, 0xff, 0x45, 0x4b, 0xa7, 0x92, 0x40,
-	0x04, 0x00, 0x00,
+	// 774 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0xcd, 0x4e, 0xdb, 0x4a,
+	0x14, 0xc7, 0x63, 0x48, 0x4c, 0x32, 0xe4, 0x5e, 0xb8, 0x43, 0x00, 0x2b, 0xe2, 0x3a, 0xb9, 0xb9,
+	0x15, 0x0a, 0xb4, 0x4a, 0x0a, 0xdd, 0x54, 0xaa, 0x54, 0x15, 0x43, 0xd5, 0xa6, 0x08, 0x44, 0x4d,
+	0xa5, 0x4a, 0x6c, 0xac, 0xc1, 0x1e, 0x12, 0xab, 0xd8, 0x63, 0x3c, 0x13, 0x5a, 0x3f, 0x42, 0x37,
+	0x55, 0xd5, 0xa7, 0xe8, 0xa6, 0x52, 0x97, 0x7d, 0x04, 0x96, 0x2c, 0xbb, 0x8a, 0xaa, 0xb0, 0xa8,
+	0x54, 0xf5, 0x21, 0x2a, 0xcf, 0x8c, 0x4d, 0x94, 0x0f, 0x54, 0x75, 0x33, 0xe0, 0x39, 0xff, 0xf3,
+	0x3b, 0x67, 0xce, 0x39, 0x99, 0x01, 0xba, 0x4d, 0xa8, 0xf7, 0x1a, 0x51, 0xaf, 0xc9, 0x97, 0xf3,
+	0x8d, 0x66, 0x1b, 0xfb, 0x98, 0xba, 0xb4, 0x11, 0x84, 0x84, 0x11, 0x38, 0x9f, 0xd8, 0x1b, 0x7c,
+	0x39, 0xdf, 0x28, 0x97, 0xda, 0xa4, 0x4d, 0xb8, 0xb1, 0x19, 0xff, 0x27, 0x74, 0xe5, 0x95, 0x11,
+	0x0e, 0x8b, 0x02, 0x2c, 0x29, 0xe5, 0x7f, 0x90, 0xe7, 0xfa, 0xa4, 0xc9, 0x57, 0xb1, 0x55, 0xfb,
+	0x99, 0x05, 0xc5, 0x27, 0x22, 0xd4, 0x21, 0x43, 0x0c, 0xc3, 0x07, 0x40, 0x0d, 0x50, 0x88, 0x3c,
+	0xaa, 0x29, 0x55, 0xa5, 0x3e, 0xbb, 0xa9, 0x35, 0x86, 0x43, 0x37, 0x0e, 0xb8, 0xdd, 0x28, 0x5c,
+	0xf4, 0x2a, 0x99, 0x8f, 0xdf, 0x3f, 0xaf, 0x2b, 0xa6, 0x74, 0x81, 0xcf, 0x40, 0xce, 0x26, 0x0e,
+	0xa6, 0xda, 0x54, 0x75, 0xba, 0x3e, 0xbb, 0xb9, 0x34, 0xea, 0xbb, 0x4d, 0x1c, 0x6c, 0xac, 0xc4,
+	0x9e, 0x3f, 0x7a, 0x95, 0x39, 0x2e, 0xbe, 0x43, 0x3c, 0x97, 0x61, 0x2f, 0x60, 0x91, 0x80, 0x09,
+	0x04, 0x3c, 0x02, 0x05, 0x9b, 0xf8, 0x2c, 0x44, 0x36, 0xa3, 0xda, 0x34, 0xe7, 0x95, 0xc7, 0xf1,
+	0x84, 0xc4, 0xa8, 0x4a, 0xe6, 0x42, 0xea, 0x34, 0xcc, 0xbd, 0xc6, 0xc5, 0x6c, 0x8a, 0xcf, 0xba,
+	0xd8, 0xb7, 0x31, 0xd5, 0xb2, 0x93, 0xd8, 0x87, 0x52, 0x72, 0xcd, 0x4e, 0x9d, 0x46, 0xd8, 0xa9,
+	0x05, 0xbe, 0x55, 0x40, 0x29, 0x8d, 0x64, 0x79, 0x6e, 0x3b, 0x44, 0xcc, 0x25, 0x3e, 0xd5, 0x72,
+	0x3c, 0xce, 0xda, 0xe4, 0x33, 0xd0, 0xbd, 0x44, 0xcc, 0x5b, 0x61, 0xdc, 0x96, 0x61, 0xf5, 0x71,
+	0xb8, 0xe1, 0x0c, 0x16, 0xec, 0x11, 0x0a, 0x85, 0xef, 0x14, 0xb0, 0x1c, 0x60, 0xdf, 0x71, 0xfd,
+	0xb6, 0x85, 0x1c, 0xcf, 0xf5, 0x2d, 0x16, 0x22, 0x9f, 0x9e, 0xe0, 0x90, 0x6a, 0x2a, 0x4f, 0x67,
+	0x75, 0x4c, 0x7b, 0x85, 0xc3, 0x56, 0xac, 0x7f, 0x21, 0xe5, 0x46, 0x43, 0xe6, 0xf2, 0xdf, 0x04,
+	0xdc, 0x70, 0x3a, 0x8b, 0xc1, 0x18, 0x0a, 0xad, 0x7d, 0x51, 0xc0, 0xf2, 0x84, 0xe3, 0xc2, 0x3d,
+	0x50, 0x48, 0x8f, 0x27, 0x87, 0xef, 0xd6, 0xef, 0x14, 0x6b, 0x70, 0x10, 0xaf, 0x09, 0x70, 0x1f,
+	0xcc, 0x84, 0x98, 0x76, 0x4f, 0x59, 0x32, 0x8d, 0x37, 0x54, 0x3e, 0x65, 0x99, 0xdc, 0x63, 0x90,
+	0x98, 0x40, 0x6a, 0x1f, 0xa6, 0x40, 0x36, 0x9e, 0x5e, 0xf8, 0x3f, 0x98, 0x89, 0x27, 0xd4, 0x72,
+	0x1d, 0x9e, 0x65, 0xd6, 0x00, 0xfd, 0x5e, 0x45, 0x8d, 0x4d, 0xad, 0x1d, 0x53, 0x8d, 0x4d, 0x2d,
+	0x07, 0x1a, 0xa0, 0x20, 0x44, 0xfe, 0x09, 0xd1, 0xa6, 0xaa, 0xca, 0xf8, 0x09, 0xe3, 0x4e, 0xfe,
+	0x09, 0x19, 0x0c, 0x98, 0xb7, 0xe5, 0x26, 0xfc, 0x17, 0x00, 0xce, 0x38, 0x8e, 0x18, 0x8e, 0x7f,
+	0x02, 0x4a, 0xbd, 0x68, 0x72, 0xaa, 0x11, 0x6f, 0xc0, 0x25, 0xa0, 0x06, 0xae, 0xef, 0x63, 0x47,
+	0xcb, 0x56, 0x95, 0x7a, 0xde, 0x94, 0x5f, 0xf0, 0x2e, 0x28, 0x51, 0x86, 0xc2, 0x36, 0x62, 0xd8,
+	0x3a, 0xeb, 0xe2, 0x30, 0xb2, 0x02, 0xc4, 0x3a, 0x62, 0xfe, 0x0a, 0x26, 0x4c, 0x6c, 0xcf, 0x63,
+	0xd3, 0x41, 0x6c, 0x81, 0xf7, 0x81, 0x4a, 0xed, 0x0e, 0xf6, 0x90, 0xa6, 0xf2, 0x4c, 0xab, 0x93,
+	0x2b, 0x75, 0xc8, 0x75, 0xa6, 0xd4, 0xd7, 0x3e, 0x4d, 0x83, 0x7c, 0x62, 0x82, 0x6b, 0x60, 0x3e,
+	0x19, 0x42, 0x0b, 0x39, 0x4e, 0x88, 0xa9, 0xb8, 0x44, 0x0a, 0xe6, 0x5c, 0xb2, 0xbf, 0x25, 0xb6,
+	0xe1, 0x3e, 0xf8, 0x2b, 0x95, 0x0e, 0x94, 0x48, 0x9f, 0x1c, 0x78, 0xb8, 0x4c, 0x45, 0x7b, 0xc0,
+	0x00, 0x5b, 0xe0, 0xef, 0x94, 0x47, 0xe3, 0x69, 0x92, 0x37, 0xc6, 0xf2, 0x28, 0x70, 0x8f, 0x38,
+	0xf8, 0x74, 0x90, 0x94, 0x66, 0x22, 0xc6, 0xd0, 0x05, 0x8b, 0x29, 0x8a, 0x97, 0xbf, 0xe3, 0x52,
+	0x46, 0xc2, 0x48, 0xde, 0x13, 0xeb, 0x93, 0x53, 0x8c, 0xbb, 0xf9, 0x54, 0x88, 0x1f, 0xfb, 0x2c,
+	0x8c, 0x06, 0x83, 0x2c, 0xd8, 0xa3, 0xa2, 0x3f, 0xe8, 0xd4, 0x43, 0x90, 0xf7, 0x30, 0x43, 0x0e,
+	0x62, 0x49, 0xaf, 0x6a, 0x37, 0x4c, 0xb5, 0x54, 0x9a, 0xa9, 0x4f, 0xcd, 0x00, 0xf9, 0xe4, 0x56,
+	0x83, 0x55, 0xa0, 0xba, 0x8e, 0xf5, 0x0a, 0x47, 0xbc, 0x49, 0x45, 0xa3, 0xd0, 0xef, 0x55, 0x72,
+	0xad, 0x9d, 0x5d, 0x1c, 0x99, 0x39, 0xd7, 0xd9, 0xc5, 0x11, 0x2c, 0x81, 0xdc, 0x39, 0x3a, 0xed,
+	0x62, 0xde, 0x9d, 0xac, 0x29, 0x3e, 0x8c, 0x47, 0x17, 0x7d, 0x5d, 0xb9, 0xec, 0xeb, 0xca, 0xb7,
+	0xbe, 0xae, 0xbc, 0xbf, 0xd2, 0x33, 0x97, 0x57, 0x7a, 0xe6, 0xeb, 0x95, 0x9e, 0x39, 0x5a, 0x6d,
+	0xbb, 0xac, 0xd3, 0x3d, 0x6e, 0xd8, 0xc4, 0x6b, 0x6e, 0x13, 0xea, 0xbd, 0x4c, 0x1e, 0x22, 0xa7,
+	0xf9, 0x86, 0xff, 0x15, 0xaf, 0xd1, 0xb1, 0xca, 0xdf, 0x9e, 0x7b, 0xbf, 0x06, 0x00, 0x73, 0xa7,
+	0xc7, 0x5c, 0xf6, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -377,6 +504,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingAdminTransfers) > 0 {
+		for iNdEx := len(m.PendingAdminTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingAdminTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ContractsMigrations) > 0 {
+		for iNdEx := len(m.ContractsMigrations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractsMigrations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.Sequences) > 0 {
 		for iNdEx := len(m.Sequences) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -432,6 +587,53 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ContractsMigrationState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractsMigrationState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractsMigrationState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Migration.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Code) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -452,6 +654,27 @@ func (m *Code) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Schema != nil {
+		{
+			size, err := m.Schema.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.StargateQueryPaths) > 0 {
+		for iNdEx := len(m.StargateQueryPaths) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.StargateQueryPaths[iNdEx])
+			copy(dAtA[i:], m.StargateQueryPaths[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.StargateQueryPaths[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.Pinned {
 		i--
 		if m.Pinned {
@@ -507,6 +730,27 @@ func (m *Contract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.StargateQueryPaths) > 0 {
+		for iNdEx := len(m.StargateQueryPaths) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.StargateQueryPaths[iNdEx])
+			copy(dAtA[i:], m.StargateQueryPaths[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.StargateQueryPaths[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.ContractCodeHistory) > 0 {
 		for iNdEx := len(m.ContractCodeHistory) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -628,6 +872,35 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ContractsMigrations) > 0 {
+		for _, e := range m.ContractsMigrations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingAdminTransfers) > 0 {
+		for _, e := range m.PendingAdminTransfers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ContractsMigrationState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Migration.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -649,6 +922,16 @@ func (m *Code) Size() (n int) {
 	if m.Pinned {
 		n += 2
 	}
+	if len(m.StargateQueryPaths) > 0 {
+		for _, s := range m.StargateQueryPaths {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.Schema != nil {
+		l = m.Schema.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -676,6 +959,16 @@ func (m *Contract) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.StargateQueryPaths) > 0 {
+		for _, s := range m.StargateQueryPaths {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -867,6 +1160,192 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractsMigrations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractsMigrations = append(m.ContractsMigrations, ContractsMigrationState{})
+			if err := m.ContractsMigrations[len(m.ContractsMigrations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingAdminTransfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingAdminTransfers = append(m.PendingAdminTransfers, PendingAdminTransfer{})
+			if err := m.PendingAdminTransfers[len(m.PendingAdminTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *ContractsMigrationState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractsMigrationState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractsMigrationState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Migration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Migration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, ContractMigrationResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1024,6 +1503,74 @@ func (m *Code) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Pinned = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StargateQueryPaths", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StargateQueryPaths = append(m.StargateQueryPaths, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Schema == nil {
+				m.Schema = &ContractSchema{}
+			}
+			if err := m.Schema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1208,6 +1755,74 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StargateQueryPaths", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StargateQueryPaths = append(m.StargateQueryPaths, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &ContractMetadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -48,6 +48,56 @@ func TestValidateGenesisState(t *testing.T) {
 			},
 			expError: true,
 		},
+		"contracts migration": {
+			srcMutator: func(s *GenesisState) {
+				s.ContractsMigrations = []ContractsMigrationState{contractsMigrationStateFixture()}
+			},
+		},
+		"contracts migration invalid": {
+			srcMutator: func(s *GenesisState) {
+				m := contractsMigrationStateFixture()
+				m.Migration.BatchSize = 0
+				s.ContractsMigrations = []ContractsMigrationState{m}
+			},
+			expError: true,
+		},
+		"contracts migration result invalid": {
+			srcMutator: func(s *GenesisState) {
+				m := contractsMigrationStateFixture()
+				m.Results[0].Contract = invalidAddress
+				s.ContractsMigrations = []ContractsMigrationState{m}
+			},
+			expError: true,
+		},
+		"pending admin transfer": {
+			srcMutator: func(s *GenesisState) {
+				s.PendingAdminTransfers = []PendingAdminTransfer{pendingAdminTransferFixture()}
+			},
+		},
+		"pending admin transfer invalid": {
+			srcMutator: func(s *GenesisState) {
+				transfer := pendingAdminTransferFixture()
+				transfer.NewAdmin = invalidAddress
+				s.PendingAdminTransfers = []PendingAdminTransfer{transfer}
+			},
+			expError: true,
+		},
+		"pending admin transfer without expiry": {
+			srcMutator: func(s *GenesisState) {
+				transfer := pendingAdminTransferFixture()
+				transfer.ExpiryHeight = 0
+				s.PendingAdminTransfers = []PendingAdminTransfer{transfer}
+			},
+			expError: true,
+		},
+		"contracts migration duplicate result": {
+			srcMutator: func(s *GenesisState) {
+				m := contractsMigrationStateFixture()
+				m.Results = append(m.Results, m.Results[0])
+				s.ContractsMigrations = []ContractsMigrationState{m}
+			},
+			expError: true,
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -62,6 +112,35 @@ func TestValidateGenesisState(t *testing.T) {
 	}
 }
 
+func contractsMigrationStateFixture() ContractsMigrationState {
+	return ContractsMigrationState{
+		Migration: ContractsMigration{
+			ID:          1,
+			CodeID:      1,
+			NewCodeID:   2,
+			Msg:         []byte(`{}`),
+			BatchSize:   DefaultContractsMigrationBatchSize,
+			StartHeight: 1,
+			EndHeight:   2,
+			Succeeded:   1,
+			Failed:      1,
+		},
+		Results: []ContractMigrationResult{
+			{Contract: sdk.AccAddress(randBytes(ContractAddrLen)).String(), Success: true, Height: 1},
+			{Contract: sdk.AccAddress(randBytes(ContractAddrLen)).String(), Error: "migrate wasm contract failed", Height: 2},
+		},
+	}
+}
+
+func pendingAdminTransferFixture() PendingAdminTransfer {
+	return PendingAdminTransfer{
+		Contract:     sdk.AccAddress(randBytes(ContractAddrLen)).String(),
+		NewAdmin:     sdk.AccAddress(randBytes(SDKAddrLen)).String(),
+		Proposer:     sdk.AccAddress(randBytes(SDKAddrLen)).String(),
+		ExpiryHeight: 100,
+	}
+}
+
 func TestCodeValidateBasic(t *testing.T) {
 	specs := map[string]struct {
 		srcMutator func(*Code)
@@ -98,6 +177,17 @@ func TestCodeValidateBasic(t *testing.T) {
 			},
 			expError: true,
 		},
+		"with schema": {
+			srcMutator: func(c *Code) {
+				c.Schema = &ContractSchema{Execute: []byte(`{"type":"object"}`)}
+			},
+		},
+		"schema invalid": {
+			srcMutator: func(c *Code) {
+				c.Schema = &ContractSchema{Execute: []byte(`{"type":"text"}`)}
+			},
+			expError: true,
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -0,0 +1,43 @@
+package types
+
+import (
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+)
+
+const (
+	// IBCQueryPortID is the port that the cross-chain contract query host is bound to
+	IBCQueryPortID = "wasmquery"
+	// IBCQueryVersion is the version of the cross-chain contract query protocol
+	IBCQueryVersion = "wasm-query-1"
+)
+
+// IBCQueryPacketData is the packet payload that a contract sends over a query channel to
+// have a query executed on the counterparty chain. The query result is returned in the
+// `result` field of the standard acknowledgement envelope. Errors are returned in the `error` field.
+type IBCQueryPacketData struct {
+	Request wasmvmtypes.QueryRequest `json:"request"`
+}
+
+// ValidateBasic performs basic stateless checks
+func (p IBCQueryPacketData) ValidateBasic() error {
+	bz, err := json.Marshal(p.Request)
+	if err != nil {
+		return errorsmod.Wrap(ErrInvalid, "request")
+	}
+	if string(bz) == "{}" {
+		return errorsmod.Wrap(ErrEmpty, "request")
+	}
+	return nil
+}
+
+// GetBytes returns the JSON encoded packet data
+func (p IBCQueryPacketData) GetBytes() []byte {
+	bz, err := json.Marshal(p)
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
@@ -1,7 +1,11 @@
 package types
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
 )
 
 // isJSONObjectWithTopLevelKey returns true if the given bytes are a valid JSON object
@@ -32,3 +36,42 @@ func isJSONObjectWithTopLevelKey(jsonBytes RawContractMessage, allowedKeys []str
 
 	panic("Reached unreachable code. This is a bug.")
 }
+
+// lookupJSONPath returns the value at the dot separated path of object keys and array indexes
+// in the decoded JSON document. Returns false when the path does not exist.
+func lookupJSONPath(document interface{}, path string) (interface{}, bool) {
+	current := document
+	for _, segment := range strings.Split(path, ".") {
+		switch v := current.(type) {
+		case map[string]interface{}:
+			next, ok := v[segment]
+			if !ok {
+				return nil, false
+			}
+			current = next
+		case []interface{}:
+			idx, err := strconv.ParseUint(segment, 10, 32)
+			if err != nil || idx >= uint64(len(v)) {
+				return nil, false
+			}
+			current = v[idx]
+		default:
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+// decodeJSON decodes the bytes into a generic document. Numbers are kept as json.Number to not lose precision.
+func decodeJSON(bz []byte) (interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(bz))
+	dec.UseNumber()
+	var document interface{}
+	if err := dec.Decode(&document); err != nil {
+		return nil, err
+	}
+	if dec.More() {
+		return nil, errors.New("unexpected data after JSON value")
+	}
+	return document, nil
+}
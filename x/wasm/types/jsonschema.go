@@ -0,0 +1,674 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+const (
+	// maxJSONSchemaDepth is the max nesting of JSON documents and schema references
+	maxJSONSchemaDepth = 128
+	// maxJSONNumberExponent is the max absolute exponent of a number in scientific notation
+	maxJSONNumberExponent = 308
+)
+
+// jsonSchemaTypes are the primitive types of a JSON schema
+var jsonSchemaTypes = map[string]struct{}{
+	"null": {}, "boolean": {}, "object": {}, "array": {}, "number": {}, "integer": {}, "string": {},
+}
+
+// jsonSchemaIntegerFormats are the integer formats generated by cosmwasm-schema with their bounds
+var jsonSchemaIntegerFormats = map[string][2]*big.Rat{
+	"uint8": uintBounds(8), "uint16": uintBounds(16), "uint32": uintBounds(32), "uint64": uintBounds(64),
+	"int8": intBounds(8), "int16": intBounds(16), "int32": intBounds(32), "int64": intBounds(64),
+}
+
+func uintBounds(bits uint) [2]*big.Rat {
+	upper := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), bits), big.NewInt(1))
+	return [2]*big.Rat{new(big.Rat), new(big.Rat).SetInt(upper)}
+}
+
+func intBounds(bits uint) [2]*big.Rat {
+	half := new(big.Int).Lsh(big.NewInt(1), bits-1)
+	return [2]*big.Rat{new(big.Rat).SetInt(new(big.Int).Neg(half)), new(big.Rat).SetInt(new(big.Int).Sub(half, big.NewInt(1)))}
+}
+
+// JSONSchema is a compiled JSON schema to validate contract messages against.
+//
+// It supports the subset of JSON schema draft 7 that is generated by cosmwasm-schema: boolean schemas,
+// type, enum, const, properties, required, additionalProperties, items, additionalItems, minItems, maxItems,
+// minLength, maxLength, minimum, maximum, exclusiveMinimum, exclusiveMaximum, the integer formats, allOf,
+// anyOf, oneOf, not and $ref to a JSON pointer within the same document. Any other keyword is ignored.
+// The validation is deterministic: object keys are processed in sorted order and the first violation is returned.
+type JSONSchema struct {
+	root *jsonSchemaNode
+}
+
+type jsonSchemaNode struct {
+	// never is set for the `false` schema. The `true` schema is a node without constraints.
+	never                bool
+	ref                  *jsonSchemaNode
+	types                []string
+	enum                 []interface{}
+	constVal             interface{}
+	hasConst             bool
+	properties           map[string]*jsonSchemaNode
+	required             []string
+	additionalProperties *jsonSchemaNode
+	items                *jsonSchemaNode
+	tupleItems           []*jsonSchemaNode
+	additionalItems      *jsonSchemaNode
+	minItems, maxItems   *int
+	minLength, maxLength *int
+	minimum, maximum     *big.Rat
+	exclusiveMinimum     *big.Rat
+	exclusiveMaximum     *big.Rat
+	format               string
+	allOf, anyOf, oneOf  []*jsonSchemaNode
+	not                  *jsonSchemaNode
+}
+
+// CompileJSONSchema parses the given JSON schema document
+func CompileJSONSchema(bz []byte) (*JSONSchema, error) {
+	doc, err := decodeJSONNumbersAsRat(bz)
+	if err != nil {
+		return nil, errorsmod.Wrap(ErrInvalid, err.Error())
+	}
+	c := jsonSchemaCompiler{doc: doc, refs: make(map[string]*jsonSchemaNode)}
+	root, err := c.compileRef("#", 0)
+	if err != nil {
+		return nil, errorsmod.Wrap(ErrInvalid, err.Error())
+	}
+	return &JSONSchema{root: root}, nil
+}
+
+// Validate checks the JSON message against the schema. It returns the number of validation steps taken which
+// is limited by MaxJSONSchemaValidationSteps.
+func (s JSONSchema) Validate(msg []byte) (int, error) {
+	doc, err := decodeJSONNumbersAsRat(msg)
+	if err != nil {
+		return 0, errorsmod.Wrap(ErrSchemaValidation, err.Error())
+	}
+	var v jsonSchemaValidator
+	err = v.validate(s.root, doc, "", 0)
+	return v.steps, err
+}
+
+type jsonSchemaCompiler struct {
+	doc  interface{}
+	refs map[string]*jsonSchemaNode
+}
+
+// compileRef returns the node for the JSON pointer. Nodes are registered before they are compiled so that
+// recursive references resolve to the same node.
+func (c *jsonSchemaCompiler) compileRef(ref string, depth int) (*jsonSchemaNode, error) {
+	if n, ok := c.refs[ref]; ok {
+		return n, nil
+	}
+	v, err := c.resolve(ref)
+	if err != nil {
+		return nil, err
+	}
+	n := &jsonSchemaNode{}
+	c.refs[ref] = n
+	return n, c.compileInto(n, v, ref, depth)
+}
+
+// resolve returns the sub document for a JSON pointer in URI fragment form, i.e. "#/definitions/Uint128"
+func (c *jsonSchemaCompiler) resolve(ref string) (interface{}, error) {
+	if ref == "#" {
+		return c.doc, nil
+	}
+	if !strings.HasPrefix(ref, "#/") {
+		return nil, fmt.Errorf("unsupported reference %q", ref)
+	}
+	cur := c.doc
+	for _, token := range strings.Split(ref[2:], "/") {
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+		switch x := cur.(type) {
+		case map[string]interface{}:
+			v, ok := x[token]
+			if !ok {
+				return nil, fmt.Errorf("unresolved reference %q", ref)
+			}
+			cur = v
+		case []interface{}:
+			i, err := strconv.Atoi(token)
+			if err != nil || i < 0 || i >= len(x) {
+				return nil, fmt.Errorf("unresolved reference %q", ref)
+			}
+			cur = x[i]
+		default:
+			return nil, fmt.Errorf("unresolved reference %q", ref)
+		}
+	}
+	return cur, nil
+}
+
+func (c *jsonSchemaCompiler) compile(v interface{}, path string, depth int) (*jsonSchemaNode, error) {
+	n := &jsonSchemaNode{}
+	return n, c.compileInto(n, v, path, depth)
+}
+
+func (c *jsonSchemaCompiler) compileAll(v interface{}, path string, depth int) ([]*jsonSchemaNode, error) {
+	list, ok := v.([]interface{})
+	if !ok || len(list) == 0 {
+		return nil, fmt.Errorf("%s: must be a non empty array", path)
+	}
+	r := make([]*jsonSchemaNode, len(list))
+	for i, e := range list {
+		var err error
+		if r[i], err = c.compile(e, fmt.Sprintf("%s/%d", path, i), depth); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+func (c *jsonSchemaCompiler) compileInto(n *jsonSchemaNode, v interface{}, path string, depth int) error {
+	if depth > maxJSONSchemaDepth {
+		return fmt.Errorf("%s: max depth %d exceeded", path, maxJSONSchemaDepth)
+	}
+	var obj map[string]interface{}
+	switch x := v.(type) {
+	case bool:
+		n.never = !x
+		return nil
+	case map[string]interface{}:
+		obj = x
+	default:
+		return fmt.Errorf("%s: schema must be an object or boolean", path)
+	}
+	depth++
+
+	// as of draft 7 all other keywords are ignored when a reference is set
+	if x, ok := obj["$ref"]; ok {
+		ref, ok := x.(string)
+		if !ok {
+			return fmt.Errorf("%s/$ref: must be a string", path)
+		}
+		var err error
+		n.ref, err = c.compileRef(ref, depth)
+		return err
+	}
+	if x, ok := obj["type"]; ok {
+		switch t := x.(type) {
+		case string:
+			n.types = []string{t}
+		case []interface{}:
+			for _, e := range t {
+				s, ok := e.(string)
+				if !ok {
+					return fmt.Errorf("%s/type: must be a string or array of strings", path)
+				}
+				n.types = append(n.types, s)
+			}
+		default:
+			return fmt.Errorf("%s/type: must be a string or array of strings", path)
+		}
+		for _, t := range n.types {
+			if _, ok := jsonSchemaTypes[t]; !ok {
+				return fmt.Errorf("%s/type: unknown type %q", path, t)
+			}
+		}
+	}
+	if x, ok := obj["enum"]; ok {
+		list, ok := x.([]interface{})
+		if !ok || len(list) == 0 {
+			return fmt.Errorf("%s/enum: must be a non empty array", path)
+		}
+		n.enum = list
+	}
+	if x, ok := obj["const"]; ok {
+		n.constVal, n.hasConst = x, true
+	}
+	if x, ok := obj["properties"]; ok {
+		props, ok := x.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("%s/properties: must be an object", path)
+		}
+		n.properties = make(map[string]*jsonSchemaNode, len(props))
+		for _, k := range sortedKeys(props) {
+			var err error
+			if n.properties[k], err = c.compile(props[k], path+"/properties/"+k, depth); err != nil {
+				return err
+			}
+		}
+	}
+	if x, ok := obj["required"]; ok {
+		list, ok := x.([]interface{})
+		if !ok {
+			return fmt.Errorf("%s/required: must be an array of strings", path)
+		}
+		for _, e := range list {
+			s, ok := e.(string)
+			if !ok {
+				return fmt.Errorf("%s/required: must be an array of strings", path)
+			}
+			n.required = append(n.required, s)
+		}
+	}
+	var err error
+	if x, ok := obj["additionalProperties"]; ok {
+		if n.additionalProperties, err = c.compile(x, path+"/additionalProperties", depth); err != nil {
+			return err
+		}
+	}
+	if x, ok := obj["items"]; ok {
+		if _, isTuple := x.([]interface{}); isTuple {
+			n.tupleItems, err = c.compileAll(x, path+"/items", depth)
+		} else {
+			n.items, err = c.compile(x, path+"/items", depth)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if x, ok := obj["additionalItems"]; ok {
+		if n.additionalItems, err = c.compile(x, path+"/additionalItems", depth); err != nil {
+			return err
+		}
+	}
+	for _, l := range []struct {
+		keyword string
+		dst     **int
+	}{
+		{"minItems", &n.minItems}, {"maxItems", &n.maxItems}, {"minLength", &n.minLength}, {"maxLength", &n.maxLength},
+	} {
+		x, ok := obj[l.keyword]
+		if !ok {
+			continue
+		}
+		r, ok := x.(*big.Rat)
+		if !ok || !r.IsInt() || r.Sign() < 0 || !r.Num().IsInt64() || r.Num().Int64() > math.MaxInt32 {
+			return fmt.Errorf("%s/%s: must be a non negative integer", path, l.keyword)
+		}
+		i := int(r.Num().Int64())
+		*l.dst = &i
+	}
+	for _, l := range []struct {
+		keyword string
+		dst     **big.Rat
+	}{
+		{"minimum", &n.minimum}, {"maximum", &n.maximum}, {"exclusiveMinimum", &n.exclusiveMinimum}, {"exclusiveMaximum", &n.exclusiveMaximum},
+	} {
+		x, ok := obj[l.keyword]
+		if !ok {
+			continue
+		}
+		r, ok := x.(*big.Rat)
+		if !ok {
+			return fmt.Errorf("%s/%s: must be a number", path, l.keyword)
+		}
+		*l.dst = r
+	}
+	if x, ok := obj["format"]; ok {
+		if n.format, ok = x.(string); !ok {
+			return fmt.Errorf("%s/format: must be a string", path)
+		}
+	}
+	if x, ok := obj["allOf"]; ok {
+		if n.allOf, err = c.compileAll(x, path+"/allOf", depth); err != nil {
+			return err
+		}
+	}
+	if x, ok := obj["anyOf"]; ok {
+		if n.anyOf, err = c.compileAll(x, path+"/anyOf", depth); err != nil {
+			return err
+		}
+	}
+	if x, ok := obj["oneOf"]; ok {
+		if n.oneOf, err = c.compileAll(x, path+"/oneOf", depth); err != nil {
+			return err
+		}
+	}
+	if x, ok := obj["not"]; ok {
+		if n.not, err = c.compile(x, path+"/not", depth); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type jsonSchemaValidator struct {
+	steps int
+	// violationPath is the document path of the last violation
+	violationPath string
+}
+
+func (v *jsonSchemaValidator) validate(n *jsonSchemaNode, doc interface{}, path string, depth int) error {
+	v.steps++
+	if v.steps > MaxJSONSchemaValidationSteps {
+		return errorsmod.Wrapf(ErrLimit, "max schema validation steps %d exceeded", MaxJSONSchemaValidationSteps)
+	}
+	if depth > maxJSONSchemaDepth {
+		return errorsmod.Wrapf(ErrLimit, "max schema validation depth %d exceeded", maxJSONSchemaDepth)
+	}
+	depth++
+	if n.ref != nil {
+		return v.validate(n.ref, doc, path, depth)
+	}
+	if n.never {
+		return v.violation(path, "not allowed")
+	}
+	if len(n.types) != 0 && !matchesJSONType(n.types, doc) {
+		return v.violation(path, "expected %s, got %s", strings.Join(n.types, " or "), jsonTypeOf(doc))
+	}
+	if n.hasConst && !jsonEqual(n.constVal, doc) {
+		return v.violation(path, "does not match the constant value")
+	}
+	if len(n.enum) != 0 {
+		var found bool
+		for _, e := range n.enum {
+			v.steps++
+			if found = jsonEqual(e, doc); found {
+				break
+			}
+		}
+		if !found {
+			return v.violation(path, "not one of the enum values")
+		}
+	}
+
+	switch x := doc.(type) {
+	case string:
+		l := utf8.RuneCountInString(x)
+		if n.minLength != nil && l < *n.minLength {
+			return v.violation(path, "shorter than %d characters", *n.minLength)
+		}
+		if n.maxLength != nil && l > *n.maxLength {
+			return v.violation(path, "longer than %d characters", *n.maxLength)
+		}
+	case *big.Rat:
+		if n.minimum != nil && x.Cmp(n.minimum) < 0 {
+			return v.violation(path, "less than %s", n.minimum.RatString())
+		}
+		if n.maximum != nil && x.Cmp(n.maximum) > 0 {
+			return v.violation(path, "greater than %s", n.maximum.RatString())
+		}
+		if n.exclusiveMinimum != nil && x.Cmp(n.exclusiveMinimum) <= 0 {
+			return v.violation(path, "less than or equal to %s", n.exclusiveMinimum.RatString())
+		}
+		if n.exclusiveMaximum != nil && x.Cmp(n.exclusiveMaximum) >= 0 {
+			return v.violation(path, "greater than or equal to %s", n.exclusiveMaximum.RatString())
+		}
+		if bounds, ok := jsonSchemaIntegerFormats[n.format]; ok {
+			if !x.IsInt() || x.Cmp(bounds[0]) < 0 || x.Cmp(bounds[1]) > 0 {
+				return v.violation(path, "not a valid %s", n.format)
+			}
+		}
+	case []interface{}:
+		if n.minItems != nil && len(x) < *n.minItems {
+			return v.violation(path, "less than %d items", *n.minItems)
+		}
+		if n.maxItems != nil && len(x) > *n.maxItems {
+			return v.violation(path, "more than %d items", *n.maxItems)
+		}
+		for i, e := range x {
+			itemSchema := n.items
+			if n.tupleItems != nil {
+				itemSchema = n.additionalItems
+				if i < len(n.tupleItems) {
+					itemSchema = n.tupleItems[i]
+				}
+			}
+			if itemSchema == nil {
+				continue
+			}
+			if err := v.validate(itemSchema, e, path+"/"+strconv.Itoa(i), depth); err != nil {
+				return err
+			}
+		}
+	case map[string]interface{}:
+		for _, k := range n.required {
+			if _, ok := x[k]; !ok {
+				return v.violation(path, "missing property %q", k)
+			}
+		}
+		for _, k := range sortedKeys(x) {
+			propSchema, ok := n.properties[k]
+			if !ok {
+				propSchema = n.additionalProperties
+			}
+			if propSchema == nil {
+				continue
+			}
+			if !ok && propSchema.never {
+				return v.violation(path, "unknown property %q", k)
+			}
+			if err := v.validate(propSchema, x[k], path+"/"+escapeJSONPointer(k), depth); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, s := range n.allOf {
+		if err := v.validate(s, doc, path, depth); err != nil {
+			return err
+		}
+	}
+	if len(n.anyOf) != 0 {
+		var matched bool
+		var mismatches []jsonSchemaMismatch
+		for _, s := range n.anyOf {
+			err := v.validate(s, doc, path, depth)
+			if ErrLimit.Is(err) {
+				return err
+			}
+			if matched = err == nil; matched {
+				break
+			}
+			mismatches = append(mismatches, jsonSchemaMismatch{err: err, path: v.violationPath})
+		}
+		if !matched {
+			return v.noMatch(path, mismatches)
+		}
+	}
+	if len(n.oneOf) != 0 {
+		var matches int
+		var mismatches []jsonSchemaMismatch
+		for _, s := range n.oneOf {
+			err := v.validate(s, doc, path, depth)
+			if ErrLimit.Is(err) {
+				return err
+			}
+			if err == nil {
+				matches++
+				continue
+			}
+			mismatches = append(mismatches, jsonSchemaMismatch{err: err, path: v.violationPath})
+		}
+		switch {
+		case matches == 0:
+			return v.noMatch(path, mismatches)
+		case matches > 1:
+			return v.violation(path, "matches more than one of the allowed schemas")
+		}
+	}
+	if n.not != nil {
+		err := v.validate(n.not, doc, path, depth)
+		if ErrLimit.Is(err) {
+			return err
+		}
+		if err == nil {
+			return v.violation(path, "matches a disallowed schema")
+		}
+	}
+	return nil
+}
+
+func (v *jsonSchemaValidator) violation(path string, format string, args ...interface{}) error {
+	v.violationPath = path
+	if path == "" {
+		path = "/"
+	}
+	return errorsmod.Wrapf(ErrSchemaValidation, "%s: %s", path, fmt.Sprintf(format, args...))
+}
+
+// jsonSchemaMismatch is the violation of one alternative of anyOf or oneOf
+type jsonSchemaMismatch struct {
+	err  error
+	path string
+}
+
+// noMatch returns the violation of the alternative that matched deepest into the document. This is the
+// relevant error for a message variant with a known name but invalid content, for example.
+func (v *jsonSchemaValidator) noMatch(path string, mismatches []jsonSchemaMismatch) error {
+	var deepest *jsonSchemaMismatch
+	depth := strings.Count(path, "/")
+	for i, m := range mismatches {
+		if d := strings.Count(m.path, "/"); d > depth {
+			deepest, depth = &mismatches[i], d
+		}
+	}
+	if deepest != nil {
+		v.violationPath = deepest.path
+		return deepest.err
+	}
+	return v.violation(path, "does not match any of the allowed schemas")
+}
+
+func escapeJSONPointer(s string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(s, "~", "~0"), "/", "~1")
+}
+
+func matchesJSONType(types []string, doc interface{}) bool {
+	actual := jsonTypeOf(doc)
+	for _, t := range types {
+		if t == actual || (t == "number" && actual == "integer") {
+			return true
+		}
+	}
+	return false
+}
+
+func jsonTypeOf(doc interface{}) string {
+	switch x := doc.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case *big.Rat:
+		if x.IsInt() {
+			return "integer"
+		}
+		return "number"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	default:
+		panic(fmt.Sprintf("unexpected json type: %T", doc))
+	}
+}
+
+func jsonEqual(a, b interface{}) bool {
+	switch x := a.(type) {
+	case nil:
+		return b == nil
+	case bool:
+		y, ok := b.(bool)
+		return ok && x == y
+	case string:
+		y, ok := b.(string)
+		return ok && x == y
+	case *big.Rat:
+		y, ok := b.(*big.Rat)
+		return ok && x.Cmp(y) == 0
+	case []interface{}:
+		y, ok := b.([]interface{})
+		if !ok || len(x) != len(y) {
+			return false
+		}
+		for i := range x {
+			if !jsonEqual(x[i], y[i]) {
+				return false
+			}
+		}
+		return true
+	case map[string]interface{}:
+		y, ok := b.(map[string]interface{})
+		if !ok || len(x) != len(y) {
+			return false
+		}
+		for k, xv := range x {
+			yv, ok := y[k]
+			if !ok || !jsonEqual(xv, yv) {
+				return false
+			}
+		}
+		return true
+	default:
+		return false
+	}
+}
+
+// decodeJSONNumbersAsRat parses a single JSON document. Numbers are decoded to big.Rat to compare them without
+// loss of precision.
+func decodeJSONNumbersAsRat(bz []byte) (interface{}, error) {
+	doc, err := decodeJSON(bz)
+	if err != nil {
+		return nil, err
+	}
+	return normalizeJSON(doc, 0)
+}
+
+func normalizeJSON(doc interface{}, depth int) (interface{}, error) {
+	if depth > maxJSONSchemaDepth {
+		return nil, fmt.Errorf("max depth %d exceeded", maxJSONSchemaDepth)
+	}
+	switch x := doc.(type) {
+	case json.Number:
+		return parseJSONNumber(x)
+	case []interface{}:
+		for i := range x {
+			var err error
+			if x[i], err = normalizeJSON(x[i], depth+1); err != nil {
+				return nil, err
+			}
+		}
+	case map[string]interface{}:
+		for _, k := range sortedKeys(x) {
+			v, err := normalizeJSON(x[k], depth+1)
+			if err != nil {
+				return nil, err
+			}
+			x[k] = v
+		}
+	}
+	return doc, nil
+}
+
+func parseJSONNumber(n json.Number) (*big.Rat, error) {
+	s := n.String()
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		exp, err := strconv.Atoi(s[i+1:])
+		if err != nil || exp > maxJSONNumberExponent || exp < -maxJSONNumberExponent {
+			return nil, fmt.Errorf("number out of range: %s", s)
+		}
+	}
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return nil, fmt.Errorf("invalid number: %s", s)
+	}
+	return r, nil
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,379 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// cw20ExecuteSchema is a shortened version of the execute message schema generated by cosmwasm-schema for cw20-base
+const cw20ExecuteSchema = `{
+  "$schema": "http://json-schema.org/draft-07/schema#",
+  "title": "ExecuteMsg",
+  "oneOf": [
+    {
+      "type": "object",
+      "required": ["transfer"],
+      "properties": {
+        "transfer": {
+          "type": "object",
+          "required": ["amount", "recipient"],
+          "properties": {
+            "amount": {"$ref": "#/definitions/Uint128"},
+            "recipient": {"type": "string"}
+          },
+          "additionalProperties": false
+        }
+      },
+      "additionalProperties": false
+    },
+    {
+      "type": "object",
+      "required": ["increase_allowance"],
+      "properties": {
+        "increase_allowance": {
+          "type": "object",
+          "required": ["amount", "spender"],
+          "properties": {
+            "amount": {"$ref": "#/definitions/Uint128"},
+            "expires": {
+              "anyOf": [{"$ref": "#/definitions/Expiration"}, {"type": "null"}]
+            },
+            "spender": {"type": "string"}
+          },
+          "additionalProperties": false
+        }
+      },
+      "additionalProperties": false
+    }
+  ],
+  "definitions": {
+    "Expiration": {
+      "oneOf": [
+        {
+          "type": "object",
+          "required": ["at_height"],
+          "properties": {"at_height": {"type": "integer", "format": "uint64", "minimum": 0.0}},
+          "additionalProperties": false
+        },
+        {
+          "type": "object",
+          "required": ["never"],
+          "properties": {"never": {"type": "object"}},
+          "additionalProperties": false
+        }
+      ]
+    },
+    "Uint128": {
+      "description": "A string containing a 128-bit integer in decimal representation.",
+      "type": "string"
+    }
+  }
+}`
+
+func TestCompileJSONSchema(t *testing.T) {
+	specs := map[string]struct {
+		src    string
+		expErr bool
+	}{
+		"cosmwasm-schema": {
+			src: cw20ExecuteSchema,
+		},
+		"true schema": {
+			src: `true`,
+		},
+		"empty object": {
+			src: `{}`,
+		},
+		"recursive reference": {
+			src: `{"definitions": {"Node": {"type": "object", "properties": {"next": {"$ref": "#/definitions/Node"}}}}, "$ref": "#/definitions/Node"}`,
+		},
+		"unknown keywords ignored": {
+			src: `{"title": "x", "pattern": "^a", "examples": [1]}`,
+		},
+		"invalid json": {
+			src:    `{"type": `,
+			expErr: true,
+		},
+		"not a schema": {
+			src:    `"string"`,
+			expErr: true,
+		},
+		"unknown type": {
+			src:    `{"type": "text"}`,
+			expErr: true,
+		},
+		"invalid type list": {
+			src:    `{"type": ["string", 1]}`,
+			expErr: true,
+		},
+		"unresolved reference": {
+			src:    `{"$ref": "#/definitions/Unknown"}`,
+			expErr: true,
+		},
+		"remote reference": {
+			src:    `{"$ref": "https://example.com/schema.json"}`,
+			expErr: true,
+		},
+		"empty enum": {
+			src:    `{"enum": []}`,
+			expErr: true,
+		},
+		"invalid required": {
+			src:    `{"required": "foo"}`,
+			expErr: true,
+		},
+		"invalid properties": {
+			src:    `{"properties": []}`,
+			expErr: true,
+		},
+		"invalid property schema": {
+			src:    `{"properties": {"foo": 1}}`,
+			expErr: true,
+		},
+		"negative min length": {
+			src:    `{"minLength": -1}`,
+			expErr: true,
+		},
+		"non integer max items": {
+			src:    `{"maxItems": 1.5}`,
+			expErr: true,
+		},
+		"non number minimum": {
+			src:    `{"minimum": "1"}`,
+			expErr: true,
+		},
+		"empty one of": {
+			src:    `{"oneOf": []}`,
+			expErr: true,
+		},
+		"exponent out of range": {
+			src:    `{"maximum": 1e1000000}`,
+			expErr: true,
+		},
+		"too deep": {
+			src:    strings.Repeat(`{"not": `, maxJSONSchemaDepth+1) + `{}` + strings.Repeat(`}`, maxJSONSchemaDepth+1),
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			_, gotErr := CompileJSONSchema([]byte(spec.src))
+			if spec.expErr {
+				assert.True(t, ErrInvalid.Is(gotErr), gotErr)
+				return
+			}
+			assert.NoError(t, gotErr)
+		})
+	}
+}
+
+func TestJSONSchemaValidate(t *testing.T) {
+	specs := map[string]struct {
+		schema string
+		src    string
+		expErr *errorsmod.Error
+	}{
+		"cosmwasm-schema - transfer": {
+			schema: cw20ExecuteSchema,
+			src:    `{"transfer": {"amount": "100", "recipient": "cosmos1..."}}`,
+		},
+		"cosmwasm-schema - optional expiration not set": {
+			schema: cw20ExecuteSchema,
+			src:    `{"increase_allowance": {"amount": "1", "spender": "cosmos1..."}}`,
+		},
+		"cosmwasm-schema - optional expiration null": {
+			schema: cw20ExecuteSchema,
+			src:    `{"increase_allowance": {"amount": "1", "spender": "cosmos1...", "expires": null}}`,
+		},
+		"cosmwasm-schema - expiration set": {
+			schema: cw20ExecuteSchema,
+			src:    `{"increase_allowance": {"amount": "1", "spender": "cosmos1...", "expires": {"at_height": 18446744073709551615}}}`,
+		},
+		"cosmwasm-schema - expiration exceeds uint64": {
+			schema: cw20ExecuteSchema,
+			src:    `{"increase_allowance": {"amount": "1", "spender": "cosmos1...", "expires": {"at_height": 18446744073709551616}}}`,
+			expErr: ErrSchemaValidation,
+		},
+		"cosmwasm-schema - amount as number": {
+			schema: cw20ExecuteSchema,
+			src:    `{"transfer": {"amount": 100, "recipient": "cosmos1..."}}`,
+			expErr: ErrSchemaValidation,
+		},
+		"cosmwasm-schema - missing recipient": {
+			schema: cw20ExecuteSchema,
+			src:    `{"transfer": {"amount": "100"}}`,
+			expErr: ErrSchemaValidation,
+		},
+		"cosmwasm-schema - unknown field": {
+			schema: cw20ExecuteSchema,
+			src:    `{"transfer": {"amount": "100", "recipient": "cosmos1...", "memo": "foo"}}`,
+			expErr: ErrSchemaValidation,
+		},
+		"cosmwasm-schema - unknown variant": {
+			schema: cw20ExecuteSchema,
+			src:    `{"burn": {"amount": "100"}}`,
+			expErr: ErrSchemaValidation,
+		},
+		"true schema": {
+			schema: `true`,
+			src:    `{"any": "thing"}`,
+		},
+		"false schema": {
+			schema: `false`,
+			src:    `{}`,
+			expErr: ErrSchemaValidation,
+		},
+		"type list": {
+			schema: `{"type": ["string", "null"]}`,
+			src:    `null`,
+		},
+		"integer is a number": {
+			schema: `{"type": "number"}`,
+			src:    `1`,
+		},
+		"integer with zero fraction": {
+			schema: `{"type": "integer"}`,
+			src:    `1.0`,
+		},
+		"number is not an integer": {
+			schema: `{"type": "integer"}`,
+			src:    `1.5`,
+			expErr: ErrSchemaValidation,
+		},
+		"enum": {
+			schema: `{"enum": ["a", 1, {"b": [true]}]}`,
+			src:    `{"b": [true]}`,
+		},
+		"not in enum": {
+			schema: `{"enum": ["a", 1]}`,
+			src:    `"b"`,
+			expErr: ErrSchemaValidation,
+		},
+		"const number": {
+			schema: `{"const": 100}`,
+			src:    `1e2`,
+		},
+		"min length in characters": {
+			schema: `{"type": "string", "minLength": 2, "maxLength": 2}`,
+			src:    `"äö"`,
+		},
+		"max length exceeded": {
+			schema: `{"type": "string", "maxLength": 2}`,
+			src:    `"abc"`,
+			expErr: ErrSchemaValidation,
+		},
+		"exclusive minimum": {
+			schema: `{"exclusiveMinimum": 0}`,
+			src:    `0`,
+			expErr: ErrSchemaValidation,
+		},
+		"maximum": {
+			schema: `{"maximum": 10}`,
+			src:    `10.5`,
+			expErr: ErrSchemaValidation,
+		},
+		"tuple": {
+			schema: `{"type": "array", "items": [{"type": "string"}, {"type": "integer"}], "additionalItems": false}`,
+			src:    `["a", 1]`,
+		},
+		"tuple additional items": {
+			schema: `{"type": "array", "items": [{"type": "string"}], "additionalItems": false}`,
+			src:    `["a", 1]`,
+			expErr: ErrSchemaValidation,
+		},
+		"array items": {
+			schema: `{"type": "array", "items": {"type": "string"}, "maxItems": 2}`,
+			src:    `["a", 1]`,
+			expErr: ErrSchemaValidation,
+		},
+		"max items": {
+			schema: `{"type": "array", "maxItems": 1}`,
+			src:    `[1, 2]`,
+			expErr: ErrSchemaValidation,
+		},
+		"additional properties schema": {
+			schema: `{"type": "object", "additionalProperties": {"type": "integer"}}`,
+			src:    `{"a": 1, "b": "2"}`,
+			expErr: ErrSchemaValidation,
+		},
+		"all of": {
+			schema: `{"allOf": [{"type": "integer"}, {"minimum": 5}]}`,
+			src:    `4`,
+			expErr: ErrSchemaValidation,
+		},
+		"one of matches many": {
+			schema: `{"oneOf": [{"type": "integer"}, {"minimum": 5}]}`,
+			src:    `6`,
+			expErr: ErrSchemaValidation,
+		},
+		"not": {
+			schema: `{"not": {"type": "null"}}`,
+			src:    `null`,
+			expErr: ErrSchemaValidation,
+		},
+		"recursive reference": {
+			schema: `{"definitions": {"Node": {"type": "object", "properties": {"next": {"$ref": "#/definitions/Node"}}, "additionalProperties": false}}, "$ref": "#/definitions/Node"}`,
+			src:    `{"next": {"next": {"other": 1}}}`,
+			expErr: ErrSchemaValidation,
+		},
+		"endless reference": {
+			schema: `{"$ref": "#"}`,
+			src:    `{}`,
+			expErr: ErrLimit,
+		},
+		"invalid json": {
+			schema: `true`,
+			src:    `{"foo": `,
+			expErr: ErrSchemaValidation,
+		},
+		"trailing data": {
+			schema: `true`,
+			src:    `{} {}`,
+			expErr: ErrSchemaValidation,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			schema, err := CompileJSONSchema([]byte(spec.schema))
+			require.NoError(t, err)
+			steps, gotErr := schema.Validate([]byte(spec.src))
+			if spec.expErr != nil {
+				assert.True(t, spec.expErr.Is(gotErr), gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Positive(t, steps)
+		})
+	}
+}
+
+func TestJSONSchemaValidateErrorPath(t *testing.T) {
+	schema, err := CompileJSONSchema([]byte(cw20ExecuteSchema))
+	require.NoError(t, err)
+	_, gotErr := schema.Validate([]byte(`{"increase_allowance": {"amount": 1, "spender": "cosmos1..."}}`))
+	require.Error(t, gotErr)
+	assert.Contains(t, gotErr.Error(), "/increase_allowance/amount: expected string, got integer")
+}
+
+func TestJSONSchemaValidateMaxSteps(t *testing.T) {
+	schema, err := CompileJSONSchema([]byte(`{"type": "array", "items": {"anyOf": [{"type": "string"}, {"type": "integer"}]}}`))
+	require.NoError(t, err)
+	items := make([]string, MaxJSONSchemaValidationSteps/2)
+	for i := range items {
+		items[i] = fmt.Sprint(i)
+	}
+	_, gotErr := schema.Validate([]byte("[" + strings.Join(items, ",") + "]"))
+	assert.True(t, ErrLimit.Is(gotErr), gotErr)
+
+	// and deterministic step count
+	steps1, err := schema.Validate([]byte(`[1, "a", 2]`))
+	require.NoError(t, err)
+	steps2, err := schema.Validate([]byte(`[1, "a", 2]`))
+	require.NoError(t, err)
+	assert.Equal(t, steps1, steps2)
+}
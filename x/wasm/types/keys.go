@@ -32,10 +32,23 @@ var (
 	PinnedCodeIndexPrefix                          = []byte{0x07}
 	TXCounterPrefix                                = []byte{0x08}
 	ContractsByCreatorPrefix                       = []byte{0x09}
+	CodeStargateQueryAllowlistPrefix               = []byte{0x0a}
+	ContractStargateQueryAllowlistPrefix           = []byte{0x0b}
+	ContractMetadataPrefix                         = []byte{0x0c}
+	ContractsByMetadataTagPrefix                   = []byte{0x0d}
+	ContractsByAdminPrefix                         = []byte{0x0e}
+	ContractsByLabelPrefix                         = []byte{0x0f}
 	ParamsKey                                      = []byte{0x10}
-
-	KeyLastCodeID     = append(SequenceKeyPrefix, []byte("lastCodeId")...)
-	KeyLastInstanceID = append(SequenceKeyPrefix, []byte("lastContractId")...)
+	ContractSchemaPrefix                           = []byte{0x11}
+	ContractsMigrationPrefix                       = []byte{0x12}
+	ContractMigrationResultPrefix                  = []byte{0x13}
+	PendingContractsMigrationPrefix                = []byte{0x14}
+	PendingAdminTransferPrefix                     = []byte{0x15}
+	PendingAdminTransfersByNewAdminPrefix          = []byte{0x16}
+
+	KeyLastCodeID               = append(SequenceKeyPrefix, []byte("lastCodeId")...)
+	KeyLastInstanceID           = append(SequenceKeyPrefix, []byte("lastContractId")...)
+	KeyLastContractsMigrationID = append(SequenceKeyPrefix, []byte("lastContractsMigrationId")...)
 )
 
 // GetCodeKey constructs the key for retreiving the ID for the WASM code
@@ -55,6 +68,65 @@ func GetContractsByCreatorPrefix(addr sdk.AccAddress) []byte {
 	return append(ContractsByCreatorPrefix, bz...)
 }
 
+// GetCodeStargateQueryAllowlistKey returns the key for the stargate query allowlist of a code
+func GetCodeStargateQueryAllowlistKey(codeID uint64) []byte {
+	return append(CodeStargateQueryAllowlistPrefix, sdk.Uint64ToBigEndian(codeID)...)
+}
+
+// GetContractSchemaKey returns the key for the JSON schema of the messages of a code
+func GetContractSchemaKey(codeID uint64) []byte {
+	return append(ContractSchemaPrefix, sdk.Uint64ToBigEndian(codeID)...)
+}
+
+// GetContractsMigrationKey returns the key for the record of a contracts migration
+func GetContractsMigrationKey(id uint64) []byte {
+	return append(ContractsMigrationPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetPendingContractsMigrationKey returns the key for the index of the contracts migrations in progress
+func GetPendingContractsMigrationKey(id uint64) []byte {
+	return append(PendingContractsMigrationPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetContractMigrationResultPrefix returns the prefix for the results of a contracts migration: `<prefix><id>`
+func GetContractMigrationResultPrefix(id uint64) []byte {
+	return append(ContractMigrationResultPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetContractMigrationResultKey returns the key for the result of a contract in a contracts migration:
+// `<prefix><id><contractAddr>`
+func GetContractMigrationResultKey(id uint64, contractAddr sdk.AccAddress) []byte {
+	return append(GetContractMigrationResultPrefix(id), contractAddr...)
+}
+
+// GetContractStargateQueryAllowlistKey returns the key for the stargate query allowlist of a contract
+func GetContractStargateQueryAllowlistKey(addr sdk.AccAddress) []byte {
+	return append(ContractStargateQueryAllowlistPrefix, addr...)
+}
+
+// GetContractMetadataKey returns the key for the metadata of a contract
+func GetContractMetadataKey(addr sdk.AccAddress) []byte {
+	return append(ContractMetadataPrefix, addr...)
+}
+
+// GetContractsByMetadataTagKeyPrefix returns the prefix of the tag index for all values of a tag key:
+// `<prefix><key length><key>`
+func GetContractsByMetadataTagKeyPrefix(key string) []byte {
+	return append(ContractsByMetadataTagPrefix, address.MustLengthPrefix([]byte(key))...)
+}
+
+// GetContractsByMetadataTagPrefix returns the prefix of the tag index for a tag key and value:
+// `<prefix><key length><key><value length><value>`
+func GetContractsByMetadataTagPrefix(key, value string) []byte {
+	return append(GetContractsByMetadataTagKeyPrefix(key), address.MustLengthPrefix([]byte(value))...)
+}
+
+// GetContractByMetadataTagSecondaryIndexKey returns the key for the tag index:
+// `<prefix><key length><key><value length><value><contractAddr>`
+func GetContractByMetadataTagSecondaryIndexKey(tag MetadataTag, contractAddr sdk.AccAddress) []byte {
+	return append(GetContractsByMetadataTagPrefix(tag.Key, tag.Value), contractAddr...)
+}
+
 // GetContractStorePrefix returns the store prefix for the WASM contract instance
 func GetContractStorePrefix(addr sdk.AccAddress) []byte {
 	return append(ContractStorePrefix, addr...)
@@ -96,6 +168,39 @@ func GetContractByCreatorSecondaryIndexKey(bz []byte, position []byte, contractA
 	return r
 }
 
+// GetContractsByAdminPrefix returns the contracts by admin prefix: `<prefix><adminAddress length><adminAddress>`
+func GetContractsByAdminPrefix(addr sdk.AccAddress) []byte {
+	return append(ContractsByAdminPrefix, address.MustLengthPrefix(addr)...)
+}
+
+// GetContractByAdminSecondaryIndexKey returns the key for the admin index: `<prefix><adminAddress length><adminAddress><contractAddr>`
+func GetContractByAdminSecondaryIndexKey(admin, contractAddr sdk.AccAddress) []byte {
+	return append(GetContractsByAdminPrefix(admin), contractAddr...)
+}
+
+// GetContractsByLabelPrefix returns the prefix for all contracts with a label that starts with the given prefix:
+// `<prefix><labelPrefix>`
+func GetContractsByLabelPrefix(labelPrefix string) []byte {
+	return append(ContractsByLabelPrefix, labelPrefix...)
+}
+
+// GetContractByLabelSecondaryIndexKey returns the key for the label index:
+// `<prefix><label><0x00><contractAddr><contractAddr length>`. The zero byte terminates the label so that shorter labels
+// are sorted first. The address length is appended so that the address can be read from the end of the variable
+// length key.
+func GetContractByLabelSecondaryIndexKey(label string, contractAddr sdk.AccAddress) []byte {
+	r := GetContractsByLabelPrefix(label)
+	r = append(r, 0)
+	r = append(r, contractAddr...)
+	return append(r, byte(len(contractAddr)))
+}
+
+// ParseContractByLabelSecondaryIndexKey returns the contract address from a label index key with or without prefix
+func ParseContractByLabelSecondaryIndexKey(key []byte) sdk.AccAddress {
+	addrLen := int(key[len(key)-1])
+	return key[len(key)-1-addrLen : len(key)-1]
+}
+
 // GetContractCodeHistoryElementKey returns the key a contract code history entry: `<prefix><contractAddr><position>`
 func GetContractCodeHistoryElementKey(contractAddr sdk.AccAddress, pos uint64) []byte {
 	prefix := GetContractCodeHistoryElementPrefix(contractAddr)
@@ -129,3 +234,20 @@ func GetPinnedCodeIndexPrefix(codeID uint64) []byte {
 func ParsePinnedCodeIndex(s []byte) uint64 {
 	return sdk.BigEndianToUint64(s)
 }
+
+// GetPendingAdminTransferKey returns the key of the pending admin transfer of a contract: `<prefix><contractAddr>`
+func GetPendingAdminTransferKey(contractAddr sdk.AccAddress) []byte {
+	return append(PendingAdminTransferPrefix, contractAddr...)
+}
+
+// GetPendingAdminTransfersByNewAdminPrefix returns the prefix of all pending admin transfers to the new admin:
+// `<prefix><newAdmin length><newAdmin>`
+func GetPendingAdminTransfersByNewAdminPrefix(newAdmin sdk.AccAddress) []byte {
+	return append(PendingAdminTransfersByNewAdminPrefix, address.MustLengthPrefix(newAdmin)...)
+}
+
+// GetPendingAdminTransferByNewAdminKey returns the key of the new admin index:
+// `<prefix><newAdmin length><newAdmin><contractAddr>`
+func GetPendingAdminTransferByNewAdminKey(newAdmin, contractAddr sdk.AccAddress) []byte {
+	return append(GetPendingAdminTransfersByNewAdminPrefix(newAdmin), contractAddr...)
+}
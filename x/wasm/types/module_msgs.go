@@ -0,0 +1,260 @@
+package types
+
+import (
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+)
+
+// Capabilities that contracts require to use the opt-in authz, feegrant, nft and group messages and queries.
+// They must only be added to the supported capabilities when the related keeper option is set.
+const (
+	CapabilityAuthz    = "authz"
+	CapabilityFeegrant = "feegrant"
+	CapabilityNFT      = "nft"
+	CapabilityGroup    = "group"
+)
+
+// ModuleMsg contains the opt-in messages for the authz, feegrant, nft and group modules.
+// They are sent by a contract as `custom` variant of the CosmosMsg. Exactly one field is set.
+// The top level keys are prefixed with `wasmd_` so that they do not collide with the custom
+// messages of a chain. Custom messages with other top level keys are passed to the custom encoder.
+type ModuleMsg struct {
+	Authz    *AuthzMsg    `json:"wasmd_authz,omitempty"`
+	Feegrant *FeegrantMsg `json:"wasmd_feegrant,omitempty"`
+	NFT      *NFTMsg      `json:"wasmd_nft,omitempty"`
+	Group    *GroupMsg    `json:"wasmd_group,omitempty"`
+}
+
+// AnyMsg is a protobuf message with type url and binary encoded value
+type AnyMsg struct {
+	TypeURL string `json:"type_url"`
+	Value   []byte `json:"value"`
+}
+
+// AuthzMsg is the authz message with the contract as granter or grantee
+type AuthzMsg struct {
+	// Exec executes the messages with the contract as grantee
+	Exec *AuthzExecMsg `json:"exec,omitempty"`
+	// Grant grants an authorization with the contract as granter
+	Grant *AuthzGrantMsg `json:"grant,omitempty"`
+	// Revoke revokes an authorization that was granted by the contract
+	Revoke *AuthzRevokeMsg `json:"revoke,omitempty"`
+}
+
+type AuthzExecMsg struct {
+	Msgs []AnyMsg `json:"msgs"`
+}
+
+type AuthzGrantMsg struct {
+	Grantee       string `json:"grantee"`
+	Authorization AnyMsg `json:"authorization"`
+	// Expiration as unix timestamp in seconds. Optional
+	Expiration *uint64 `json:"expiration,omitempty"`
+}
+
+type AuthzRevokeMsg struct {
+	Grantee    string `json:"grantee"`
+	MsgTypeURL string `json:"msg_type_url"`
+}
+
+// FeegrantMsg is the feegrant message with the contract as granter
+type FeegrantMsg struct {
+	// GrantAllowance grants a basic allowance
+	GrantAllowance *FeegrantGrantAllowanceMsg `json:"grant_allowance,omitempty"`
+	// RevokeAllowance revokes an allowance that was granted by the contract
+	RevokeAllowance *FeegrantRevokeAllowanceMsg `json:"revoke_allowance,omitempty"`
+}
+
+type FeegrantGrantAllowanceMsg struct {
+	Grantee string `json:"grantee"`
+	// SpendLimit is the max amount of fees the grantee can spend. Empty means no limit.
+	SpendLimit []wasmvmtypes.Coin `json:"spend_limit,omitempty"`
+	// Expiration as unix timestamp in seconds. Optional
+	Expiration *uint64 `json:"expiration,omitempty"`
+}
+
+type FeegrantRevokeAllowanceMsg struct {
+	Grantee string `json:"grantee"`
+}
+
+// NFTMsg is the nft message with the contract as sender
+type NFTMsg struct {
+	Send *NFTSendMsg `json:"send,omitempty"`
+}
+
+type NFTSendMsg struct {
+	ClassID  string `json:"class_id"`
+	ID       string `json:"id"`
+	Receiver string `json:"receiver"`
+}
+
+// GroupMsg is the group proposal message with the contract as proposer, voter or executor
+type GroupMsg struct {
+	SubmitProposal   *GroupSubmitProposalMsg   `json:"submit_proposal,omitempty"`
+	Vote             *GroupVoteMsg             `json:"vote,omitempty"`
+	Exec             *GroupExecMsg             `json:"exec,omitempty"`
+	WithdrawProposal *GroupWithdrawProposalMsg `json:"withdraw_proposal,omitempty"`
+}
+
+type GroupSubmitProposalMsg struct {
+	GroupPolicyAddress string   `json:"group_policy_address"`
+	Metadata           string   `json:"metadata,omitempty"`
+	Messages           []AnyMsg `json:"messages,omitempty"`
+	Title              string   `json:"title,omitempty"`
+	Summary            string   `json:"summary,omitempty"`
+	// TryExec executes the proposal immediately when the decision policy allows it
+	TryExec bool `json:"try_exec,omitempty"`
+}
+
+type GroupVoteMsg struct {
+	ProposalID uint64 `json:"proposal_id"`
+	// Option is one of "yes", "no", "abstain" or "no_with_veto"
+	Option   string `json:"option"`
+	Metadata string `json:"metadata,omitempty"`
+	// TryExec executes the proposal immediately when the decision policy allows it
+	TryExec bool `json:"try_exec,omitempty"`
+}
+
+type GroupExecMsg struct {
+	ProposalID uint64 `json:"proposal_id"`
+}
+
+type GroupWithdrawProposalMsg struct {
+	ProposalID uint64 `json:"proposal_id"`
+}
+
+// ModuleQuery contains the opt-in queries for the authz, feegrant, nft and group modules.
+// They are sent by a contract as `custom` variant of the QueryRequest. Exactly one field is set.
+// The top level keys are prefixed with `wasmd_` so that they do not collide with the custom
+// queries of a chain. Custom queries with other top level keys are passed to the custom querier.
+type ModuleQuery struct {
+	Authz    *AuthzQuery    `json:"wasmd_authz,omitempty"`
+	Feegrant *FeegrantQuery `json:"wasmd_feegrant,omitempty"`
+	NFT      *NFTQuery      `json:"wasmd_nft,omitempty"`
+	Group    *GroupQuery    `json:"wasmd_group,omitempty"`
+}
+
+type AuthzQuery struct {
+	Grant *AuthzGrantQuery `json:"grant,omitempty"`
+}
+
+type AuthzGrantQuery struct {
+	Granter    string `json:"granter"`
+	Grantee    string `json:"grantee"`
+	MsgTypeURL string `json:"msg_type_url"`
+}
+
+// AuthzGrantResponse returns the authorization. Nil when no grant exists or it has expired.
+type AuthzGrantResponse struct {
+	Authorization *AnyMsg `json:"authorization,omitempty"`
+	// Expiration as unix timestamp in seconds. Optional
+	Expiration *uint64 `json:"expiration,omitempty"`
+}
+
+type FeegrantQuery struct {
+	Allowance *FeegrantAllowanceQuery `json:"allowance,omitempty"`
+}
+
+type FeegrantAllowanceQuery struct {
+	Granter string `json:"granter"`
+	Grantee string `json:"grantee"`
+}
+
+// FeegrantAllowanceResponse returns the allowance. Nil when no allowance exists.
+type FeegrantAllowanceResponse struct {
+	Allowance *AnyMsg `json:"allowance,omitempty"`
+}
+
+type NFTQuery struct {
+	Owner   *NFTOwnerQuery   `json:"owner,omitempty"`
+	Balance *NFTBalanceQuery `json:"balance,omitempty"`
+	NFT     *NFTNFTQuery     `json:"nft,omitempty"`
+}
+
+type NFTOwnerQuery struct {
+	ClassID string `json:"class_id"`
+	ID      string `json:"id"`
+}
+
+// NFTOwnerResponse returns the owner. Empty when the nft does not exist.
+type NFTOwnerResponse struct {
+	Owner string `json:"owner"`
+}
+
+type NFTBalanceQuery struct {
+	ClassID string `json:"class_id"`
+	Owner   string `json:"owner"`
+}
+
+type NFTBalanceResponse struct {
+	Amount uint64 `json:"amount"`
+}
+
+type NFTNFTQuery struct {
+	ClassID string `json:"class_id"`
+	ID      string `json:"id"`
+}
+
+// NFTNFTResponse returns the nft. Nil when the nft does not exist.
+type NFTNFTResponse struct {
+	NFT *NFT `json:"nft,omitempty"`
+}
+
+type NFT struct {
+	ClassID string `json:"class_id"`
+	ID      string `json:"id"`
+	URI     string `json:"uri"`
+	URIHash string `json:"uri_hash"`
+}
+
+type GroupQuery struct {
+	Proposal *GroupProposalQuery `json:"proposal,omitempty"`
+	Vote     *GroupVoteQuery     `json:"vote,omitempty"`
+}
+
+type GroupProposalQuery struct {
+	ProposalID uint64 `json:"proposal_id"`
+}
+
+type GroupProposalResponse struct {
+	Proposal GroupProposal `json:"proposal"`
+}
+
+type GroupProposal struct {
+	ID                 uint64   `json:"id"`
+	GroupPolicyAddress string   `json:"group_policy_address"`
+	Metadata           string   `json:"metadata"`
+	Proposers          []string `json:"proposers"`
+	// Status as protobuf enum name, for example "PROPOSAL_STATUS_ACCEPTED"
+	Status string `json:"status"`
+	// ExecutorResult as protobuf enum name, for example "PROPOSAL_EXECUTOR_RESULT_SUCCESS"
+	ExecutorResult   string           `json:"executor_result"`
+	FinalTallyResult GroupTallyResult `json:"final_tally_result"`
+	// VotingPeriodEnd as unix timestamp in seconds
+	VotingPeriodEnd uint64 `json:"voting_period_end"`
+	Title           string `json:"title"`
+	Summary         string `json:"summary"`
+}
+
+type GroupTallyResult struct {
+	Yes        string `json:"yes"`
+	Abstain    string `json:"abstain"`
+	No         string `json:"no"`
+	NoWithVeto string `json:"no_with_veto"`
+}
+
+type GroupVoteQuery struct {
+	ProposalID uint64 `json:"proposal_id"`
+	Voter      string `json:"voter"`
+}
+
+type GroupVoteResponse struct {
+	Vote GroupVote `json:"vote"`
+}
+
+type GroupVote struct {
+	ProposalID uint64 `json:"proposal_id"`
+	Voter      string `json:"voter"`
+	// Option as protobuf enum name, for example "VOTE_OPTION_YES"
+	Option   string `json:"option"`
+	Metadata string `json:"metadata"`
+}
@@ -116,6 +116,41 @@ func (p Params) ValidateBasic() error {
 	if err := validateAccessConfig(p.CodeUploadAccess); err != nil {
 		return errors.Wrap(err, "upload access")
 	}
+	if err := p.AddressGenerator.ValidateBasic(); err != nil {
+		return errors.Wrap(err, "address generator")
+	}
+	return nil
+}
+
+const (
+	// AddressGeneratorPredictable derives the address from the code checksum, creator, salt and optional init message
+	AddressGeneratorPredictable = "predictable"
+	// AddressGeneratorDomainSeparated derives the address like AddressGeneratorPredictable but includes
+	// the domain separator so that only chains with the same domain generate the same addresses
+	AddressGeneratorDomainSeparated = "domain-separated"
+)
+
+// StrategyName returns the name of the selected strategy or the default
+func (a AddressGeneratorConfig) StrategyName() string {
+	if a.Strategy == "" {
+		return AddressGeneratorPredictable
+	}
+	return a.Strategy
+}
+
+// ValidateBasic performs basic validation. The keeper ensures that the strategy is registered.
+func (a AddressGeneratorConfig) ValidateBasic() error {
+	if len(a.Strategy) > MaxAddressGeneratorNameSize {
+		return ErrLimit.Wrapf("strategy cannot be longer than %d characters", MaxAddressGeneratorNameSize)
+	}
+	for _, c := range a.Strategy {
+		if (c < 'a' || c > 'z') && (c < '0' || c > '9') && c != '-' && c != '_' {
+			return ErrInvalid.Wrapf("strategy: unsupported character %q", c)
+		}
+	}
+	if len(a.Domain) > MaxAddressDomainSize {
+		return ErrLimit.Wrapf("domain cannot be longer than %d characters", MaxAddressDomainSize)
+	}
 	return nil
 }
 
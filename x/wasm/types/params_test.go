@@ -3,6 +3,7 @@ package types
 import (
 	"bytes"
 	"encoding/json"
+	"strings"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -145,6 +146,37 @@ func TestValidateParams(t *testing.T) {
 			},
 			expErr: true,
 		},
+		"all good with address generator": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				AddressGenerator:             AddressGeneratorConfig{Strategy: AddressGeneratorDomainSeparated, Domain: "my-domain"},
+			},
+		},
+		"reject address generator with invalid strategy name": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				AddressGenerator:             AddressGeneratorConfig{Strategy: "Domain Separated"},
+			},
+			expErr: true,
+		},
+		"reject address generator with strategy name exceeding limit": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				AddressGenerator:             AddressGeneratorConfig{Strategy: strings.Repeat("a", MaxAddressGeneratorNameSize+1)},
+			},
+			expErr: true,
+		},
+		"reject address generator with domain exceeding limit": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				AddressGenerator:             AddressGeneratorConfig{Strategy: AddressGeneratorDomainSeparated, Domain: strings.Repeat("a", MaxAddressDomainSize+1)},
+			},
+			expErr: true,
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -211,6 +243,16 @@ func TestParamsUnmarshalJson(t *testing.T) {
 				"instantiate_default_permission": "Everybody"}`,
 			exp: DefaultParams(),
 		},
+		"with address generator": {
+			src: `{"code_upload_access": {"permission": "Everybody"},
+				"instantiate_default_permission": "Everybody",
+				"address_generator": {"strategy": "domain-separated", "domain": "my-domain"}}`,
+			exp: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				AddressGenerator:             AddressGeneratorConfig{Strategy: AddressGeneratorDomainSeparated, Domain: "my-domain"},
+			},
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -0,0 +1,149 @@
+package types
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	responseTemplatePrefix = "{{responses."
+	responseTemplateSuffix = "}}"
+)
+
+// ResolveResponseTemplates replaces the response templates in the contract message with the response data of
+// earlier executions. Only whole JSON string values are replaced:
+//
+//	"{{responses.N}}" with the base64 encoded response data at index N
+//	"{{responses.N.path}}" with the JSON value at the dot separated path in the JSON response data at index N
+//
+// Messages without templates are returned unchanged. Gas is charged per byte of every decoded document.
+func ResolveResponseTemplates(ctx sdk.Context, msg RawContractMessage, responses [][]byte) (RawContractMessage, error) {
+	ctx.GasMeter().ConsumeGas(gasDeserializationCostPerByte*uint64(len(msg)), "response templates")
+	document, err := decodeJSON(msg)
+	if err != nil {
+		return nil, ErrInvalid.Wrap("payload msg: not a json document")
+	}
+	var replaced bool
+	document, err = walkResponseTemplates(document, func(idx int, path string) (interface{}, error) {
+		replaced = true
+		if idx >= len(responses) {
+			return nil, ErrInvalid.Wrapf("response %d not available", idx)
+		}
+		data := responses[idx]
+		if path == "" {
+			return base64.StdEncoding.EncodeToString(data), nil
+		}
+		ctx.GasMeter().ConsumeGas(gasDeserializationCostPerByte*uint64(len(data)), "response templates")
+		responseDoc, err := decodeJSON(data)
+		if err != nil {
+			return nil, ErrInvalid.Wrapf("response %d: not a json document", idx)
+		}
+		v, ok := lookupJSONPath(responseDoc, path)
+		if !ok {
+			return nil, ErrNotFound.Wrapf("response %d: path %q", idx, path)
+		}
+		return v, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !replaced {
+		return msg, nil
+	}
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(document); err != nil {
+		return nil, errorsmod.Wrap(ErrInvalid, err.Error())
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+// ValidateResponseTemplates ensures that the response templates in the contract message reference only
+// the given number of earlier executions.
+func ValidateResponseTemplates(msg RawContractMessage, available int) error {
+	document, err := decodeJSON(msg)
+	if err != nil {
+		return ErrInvalid.Wrap("not a json document")
+	}
+	_, err = walkResponseTemplates(document, func(idx int, _ string) (interface{}, error) {
+		if idx >= available {
+			return nil, ErrInvalid.Wrapf("response %d not available", idx)
+		}
+		return nil, nil
+	})
+	return err
+}
+
+// HasResponseTemplates returns true when the contract message contains any response template
+func HasResponseTemplates(msg RawContractMessage) (bool, error) {
+	document, err := decodeJSON(msg)
+	if err != nil {
+		return false, ErrInvalid.Wrap("not a json document")
+	}
+	var found bool
+	_, err = walkResponseTemplates(document, func(int, string) (interface{}, error) {
+		found = true
+		return nil, nil
+	})
+	return found, err
+}
+
+// walkResponseTemplates replaces all string values that are response templates with the resolved value.
+// Object keys are visited in sorted order to report errors deterministically.
+func walkResponseTemplates(node interface{}, resolve func(idx int, path string) (interface{}, error)) (interface{}, error) {
+	switch v := node.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			r, err := walkResponseTemplates(v[k], resolve)
+			if err != nil {
+				return nil, err
+			}
+			v[k] = r
+		}
+		return v, nil
+	case []interface{}:
+		for i, e := range v {
+			r, err := walkResponseTemplates(e, resolve)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = r
+		}
+		return v, nil
+	case string:
+		idx, path, ok := parseResponseTemplate(v)
+		if !ok {
+			return v, nil
+		}
+		return resolve(idx, path)
+	default:
+		return v, nil
+	}
+}
+
+// parseResponseTemplate returns the response index and optional json path of a response template
+func parseResponseTemplate(s string) (int, string, bool) {
+	if !strings.HasPrefix(s, responseTemplatePrefix) || !strings.HasSuffix(s, responseTemplateSuffix) ||
+		len(s) < len(responseTemplatePrefix)+len(responseTemplateSuffix) {
+		return 0, "", false
+	}
+	idxStr, path, _ := strings.Cut(s[len(responseTemplatePrefix):len(s)-len(responseTemplateSuffix)], ".")
+	idx, err := strconv.ParseUint(idxStr, 10, 16)
+	if err != nil {
+		return 0, "", false
+	}
+	return int(idx), path, true
+}
@@ -0,0 +1,76 @@
+package types
+
+import (
+	"testing"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveResponseTemplates(t *testing.T) {
+	responses := [][]byte{
+		[]byte(`{"token_id":"1","amount":123456789012345678901234567890,"nested":{"list":[{"a":true}]}}`),
+		{0xf0, 0x0b, 0xaa},
+	}
+	specs := map[string]struct {
+		msg    RawContractMessage
+		expMsg string
+		expErr *errorsmod.Error
+	}{
+		"no templates": {
+			msg:    RawContractMessage(`{"foo": {"bar": "<&>"}}`),
+			expMsg: `{"foo": {"bar": "<&>"}}`,
+		},
+		"raw response": {
+			msg:    RawContractMessage(`{"foo":"{{responses.1}}"}`),
+			expMsg: `{"foo":"8Auq"}`,
+		},
+		"json path": {
+			msg:    RawContractMessage(`{"foo":"{{responses.0.token_id}}","bar":["{{responses.0.amount}}"],"baz":"{{responses.0.nested.list.0}}"}`),
+			expMsg: `{"bar":[123456789012345678901234567890],"baz":{"a":true},"foo":"1"}`,
+		},
+		"json object": {
+			msg:    RawContractMessage(`{"foo":"{{responses.0.nested}}","other":"<&>"}`),
+			expMsg: `{"foo":{"list":[{"a":true}]},"other":"<&>"}`,
+		},
+		"template as part of a string not replaced": {
+			msg:    RawContractMessage(`{"foo":"id: {{responses.0.token_id}}"}`),
+			expMsg: `{"foo":"id: {{responses.0.token_id}}"}`,
+		},
+		"invalid index not replaced": {
+			msg:    RawContractMessage(`{"foo":"{{responses.x}}"}`),
+			expMsg: `{"foo":"{{responses.x}}"}`,
+		},
+		"response not available": {
+			msg:    RawContractMessage(`{"foo":"{{responses.2}}"}`),
+			expErr: ErrInvalid,
+		},
+		"unknown path": {
+			msg:    RawContractMessage(`{"foo":"{{responses.0.unknown}}"}`),
+			expErr: ErrNotFound,
+		},
+		"path into non json response": {
+			msg:    RawContractMessage(`{"foo":"{{responses.1.foo}}"}`),
+			expErr: ErrInvalid,
+		},
+		"non json msg": {
+			msg:    RawContractMessage(`not json`),
+			expErr: ErrInvalid,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx := sdk.Context{}.WithGasMeter(sdk.NewInfiniteGasMeter())
+			got, gotErr := ResolveResponseTemplates(ctx, spec.msg, responses)
+			assert.GreaterOrEqual(t, ctx.GasMeter().GasConsumed(), sdk.Gas(len(spec.msg)))
+			if spec.expErr != nil {
+				assert.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expMsg, string(got))
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+)
+
+// CapabilityStructuredSubMsgErrors is the capability that contracts require to receive the error of a failed
+// submessage in the reply as JSON encoded SubMsgError instead of the plain "codespace: <codespace>, code: <code>" text.
+const CapabilityStructuredSubMsgErrors = "structured_submsg_errors"
+
+// SubMsgError is the deterministic representation of a failed submessage that is passed to the reply.
+// The free-form error text is dropped as it is not guaranteed to be the same on all nodes.
+type SubMsgError struct {
+	// Codespace of the registered error, "undefined" for unregistered errors
+	Codespace string `json:"codespace"`
+	// Code of the registered error within the codespace
+	Code uint32 `json:"code"`
+	// SystemError contains the text of a wasmvm system error. System errors are created in x/wasm and deterministic.
+	SystemError string `json:"system_error,omitempty"`
+}
+
+// NewSubMsgError constructor
+func NewSubMsgError(err error) SubMsgError {
+	codespace, code, _ := errorsmod.ABCIInfo(err, false)
+	r := SubMsgError{Codespace: codespace, Code: code}
+	if sysErr := wasmvmtypes.ToSystemError(err); sysErr != nil {
+		r.SystemError = sysErr.Error()
+	}
+	return r
+}
+
+// String returns the plain text representation
+func (e SubMsgError) String() string {
+	return fmt.Sprintf("codespace: %s, code: %d", e.Codespace, e.Code)
+}
+
+// JSON returns the JSON encoded representation
+func (e SubMsgError) JSON() string {
+	bz, err := json.Marshal(e)
+	if err != nil {
+		panic(err) // can not fail for this type
+	}
+	return string(bz)
+}
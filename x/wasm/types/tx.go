@@ -75,6 +75,10 @@ func (msg MsgStoreCode) ValidateBasic() error {
 			return ErrInvalid.Wrap("unsupported type, use AccessTypeAnyOfAddresses instead")
 		}
 	}
+
+	if err := ValidateVerificationInfo(msg.Source, msg.Builder, msg.CodeHash); err != nil {
+		return errorsmod.Wrapf(err, "code verification info")
+	}
 	return nil
 }
 
@@ -190,6 +194,58 @@ func (msg MsgExecuteContract) GetContract() string {
 	return msg.Contract
 }
 
+func (msg MsgExecuteContracts) Route() string {
+	return RouterKey
+}
+
+func (msg MsgExecuteContracts) Type() string {
+	return "execute-contracts"
+}
+
+func (msg MsgExecuteContracts) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "sender")
+	}
+	if len(msg.Executions) == 0 {
+		return errorsmod.Wrap(ErrEmpty, "executions")
+	}
+	for i, e := range msg.Executions {
+		if err := e.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "execution %d", i)
+		}
+		if err := ValidateResponseTemplates(e.Msg, i); err != nil {
+			return errorsmod.Wrapf(err, "execution %d: response template", i)
+		}
+	}
+	return nil
+}
+
+func (msg MsgExecuteContracts) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgExecuteContracts) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{senderAddr}
+}
+
+// ValidateBasic validates a single execution
+func (e ContractExecution) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(e.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	if !e.Funds.IsValid() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "sentFunds")
+	}
+	if err := e.Msg.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "payload msg")
+	}
+	return nil
+}
+
 func (msg MsgMigrateContract) Route() string {
 	return RouterKey
 }
@@ -309,6 +365,105 @@ func (msg MsgClearAdmin) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{senderAddr}
 }
 
+func (msg MsgProposeAdmin) Route() string {
+	return RouterKey
+}
+
+func (msg MsgProposeAdmin) Type() string {
+	return "propose-contract-admin"
+}
+
+func (msg MsgProposeAdmin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.NewAdmin); err != nil {
+		return errorsmod.Wrap(err, "new admin")
+	}
+	if strings.EqualFold(msg.Sender, msg.NewAdmin) {
+		return errorsmod.Wrap(ErrInvalid, "new admin is the same as the old")
+	}
+	if msg.ExpiryBlocks > MaxAdminTransferExpiryBlocks {
+		return ErrLimit.Wrapf("expiry blocks cannot be greater than %d", MaxAdminTransferExpiryBlocks)
+	}
+	return nil
+}
+
+func (msg MsgProposeAdmin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgProposeAdmin) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{senderAddr}
+}
+
+func (msg MsgAcceptAdmin) Route() string {
+	return RouterKey
+}
+
+func (msg MsgAcceptAdmin) Type() string {
+	return "accept-contract-admin"
+}
+
+func (msg MsgAcceptAdmin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	return nil
+}
+
+func (msg MsgAcceptAdmin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgAcceptAdmin) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{senderAddr}
+}
+
+func (msg MsgCancelAdminTransfer) Route() string {
+	return RouterKey
+}
+
+func (msg MsgCancelAdminTransfer) Type() string {
+	return "cancel-contract-admin-transfer"
+}
+
+func (msg MsgCancelAdminTransfer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	return nil
+}
+
+func (msg MsgCancelAdminTransfer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCancelAdminTransfer) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{senderAddr}
+}
+
 func (msg MsgIBCSend) Route() string {
 	return RouterKey
 }
@@ -635,3 +790,205 @@ func (msg MsgStoreAndInstantiateContract) ValidateBasic() error {
 	}
 	return nil
 }
+
+func (msg MsgSetStargateQueryAllowlist) Route() string {
+	return RouterKey
+}
+
+func (msg MsgSetStargateQueryAllowlist) Type() string {
+	return "set-stargate-query-allowlist"
+}
+
+func (msg MsgSetStargateQueryAllowlist) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg MsgSetStargateQueryAllowlist) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSetStargateQueryAllowlist) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "authority")
+	}
+	if err := validateCodeIDOrContract(msg.CodeID, msg.Contract); err != nil {
+		return err
+	}
+	if len(msg.Paths) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty paths")
+	}
+	if err := ValidateStargateQueryPaths(msg.Paths); err != nil {
+		return errorsmod.Wrap(err, "paths")
+	}
+	return nil
+}
+
+func (msg MsgRemoveStargateQueryAllowlist) Route() string {
+	return RouterKey
+}
+
+func (msg MsgRemoveStargateQueryAllowlist) Type() string {
+	return "remove-stargate-query-allowlist"
+}
+
+func (msg MsgRemoveStargateQueryAllowlist) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg MsgRemoveStargateQueryAllowlist) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgRemoveStargateQueryAllowlist) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "authority")
+	}
+	return validateCodeIDOrContract(msg.CodeID, msg.Contract)
+}
+
+func (msg MsgUpdateContractMetadata) Route() string {
+	return RouterKey
+}
+
+func (msg MsgUpdateContractMetadata) Type() string {
+	return "update-contract-metadata"
+}
+
+func (msg MsgUpdateContractMetadata) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	if err := msg.Metadata.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "metadata")
+	}
+	return nil
+}
+
+func (msg MsgUpdateContractMetadata) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgUpdateContractMetadata) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{senderAddr}
+}
+
+func (msg MsgSetContractSchema) Route() string {
+	return RouterKey
+}
+
+func (msg MsgSetContractSchema) Type() string {
+	return "set-contract-schema"
+}
+
+func (msg MsgSetContractSchema) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "sender")
+	}
+	if msg.CodeID == 0 {
+		return errorsmod.Wrap(ErrEmpty, "code id")
+	}
+	if err := msg.Schema.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "schema")
+	}
+	return nil
+}
+
+func (msg MsgSetContractSchema) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSetContractSchema) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{senderAddr}
+}
+
+func (msg MsgMigrateContractsByCode) Route() string {
+	return RouterKey
+}
+
+func (msg MsgMigrateContractsByCode) Type() string {
+	return "migrate-contracts-by-code"
+}
+
+func (msg MsgMigrateContractsByCode) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "authority")
+	}
+	if msg.CodeID == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "code id is required")
+	}
+	if msg.NewCodeID == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "new code id is required")
+	}
+	if msg.CodeID == msg.NewCodeID {
+		return errorsmod.Wrap(ErrInvalid, "new code id must be different from code id")
+	}
+	if err := msg.Msg.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "payload msg")
+	}
+	if msg.BatchSize > MaxContractsMigrationBatchSize {
+		return ErrLimit.Wrapf("batch size cannot be greater than %d", MaxContractsMigrationBatchSize)
+	}
+	return nil
+}
+
+func (msg MsgMigrateContractsByCode) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgMigrateContractsByCode) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{authority}
+}
+
+// validateCodeIDOrContract ensures that exactly one of code id or contract address is set
+func validateCodeIDOrContract(codeID uint64, contract string) error {
+	switch {
+	case codeID == 0 && contract == "":
+		return errorsmod.Wrap(ErrEmpty, "code id or contract")
+	case codeID != 0 && contract != "":
+		return errorsmod.Wrap(ErrInvalid, "only one of code id or contract must be set")
+	case contract != "":
+		if _, err := sdk.AccAddressFromBech32(contract); err != nil {
+			return errorsmod.Wrap(err, "contract")
+		}
+	}
+	return nil
+}
+
+// ValidateStargateQueryPaths ensures that all paths are well-formed gRPC method paths without duplicates
+func ValidateStargateQueryPaths(paths []string) error {
+	unique := make(map[string]struct{}, len(paths))
+	for _, p := range paths {
+		parts := strings.Split(p, "/")
+		if len(parts) != 3 || parts[0] != "" || parts[1] == "" || parts[2] == "" {
+			return errorsmod.Wrapf(ErrInvalid, "path %q", p)
+		}
+		if _, exists := unique[p]; exists {
+			return errorsmod.Wrapf(ErrDuplicate, "path %q", p)
+		}
+		unique[p] = struct{}{}
+	}
+	return nil
+}
@@ -63,6 +63,34 @@ func TestStoreCodeValidation(t *testing.T) {
 			},
 			valid: false,
 		},
+		"with verification info": {
+			msg: MsgStoreCode{
+				Sender:       goodAddress,
+				WASMByteCode: []byte("foo"),
+				Source:       "https://example.com/",
+				Builder:      "cosmwasm/workspace-optimizer:v0.12.9",
+				CodeHash:     []byte{1},
+			},
+			valid: true,
+		},
+		"incomplete verification info": {
+			msg: MsgStoreCode{
+				Sender:       goodAddress,
+				WASMByteCode: []byte("foo"),
+				Source:       "https://example.com/",
+			},
+			valid: false,
+		},
+		"invalid builder": {
+			msg: MsgStoreCode{
+				Sender:       goodAddress,
+				WASMByteCode: []byte("foo"),
+				Source:       "https://example.com/",
+				Builder:      "-invalid-",
+				CodeHash:     []byte{1},
+			},
+			valid: false,
+		},
 	}
 
 	for name, tc := range cases {
@@ -430,6 +458,103 @@ func TestExecuteContractValidation(t *testing.T) {
 	}
 }
 
+func TestMsgExecuteContractsValidation(t *testing.T) {
+	bad, err := sdk.AccAddressFromHexUnsafe("012345")
+	require.NoError(t, err)
+	badAddress := bad.String()
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+
+	cases := map[string]struct {
+		msg   MsgExecuteContracts
+		valid bool
+	}{
+		"correct minimal": {
+			msg: MsgExecuteContracts{
+				Sender:     goodAddress,
+				Executions: []ContractExecution{{Contract: goodAddress, Msg: []byte("{}")}},
+			},
+			valid: true,
+		},
+		"correct all": {
+			msg: MsgExecuteContracts{
+				Sender: goodAddress,
+				Executions: []ContractExecution{
+					{Contract: goodAddress, Msg: []byte(`{"some": "data"}`), Funds: sdk.Coins{sdk.Coin{Denom: "foobar", Amount: sdk.NewInt(200)}}},
+					{Contract: goodAddress, Msg: []byte(`{"some": "{{responses.0.foo}}"}`)},
+				},
+			},
+			valid: true,
+		},
+		"empty": {
+			msg:   MsgExecuteContracts{},
+			valid: false,
+		},
+		"bad sender": {
+			msg: MsgExecuteContracts{
+				Sender:     badAddress,
+				Executions: []ContractExecution{{Contract: goodAddress, Msg: []byte("{}")}},
+			},
+			valid: false,
+		},
+		"empty executions": {
+			msg: MsgExecuteContracts{
+				Sender: goodAddress,
+			},
+			valid: false,
+		},
+		"bad contract": {
+			msg: MsgExecuteContracts{
+				Sender:     goodAddress,
+				Executions: []ContractExecution{{Contract: badAddress, Msg: []byte("{}")}},
+			},
+			valid: false,
+		},
+		"negative funds": {
+			msg: MsgExecuteContracts{
+				Sender:     goodAddress,
+				Executions: []ContractExecution{{Contract: goodAddress, Msg: []byte("{}"), Funds: sdk.Coins{sdk.Coin{Denom: "foobar", Amount: sdk.NewInt(-1)}}}},
+			},
+			valid: false,
+		},
+		"non json msg": {
+			msg: MsgExecuteContracts{
+				Sender:     goodAddress,
+				Executions: []ContractExecution{{Contract: goodAddress, Msg: []byte("invalid-json")}},
+			},
+			valid: false,
+		},
+		"template references own response": {
+			msg: MsgExecuteContracts{
+				Sender:     goodAddress,
+				Executions: []ContractExecution{{Contract: goodAddress, Msg: []byte(`{"some": "{{responses.0}}"}`)}},
+			},
+			valid: false,
+		},
+		"template references later response": {
+			msg: MsgExecuteContracts{
+				Sender: goodAddress,
+				Executions: []ContractExecution{
+					{Contract: goodAddress, Msg: []byte("{}")},
+					{Contract: goodAddress, Msg: []byte(`{"some": ["{{responses.2}}"]}`)},
+				},
+			},
+			valid: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
 func TestMsgUpdateAdministrator(t *testing.T) {
 	bad, err := sdk.AccAddressFromHexUnsafe("012345")
 	require.NoError(t, err)
@@ -553,6 +678,124 @@ func TestMsgClearAdministrator(t *testing.T) {
 	}
 }
 
+func TestMsgUpdateContractMetadata(t *testing.T) {
+	bad, err := sdk.AccAddressFromHexUnsafe("012345")
+	require.NoError(t, err)
+	badAddress := bad.String()
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	anotherGoodAddress := sdk.AccAddress(bytes.Repeat([]byte{0x2}, 20)).String()
+
+	specs := map[string]struct {
+		src    MsgUpdateContractMetadata
+		expErr bool
+	}{
+		"all good": {
+			src: MsgUpdateContractMetadata{
+				Sender:   goodAddress,
+				Contract: anotherGoodAddress,
+				Metadata: ContractMetadata{Description: "foo", Tags: []MetadataTag{{Key: "type", Value: "token"}}},
+			},
+		},
+		"empty metadata": {
+			src: MsgUpdateContractMetadata{
+				Sender:   goodAddress,
+				Contract: anotherGoodAddress,
+			},
+		},
+		"bad sender": {
+			src: MsgUpdateContractMetadata{
+				Sender:   badAddress,
+				Contract: anotherGoodAddress,
+			},
+			expErr: true,
+		},
+		"bad contract addr": {
+			src: MsgUpdateContractMetadata{
+				Sender:   goodAddress,
+				Contract: badAddress,
+			},
+			expErr: true,
+		},
+		"invalid metadata": {
+			src: MsgUpdateContractMetadata{
+				Sender:   goodAddress,
+				Contract: anotherGoodAddress,
+				Metadata: ContractMetadata{Website: "not an url"},
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgSetContractSchema(t *testing.T) {
+	bad, err := sdk.AccAddressFromHexUnsafe("012345")
+	require.NoError(t, err)
+	badAddress := bad.String()
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+
+	specs := map[string]struct {
+		src    MsgSetContractSchema
+		expErr bool
+	}{
+		"all good": {
+			src: MsgSetContractSchema{
+				Sender: goodAddress,
+				CodeID: 1,
+				Schema: ContractSchema{Execute: []byte(`{"type": "object"}`)},
+			},
+		},
+		"empty schema": {
+			src: MsgSetContractSchema{
+				Sender: goodAddress,
+				CodeID: 1,
+			},
+		},
+		"bad sender": {
+			src: MsgSetContractSchema{
+				Sender: badAddress,
+				CodeID: 1,
+			},
+			expErr: true,
+		},
+		"empty code id": {
+			src: MsgSetContractSchema{
+				Sender: goodAddress,
+			},
+			expErr: true,
+		},
+		"invalid schema": {
+			src: MsgSetContractSchema{
+				Sender: goodAddress,
+				CodeID: 1,
+				Schema: ContractSchema{Execute: []byte(`{"type": "text"}`)},
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestMsgMigrateContract(t *testing.T) {
 	bad, err := sdk.AccAddressFromHexUnsafe("012345")
 	require.NoError(t, err)
@@ -640,6 +883,246 @@ func TestMsgMigrateContract(t *testing.T) {
 	}
 }
 
+func TestMsgMigrateContractsByCodeValidation(t *testing.T) {
+	bad, err := sdk.AccAddressFromHexUnsafe("012345")
+	require.NoError(t, err)
+	badAddress := bad.String()
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+
+	specs := map[string]struct {
+		src    MsgMigrateContractsByCode
+		expErr bool
+	}{
+		"all good": {
+			src: MsgMigrateContractsByCode{
+				Authority: goodAddress,
+				CodeID:    firstCodeID,
+				NewCodeID: 2,
+				Msg:       []byte("{}"),
+			},
+		},
+		"max batch size": {
+			src: MsgMigrateContractsByCode{
+				Authority: goodAddress,
+				CodeID:    firstCodeID,
+				NewCodeID: 2,
+				Msg:       []byte("{}"),
+				BatchSize: MaxContractsMigrationBatchSize,
+			},
+		},
+		"batch size exceeds max": {
+			src: MsgMigrateContractsByCode{
+				Authority: goodAddress,
+				CodeID:    firstCodeID,
+				NewCodeID: 2,
+				Msg:       []byte("{}"),
+				BatchSize: MaxContractsMigrationBatchSize + 1,
+			},
+			expErr: true,
+		},
+		"bad authority": {
+			src: MsgMigrateContractsByCode{
+				Authority: badAddress,
+				CodeID:    firstCodeID,
+				NewCodeID: 2,
+				Msg:       []byte("{}"),
+			},
+			expErr: true,
+		},
+		"empty authority": {
+			src: MsgMigrateContractsByCode{
+				CodeID:    firstCodeID,
+				NewCodeID: 2,
+				Msg:       []byte("{}"),
+			},
+			expErr: true,
+		},
+		"empty code id": {
+			src: MsgMigrateContractsByCode{
+				Authority: goodAddress,
+				NewCodeID: 2,
+				Msg:       []byte("{}"),
+			},
+			expErr: true,
+		},
+		"empty new code id": {
+			src: MsgMigrateContractsByCode{
+				Authority: goodAddress,
+				CodeID:    firstCodeID,
+				Msg:       []byte("{}"),
+			},
+			expErr: true,
+		},
+		"same code ids": {
+			src: MsgMigrateContractsByCode{
+				Authority: goodAddress,
+				CodeID:    firstCodeID,
+				NewCodeID: firstCodeID,
+				Msg:       []byte("{}"),
+			},
+			expErr: true,
+		},
+		"non json msg": {
+			src: MsgMigrateContractsByCode{
+				Authority: goodAddress,
+				CodeID:    firstCodeID,
+				NewCodeID: 2,
+				Msg:       []byte("invalid json"),
+			},
+			expErr: true,
+		},
+		"empty msg": {
+			src: MsgMigrateContractsByCode{
+				Authority: goodAddress,
+				CodeID:    firstCodeID,
+				NewCodeID: 2,
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgProposeAdminValidation(t *testing.T) {
+	bad, err := sdk.AccAddressFromHexUnsafe("012345")
+	require.NoError(t, err)
+	badAddress := bad.String()
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	otherGoodAddress := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20)).String()
+	anotherGoodAddress := sdk.AccAddress(bytes.Repeat([]byte{0x2}, 20)).String()
+
+	specs := map[string]struct {
+		src    MsgProposeAdmin
+		expErr bool
+	}{
+		"all good": {
+			src: MsgProposeAdmin{
+				Sender:   goodAddress,
+				NewAdmin: otherGoodAddress,
+				Contract: anotherGoodAddress,
+			},
+		},
+		"max expiry": {
+			src: MsgProposeAdmin{
+				Sender:       goodAddress,
+				NewAdmin:     otherGoodAddress,
+				Contract:     anotherGoodAddress,
+				ExpiryBlocks: MaxAdminTransferExpiryBlocks,
+			},
+		},
+		"expiry exceeds max": {
+			src: MsgProposeAdmin{
+				Sender:       goodAddress,
+				NewAdmin:     otherGoodAddress,
+				Contract:     anotherGoodAddress,
+				ExpiryBlocks: MaxAdminTransferExpiryBlocks + 1,
+			},
+			expErr: true,
+		},
+		"bad sender": {
+			src: MsgProposeAdmin{
+				Sender:   badAddress,
+				NewAdmin: otherGoodAddress,
+				Contract: anotherGoodAddress,
+			},
+			expErr: true,
+		},
+		"bad new admin": {
+			src: MsgProposeAdmin{
+				Sender:   goodAddress,
+				NewAdmin: badAddress,
+				Contract: anotherGoodAddress,
+			},
+			expErr: true,
+		},
+		"bad contract addr": {
+			src: MsgProposeAdmin{
+				Sender:   goodAddress,
+				NewAdmin: otherGoodAddress,
+				Contract: badAddress,
+			},
+			expErr: true,
+		},
+		"new admin same as old admin": {
+			src: MsgProposeAdmin{
+				Sender:   goodAddress,
+				NewAdmin: goodAddress,
+				Contract: anotherGoodAddress,
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgAcceptAdminAndCancelAdminTransferValidation(t *testing.T) {
+	bad, err := sdk.AccAddressFromHexUnsafe("012345")
+	require.NoError(t, err)
+	badAddress := bad.String()
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	anotherGoodAddress := sdk.AccAddress(bytes.Repeat([]byte{0x2}, 20)).String()
+
+	specs := map[string]struct {
+		sender, contract string
+		expErr           bool
+	}{
+		"all good": {
+			sender:   goodAddress,
+			contract: anotherGoodAddress,
+		},
+		"bad sender": {
+			sender:   badAddress,
+			contract: anotherGoodAddress,
+			expErr:   true,
+		},
+		"empty sender": {
+			contract: anotherGoodAddress,
+			expErr:   true,
+		},
+		"bad contract addr": {
+			sender:   goodAddress,
+			contract: badAddress,
+			expErr:   true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			for _, src := range []sdk.Msg{
+				&MsgAcceptAdmin{Sender: spec.sender, Contract: spec.contract},
+				&MsgCancelAdminTransfer{Sender: spec.sender, Contract: spec.contract},
+			} {
+				err := src.ValidateBasic()
+				if spec.expErr {
+					require.Error(t, err)
+					continue
+				}
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestMsgJsonSignBytes(t *testing.T) {
 	const myInnerMsg = `{"foo":"bar"}`
 	specs := map[string]struct {
@@ -1137,3 +1620,139 @@ func TestMsgStoreAndInstantiateContractValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgSetStargateQueryAllowlistValidation(t *testing.T) {
+	bad, err := sdk.AccAddressFromHexUnsafe("012345")
+	require.NoError(t, err)
+	badAddress := bad.String()
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	const myPath = "/cosmos.bank.v1beta1.Query/Balance"
+
+	specs := map[string]struct {
+		src    MsgSetStargateQueryAllowlist
+		expErr bool
+	}{
+		"all good with code id": {
+			src: MsgSetStargateQueryAllowlist{
+				Authority: goodAddress,
+				CodeID:    1,
+				Paths:     []string{myPath},
+			},
+		},
+		"all good with contract": {
+			src: MsgSetStargateQueryAllowlist{
+				Authority: goodAddress,
+				Contract:  goodAddress,
+				Paths:     []string{myPath, "/cosmos.bank.v1beta1.Query/AllBalances"},
+			},
+		},
+		"bad authority": {
+			src: MsgSetStargateQueryAllowlist{
+				Authority: badAddress,
+				CodeID:    1,
+				Paths:     []string{myPath},
+			},
+			expErr: true,
+		},
+		"code id and contract empty": {
+			src: MsgSetStargateQueryAllowlist{
+				Authority: goodAddress,
+				Paths:     []string{myPath},
+			},
+			expErr: true,
+		},
+		"code id and contract set": {
+			src: MsgSetStargateQueryAllowlist{
+				Authority: goodAddress,
+				CodeID:    1,
+				Contract:  goodAddress,
+				Paths:     []string{myPath},
+			},
+			expErr: true,
+		},
+		"bad contract": {
+			src: MsgSetStargateQueryAllowlist{
+				Authority: goodAddress,
+				Contract:  badAddress,
+				Paths:     []string{myPath},
+			},
+			expErr: true,
+		},
+		"empty paths": {
+			src: MsgSetStargateQueryAllowlist{
+				Authority: goodAddress,
+				CodeID:    1,
+			},
+			expErr: true,
+		},
+		"malformed path": {
+			src: MsgSetStargateQueryAllowlist{
+				Authority: goodAddress,
+				CodeID:    1,
+				Paths:     []string{"cosmos.bank.v1beta1.Query/Balance"},
+			},
+			expErr: true,
+		},
+		"duplicate paths": {
+			src: MsgSetStargateQueryAllowlist{
+				Authority: goodAddress,
+				CodeID:    1,
+				Paths:     []string{myPath, myPath},
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgRemoveStargateQueryAllowlistValidation(t *testing.T) {
+	bad, err := sdk.AccAddressFromHexUnsafe("012345")
+	require.NoError(t, err)
+	badAddress := bad.String()
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+
+	specs := map[string]struct {
+		src    MsgRemoveStargateQueryAllowlist
+		expErr bool
+	}{
+		"all good with code id": {
+			src: MsgRemoveStargateQueryAllowlist{Authority: goodAddress, CodeID: 1},
+		},
+		"all good with contract": {
+			src: MsgRemoveStargateQueryAllowlist{Authority: goodAddress, Contract: goodAddress},
+		},
+		"bad authority": {
+			src:    MsgRemoveStargateQueryAllowlist{Authority: badAddress, CodeID: 1},
+			expErr: true,
+		},
+		"code id and contract empty": {
+			src:    MsgRemoveStargateQueryAllowlist{Authority: goodAddress},
+			expErr: true,
+		},
+		"code id and contract set": {
+			src:    MsgRemoveStargateQueryAllowlist{Authority: goodAddress, CodeID: 1, Contract: goodAddress},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
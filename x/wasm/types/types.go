@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"reflect"
+	"strings"
 
 	errorsmod "cosmossdk.io/errors"
 	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
@@ -40,6 +41,11 @@ func (c CodeInfo) ValidateBasic() error {
 	if err := c.InstantiateConfig.ValidateBasic(); err != nil {
 		return errorsmod.Wrap(err, "instantiate config")
 	}
+	if len(c.Source) != 0 || len(c.Builder) != 0 {
+		if err := ValidateVerificationInfo(c.Source, c.Builder, c.CodeHash); err != nil {
+			return errorsmod.Wrap(err, "code verification info")
+		}
+	}
 	return nil
 }
 
@@ -52,6 +58,67 @@ func NewCodeInfo(codeHash []byte, creator sdk.AccAddress, instantiatePermission
 	}
 }
 
+// contractEntryPoints are the entry points a contract can export
+var contractEntryPoints = []string{
+	"instantiate",
+	"execute",
+	"query",
+	"migrate",
+	"sudo",
+	"reply",
+	"ibc_channel_open",
+	"ibc_channel_connect",
+	"ibc_channel_close",
+	"ibc_packet_receive",
+	"ibc_packet_ack",
+	"ibc_packet_timeout",
+}
+
+// NewCodeAnalysis creates the analysis report from the vm report and the names of the functions that are exported by the code
+func NewCodeAnalysis(report wasmvmtypes.AnalysisReport, exportedFunctions []string) CodeAnalysis {
+	var capabilities []string
+	for _, c := range strings.Split(report.RequiredCapabilities, ",") {
+		if c = strings.TrimSpace(c); c != "" {
+			capabilities = append(capabilities, c)
+		}
+	}
+	exported := make(map[string]struct{}, len(exportedFunctions))
+	for _, f := range exportedFunctions {
+		exported[f] = struct{}{}
+	}
+	var entryPoints []string
+	for _, e := range contractEntryPoints {
+		if _, ok := exported[e]; ok {
+			entryPoints = append(entryPoints, e)
+		}
+	}
+	return CodeAnalysis{
+		HasIBCEntryPoints:    report.HasIBCEntryPoints,
+		RequiredCapabilities: capabilities,
+		EntryPoints:          entryPoints,
+	}
+}
+
+// RequiresCapability returns true when the code requires the given capability
+func (a CodeAnalysis) RequiresCapability(capability string) bool {
+	for _, c := range a.RequiredCapabilities {
+		if c == capability {
+			return true
+		}
+	}
+	return false
+}
+
+// HasEntryPoint returns true when the code exports the given entry point
+func (a CodeAnalysis) HasEntryPoint(entryPoint string) bool {
+	for _, e := range a.EntryPoints {
+		if e == entryPoint {
+			return true
+		}
+	}
+	return false
+}
+
 var AllCodeHistoryTypes = []ContractCodeHistoryOperationType{ContractCodeHistoryOperationTypeGenesis, ContractCodeHistoryOperationTypeInit, ContractCodeHistoryOperationTypeMigrate}
 
 // NewContractInfo creates a new instance of a given WASM contract info
@@ -106,6 +173,97 @@ func (c *ContractInfo) ValidateBasic() error {
 	return nil
 }
 
+// ValidateBasic does syntax checks on the data
+func (m ContractMetadata) ValidateBasic() error {
+	if len(m.Description) > MaxMetadataDescriptionSize {
+		return errorsmod.Wrapf(ErrLimit, "description cannot be longer than %d characters", MaxMetadataDescriptionSize)
+	}
+	if err := ValidateMetadataURL(m.Website); err != nil {
+		return errorsmod.Wrap(err, "website")
+	}
+	if err := ValidateMetadataURL(m.SchemaURL); err != nil {
+		return errorsmod.Wrap(err, "schema url")
+	}
+	if err := ValidateMetadataURL(m.Icon); err != nil {
+		return errorsmod.Wrap(err, "icon")
+	}
+	if len(m.Tags) > MaxMetadataTags {
+		return errorsmod.Wrapf(ErrLimit, "cannot have more than %d tags", MaxMetadataTags)
+	}
+	unique := make(map[string]struct{}, len(m.Tags))
+	for i, t := range m.Tags {
+		if err := t.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "tag %d", i)
+		}
+		if _, exists := unique[t.Key]; exists {
+			return errorsmod.Wrapf(ErrDuplicate, "tag key %q", t.Key)
+		}
+		unique[t.Key] = struct{}{}
+	}
+	return nil
+}
+
+// IsEmpty returns true when no field is set
+func (m ContractMetadata) IsEmpty() bool {
+	return m.Description == "" && m.Website == "" && m.SchemaURL == "" && m.Icon == "" && len(m.Tags) == 0
+}
+
+// ValidateBasic does syntax checks on the data. Each set schema must be a valid JSON schema.
+func (s ContractSchema) ValidateBasic() error {
+	for _, v := range []struct {
+		name   string
+		schema RawContractMessage
+	}{
+		{"instantiate", s.Instantiate}, {"execute", s.Execute}, {"query", s.Query}, {"migrate", s.Migrate},
+	} {
+		if !isSetSchema(v.schema) {
+			continue
+		}
+		if len(v.schema) > MaxContractSchemaSize {
+			return errorsmod.Wrapf(ErrLimit, "%s cannot be longer than %d bytes", v.name, MaxContractSchemaSize)
+		}
+		if _, err := CompileJSONSchema(v.schema); err != nil {
+			return errorsmod.Wrap(err, v.name)
+		}
+	}
+	return nil
+}
+
+// IsEmpty returns true when no schema is set
+func (s ContractSchema) IsEmpty() bool {
+	return !isSetSchema(s.Instantiate) && !isSetSchema(s.Execute) && !isSetSchema(s.Query) && !isSetSchema(s.Migrate)
+}
+
+// Normalize returns a copy where the schemas that are not set are empty. An unset schema is represented as
+// `null` in JSON.
+func (s ContractSchema) Normalize() ContractSchema {
+	for _, r := range []*RawContractMessage{&s.Instantiate, &s.Execute, &s.Query, &s.Migrate} {
+		if !isSetSchema(*r) {
+			*r = nil
+		}
+	}
+	return s
+}
+
+// isSetSchema returns false for an empty schema or the `null` JSON value
+func isSetSchema(r RawContractMessage) bool {
+	return len(r) != 0 && string(r) != "null"
+}
+
+// ValidateBasic does syntax checks on the data
+func (t MetadataTag) ValidateBasic() error {
+	if t.Key == "" {
+		return errorsmod.Wrap(ErrEmpty, "key")
+	}
+	if len(t.Key) > MaxMetadataTagKeySize {
+		return errorsmod.Wrapf(ErrLimit, "key cannot be longer than %d characters", MaxMetadataTagKeySize)
+	}
+	if len(t.Value) > MaxMetadataTagValueSize {
+		return errorsmod.Wrapf(ErrLimit, "value cannot be longer than %d characters", MaxMetadataTagValueSize)
+	}
+	return nil
+}
+
 // SetExtension set new extension data. Calls `ValidateBasic() error` on non nil values when method is implemented by
 // the extension.
 func (c *ContractInfo) SetExtension(ext ContractInfoExtension) error {
@@ -435,3 +593,67 @@ func (a AccessConfig) AllAuthorizedAddresses() []string {
 	}
 	return []string{}
 }
+
+// ValidateBasic syntax checks
+func (m ContractsMigration) ValidateBasic() error {
+	if m.ID == 0 {
+		return errorsmod.Wrap(ErrEmpty, "id")
+	}
+	if m.CodeID == 0 {
+		return errorsmod.Wrap(ErrEmpty, "code id")
+	}
+	if m.NewCodeID == 0 {
+		return errorsmod.Wrap(ErrEmpty, "new code id")
+	}
+	if m.CodeID == m.NewCodeID {
+		return errorsmod.Wrap(ErrInvalid, "new code id must be different from code id")
+	}
+	if err := m.Msg.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "msg")
+	}
+	if m.BatchSize == 0 || m.BatchSize > MaxContractsMigrationBatchSize {
+		return errorsmod.Wrapf(ErrInvalid, "batch size must be between 1 and %d", MaxContractsMigrationBatchSize)
+	}
+	if m.EndHeight != 0 && m.EndHeight < m.StartHeight {
+		return errorsmod.Wrap(ErrInvalid, "end height before start height")
+	}
+	return nil
+}
+
+// IsDone returns true when all contracts were processed
+func (m ContractsMigration) IsDone() bool {
+	return m.EndHeight != 0
+}
+
+// ValidateBasic syntax checks
+func (r ContractMigrationResult) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(r.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	if r.Success && r.Error != "" {
+		return errorsmod.Wrap(ErrInvalid, "error set on success")
+	}
+	return nil
+}
+
+// ValidateBasic syntax checks
+func (p PendingAdminTransfer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(p.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	if _, err := sdk.AccAddressFromBech32(p.NewAdmin); err != nil {
+		return errorsmod.Wrap(err, "new admin")
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Proposer); err != nil {
+		return errorsmod.Wrap(err, "proposer")
+	}
+	if p.ExpiryHeight <= 0 {
+		return errorsmod.Wrap(ErrInvalid, "expiry height")
+	}
+	return nil
+}
+
+// IsExpired returns true when the transfer can not be accepted at the given height anymore
+func (p PendingAdminTransfer) IsExpired(height int64) bool {
+	return height > p.ExpiryHeight
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"strings"
 	"testing"
 	"time"
@@ -124,6 +125,166 @@ func TestCodeInfoValidateBasic(t *testing.T) {
 	}
 }
 
+func TestContractMetadataValidateBasic(t *testing.T) {
+	specs := map[string]struct {
+		src    ContractMetadata
+		expErr bool
+	}{
+		"all good": {
+			src: ContractMetadata{
+				Description: "my contract",
+				Website:     "https://example.com",
+				SchemaURL:   "https://example.com/schema.json",
+				Icon:        "ipfs://bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi",
+				Tags:        []MetadataTag{{Key: "type", Value: "token"}, {Key: "audited"}},
+			},
+		},
+		"empty": {},
+		"description too long": {
+			src:    ContractMetadata{Description: strings.Repeat("a", MaxMetadataDescriptionSize+1)},
+			expErr: true,
+		},
+		"invalid website": {
+			src:    ContractMetadata{Website: "example.com"},
+			expErr: true,
+		},
+		"schema url too long": {
+			src:    ContractMetadata{SchemaURL: "https://example.com/" + strings.Repeat("a", MaxMetadataURLSize)},
+			expErr: true,
+		},
+		"invalid icon": {
+			src:    ContractMetadata{Icon: "icon.png"},
+			expErr: true,
+		},
+		"too many tags": {
+			src: func() ContractMetadata {
+				var m ContractMetadata
+				for i := 0; i <= MaxMetadataTags; i++ {
+					m.Tags = append(m.Tags, MetadataTag{Key: fmt.Sprintf("key%d", i)})
+				}
+				return m
+			}(),
+			expErr: true,
+		},
+		"duplicate tag keys": {
+			src:    ContractMetadata{Tags: []MetadataTag{{Key: "type", Value: "token"}, {Key: "type", Value: "dex"}}},
+			expErr: true,
+		},
+		"empty tag key": {
+			src:    ContractMetadata{Tags: []MetadataTag{{Value: "token"}}},
+			expErr: true,
+		},
+		"tag key too long": {
+			src:    ContractMetadata{Tags: []MetadataTag{{Key: strings.Repeat("a", MaxMetadataTagKeySize+1)}}},
+			expErr: true,
+		},
+		"tag value too long": {
+			src:    ContractMetadata{Tags: []MetadataTag{{Key: "type", Value: strings.Repeat("a", MaxMetadataTagValueSize+1)}}},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotErr := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+}
+
+func TestContractSchemaValidateBasic(t *testing.T) {
+	specs := map[string]struct {
+		src    ContractSchema
+		expErr bool
+	}{
+		"all good": {
+			src: ContractSchema{
+				Instantiate: []byte(`{"type": "object"}`),
+				Execute:     []byte(`{"oneOf": [{"type": "object", "required": ["release"]}]}`),
+				Query:       []byte(`true`),
+				Migrate:     []byte(`{"definitions": {"Addr": {"type": "string"}}, "$ref": "#/definitions/Addr"}`),
+			},
+		},
+		"empty": {},
+		"partial": {
+			src: ContractSchema{Execute: []byte(`{"type": "object"}`)},
+		},
+		"null schemas": {
+			src: ContractSchema{Instantiate: []byte(`null`), Execute: []byte(`{"type": "object"}`)},
+		},
+		"invalid instantiate": {
+			src:    ContractSchema{Instantiate: []byte(`{"type": "text"}`)},
+			expErr: true,
+		},
+		"invalid execute": {
+			src:    ContractSchema{Execute: []byte(`{"$ref": "#/definitions/Unknown"}`)},
+			expErr: true,
+		},
+		"invalid query": {
+			src:    ContractSchema{Query: []byte(`"foo"`)},
+			expErr: true,
+		},
+		"invalid migrate": {
+			src:    ContractSchema{Migrate: []byte(`{"type": `)},
+			expErr: true,
+		},
+		"schema too big": {
+			src:    ContractSchema{Execute: []byte(`{"description": "` + strings.Repeat("a", MaxContractSchemaSize) + `"}`)},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotErr := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+}
+
+func TestContractSchemaNormalize(t *testing.T) {
+	src := ContractSchema{Instantiate: []byte(`null`), Execute: []byte(`{"type": "object"}`), Query: []byte{}}
+	got := src.Normalize()
+	assert.Equal(t, ContractSchema{Execute: []byte(`{"type": "object"}`)}, got)
+	assert.True(t, ContractSchema{Instantiate: []byte(`null`)}.IsEmpty())
+	assert.False(t, got.IsEmpty())
+}
+
+func TestNewCodeAnalysis(t *testing.T) {
+	specs := map[string]struct {
+		srcReport  wasmvmtypes.AnalysisReport
+		srcExports []string
+		exp        CodeAnalysis
+	}{
+		"empty": {},
+		"capabilities trimmed": {
+			srcReport: wasmvmtypes.AnalysisReport{RequiredCapabilities: "iterator, staking,,stargate "},
+			exp:       CodeAnalysis{RequiredCapabilities: []string{"iterator", "staking", "stargate"}},
+		},
+		"ibc entry points": {
+			srcReport:  wasmvmtypes.AnalysisReport{HasIBCEntryPoints: true},
+			srcExports: []string{"ibc_channel_open", "ibc_packet_receive"},
+			exp:        CodeAnalysis{HasIBCEntryPoints: true, EntryPoints: []string{"ibc_channel_open", "ibc_packet_receive"}},
+		},
+		"only entry points in well known order": {
+			srcExports: []string{"sudo", "allocate", "migrate", "interface_version_8", "instantiate"},
+			exp:        CodeAnalysis{EntryPoints: []string{"instantiate", "migrate", "sudo"}},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			got := NewCodeAnalysis(spec.srcReport, spec.srcExports)
+			assert.Equal(t, spec.exp, got)
+		})
+	}
+}
+
 func TestContractInfoSetExtension(t *testing.T) {
 	anyTime := time.Now().UTC()
 	aNestedProtobufExt := func() ContractInfoExtension {
@@ -20,8 +20,73 @@ var (
 
 	// MaxProposalWasmSize is the largest a gov proposal compiled contract code can be when storing code on chain
 	MaxProposalWasmSize = 3 * 1024 * 1024 // extension point for chains to customize via compile flag.
+
+	// MaxMetadataDescriptionSize is the longest description that can be set in the contract metadata
+	MaxMetadataDescriptionSize = 1024 // extension point for chains to customize via compile flag.
+
+	// MaxMetadataURLSize is the longest website, schema or icon url that can be set in the contract metadata
+	MaxMetadataURLSize = 256 // extension point for chains to customize via compile flag.
+
+	// MaxMetadataTags is the max number of tags that can be set in the contract metadata
+	MaxMetadataTags = 16 // extension point for chains to customize via compile flag.
+
+	// MaxMetadataTagKeySize is the longest tag key that can be set in the contract metadata
+	MaxMetadataTagKeySize = 64 // extension point for chains to customize via compile flag. Must not exceed 255.
+
+	// MaxMetadataTagValueSize is the longest tag value that can be set in the contract metadata
+	MaxMetadataTagValueSize = 128 // extension point for chains to customize via compile flag. Must not exceed 255.
+
+	// MaxContractSchemaSize is the largest a JSON schema of a single contract message type can be
+	MaxContractSchemaSize = 64 * 1024 // extension point for chains to customize via compile flag.
+
+	// MaxJSONSchemaValidationSteps is the max number of steps a contract message validation can take
+	MaxJSONSchemaValidationSteps = 100_000 // extension point for chains to customize via compile flag.
+
+	// MaxAddressDomainSize is the longest domain separator that can be set for the address generator
+	MaxAddressDomainSize = 128 // extension point for chains to customize via compile flag.
+
+	// MaxContractsMigrationBatchSize is the max number of contracts that can be migrated per block by a contracts migration
+	MaxContractsMigrationBatchSize uint32 = 500 // extension point for chains to customize via compile flag.
+
+	// DefaultContractsMigrationBatchSize is the number of contracts migrated per block when not set in the message
+	DefaultContractsMigrationBatchSize uint32 = 50 // extension point for chains to customize via compile flag.
+
+	// ContractsMigrationGasLimit is the max sdk gas a single contract can consume when migrated by a contracts migration
+	ContractsMigrationGasLimit uint64 = 10_000_000 // extension point for chains to customize via compile flag.
+
+	// ContractsMigrationBlockContractsLimit is the max number of contracts migrated per block by all contracts
+	// migrations together
+	ContractsMigrationBlockContractsLimit uint32 = 500 // extension point for chains to customize via compile flag.
+
+	// ContractsMigrationBlockGasLimit is the max sdk gas consumed per block by all contracts migrations together. The
+	// next contract is migrated only when the gas left covers the ContractsMigrationGasLimit.
+	ContractsMigrationBlockGasLimit uint64 = 100_000_000 // extension point for chains to customize via compile flag.
+
+	// MaxPendingContractsMigrations is the max number of contracts migrations that can be in progress at the same time
+	MaxPendingContractsMigrations = 10 // extension point for chains to customize via compile flag.
+
+	// MaxContractLimitsPerGrant is the max number of contracts with a tracked limit state in a contract authz grant
+	// matching code ids or a creator
+	MaxContractLimitsPerGrant = 100 // extension point for chains to customize via compile flag.
+
+	// MaxContractAuthzGrantsScan is the max number of authz grants scanned for a single page of the contract authz
+	// grants query
+	MaxContractAuthzGrantsScan uint64 = 1_000 // extension point for chains to customize via compile flag.
+
+	// RollingWindowLimitBuckets is the number of sub-windows a rolling window limit tracks the usage in
+	RollingWindowLimitBuckets = 24 // extension point for chains to customize via compile flag.
+
+	// DefaultAdminTransferExpiryBlocks is the number of blocks a proposed admin can accept the transfer in when not set
+	// in the message
+	DefaultAdminTransferExpiryBlocks uint64 = 100_800 // extension point for chains to customize via compile flag.
+
+	// MaxAdminTransferExpiryBlocks is the max number of blocks a proposed admin can accept the transfer in
+	MaxAdminTransferExpiryBlocks uint64 = 1_209_600 // extension point for chains to customize via compile flag.
 )
 
+// MaxAddressGeneratorNameSize is the longest name of an address generator strategy
+const MaxAddressGeneratorNameSize = 64
+
 func validateWasmCode(s []byte, maxSize int) error {
 	if len(s) == 0 {
 		return errorsmod.Wrap(ErrEmpty, "is required")
@@ -77,3 +142,17 @@ func ValidateVerificationInfo(source, builder string, codeHash []byte) error {
 	}
 	return nil
 }
+
+// ValidateMetadataURL ensures that an optional url in the contract metadata is well-formed
+func ValidateMetadataURL(s string) error {
+	if s == "" {
+		return nil
+	}
+	if len(s) > MaxMetadataURLSize {
+		return ErrLimit.Wrapf("cannot be longer than %d characters", MaxMetadataURLSize)
+	}
+	if _, err := url.ParseRequestURI(s); err != nil {
+		return errorsmod.Wrap(ErrInvalid, err.Error())
+	}
+	return nil
+}